@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type AccountRequest struct {
+	Name           string `json:"name" binding:"required,max=100"`
+	Type           string `json:"type" binding:"omitempty,oneof=cash debit credit savings"`
+	Currency       string `json:"currency" binding:"omitempty,len=3,alpha"`
+	OpeningBalance Money  `json:"opening_balance"`
+	Archived       bool   `json:"archived"`
+}
+
+type AccountResponse struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Currency       string `json:"currency"`
+	OpeningBalance Money  `json:"opening_balance"`
+	Balance        Money  `json:"balance"`
+	Archived       bool   `json:"archived"`
+}
+
+type TransferRequest struct {
+	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1,nefield=FromAccountID"`
+	Amount        Money  `json:"amount" binding:"required,gt=0"`
+	ToAmount      Money  `json:"to_amount" binding:"omitempty,gt=0"`
+	Description   string `json:"description"`
+	Date          string `json:"date"`
+}
+
+type TransferResponse struct {
+	ID            int64                 `json:"id"`
+	FromAccountID int64                 `json:"from_account_id"`
+	ToAccountID   int64                 `json:"to_account_id"`
+	Amount        Money                 `json:"amount"`
+	ToAmount      Money                 `json:"to_amount"`
+	Description   string                `json:"description"`
+	Date          string                `json:"date"`
+	Transactions  []TransactionResponse `json:"transactions"`
+}
+
+type BalancePointResponse struct {
+	Date    string `json:"date"`
+	Balance Money  `json:"balance"`
+}
+
+type AccountBalancesResponse struct {
+	Account  AccountResponse        `json:"account"`
+	Interval string                 `json:"interval"`
+	Balances []BalancePointResponse `json:"balances"`
+}
+
+func (h *LedgerHandler) CreateAccount(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req AccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.CreateAccount(c.Request.Context(), &ledgerv1.CreateAccountRequest{
+		UserId:                userID,
+		Name:                  req.Name,
+		Type:                  req.Type,
+		Currency:              req.Currency,
+		OpeningBalance:        req.OpeningBalance.Float64(),
+		OpeningBalanceDecimal: req.OpeningBalance.String(),
+	})
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toAccountResponse(resp.GetAccount()))
+}
+
+func (h *LedgerHandler) GetAccounts(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	includeArchived, _ := strconv.ParseBool(c.Query("include_archived"))
+
+	resp, err := h.ledgerClient.GetAccounts(c.Request.Context(), &ledgerv1.GetAccountsRequest{
+		UserId:          userID,
+		IncludeArchived: includeArchived,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	accounts := make([]AccountResponse, 0, len(resp.GetAccounts()))
+	for _, account := range resp.GetAccounts() {
+		accounts = append(accounts, toAccountResponse(account))
+	}
+
+	c.JSON(http.StatusOK, accounts)
+}
+
+func (h *LedgerHandler) UpdateAccount(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid account id"})
+		return
+	}
+
+	var req AccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.UpdateAccount(c.Request.Context(), &ledgerv1.UpdateAccountRequest{
+		Id:                    id,
+		UserId:                userID,
+		Name:                  req.Name,
+		Type:                  req.Type,
+		Currency:              req.Currency,
+		OpeningBalance:        req.OpeningBalance.Float64(),
+		OpeningBalanceDecimal: req.OpeningBalance.String(),
+		Archived:              req.Archived,
+	})
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAccountResponse(resp.GetAccount()))
+}
+
+func (h *LedgerHandler) DeleteAccount(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid account id"})
+		return
+	}
+
+	_, err = h.ledgerClient.DeleteAccount(c.Request.Context(), &ledgerv1.DeleteAccountRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *LedgerHandler) CreateTransfer(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req TransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var date *timestamppb.Timestamp
+	if req.Date != "" {
+		t, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date format, use YYYY-MM-DD"})
+			return
+		}
+		date = timestamppb.New(t)
+	}
+
+	protoReq := &ledgerv1.CreateTransferRequest{
+		UserId:        userID,
+		FromAccountId: req.FromAccountID,
+		ToAccountId:   req.ToAccountID,
+		Amount:        req.Amount.Float64(),
+		AmountDecimal: req.Amount.String(),
+		Description:   req.Description,
+		Date:          date,
+	}
+	if req.ToAmount > 0 {
+		protoReq.ToAmount = req.ToAmount.Float64()
+		protoReq.ToAmountDecimal = req.ToAmount.String()
+	}
+
+	resp, err := h.ledgerClient.CreateTransfer(c.Request.Context(), protoReq)
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+
+	transfer := resp.GetTransfer()
+	transactions := make([]TransactionResponse, 0, len(resp.GetTransactions()))
+	for _, tx := range resp.GetTransactions() {
+		transactions = append(transactions, TransactionResponse{
+			ID:          tx.GetId(),
+			Kind:        tx.GetKind(),
+			Amount:      moneyFromProto(tx.GetAmountDecimal(), tx.GetAmount()),
+			Currency:    tx.GetCurrency(),
+			Category:    tx.GetCategory(),
+			Description: tx.GetDescription(),
+			Date:        tx.GetDate().AsTime().Format("2006-01-02"),
+			AccountID:   tx.GetAccountId(),
+			TransferID:  tx.GetTransferId(),
+		})
+	}
+
+	c.JSON(http.StatusCreated, TransferResponse{
+		ID:            transfer.GetId(),
+		FromAccountID: transfer.GetFromAccountId(),
+		ToAccountID:   transfer.GetToAccountId(),
+		Amount:        moneyFromProto(transfer.GetAmountDecimal(), transfer.GetAmount()),
+		ToAmount:      moneyFromProto(transfer.GetToAmountDecimal(), transfer.GetToAmount()),
+		Description:   transfer.GetDescription(),
+		Date:          transfer.GetDate().AsTime().Format("2006-01-02"),
+		Transactions:  transactions,
+	})
+}
+
+func (h *LedgerHandler) GetAccountBalances(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid account id"})
+		return
+	}
+
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -11, 0)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if v := c.Query("from"); v != "" {
+		if from, err = time.Parse("2006-01-02", v); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid from date, use YYYY-MM-DD"})
+			return
+		}
+	}
+	if v := c.Query("to"); v != "" {
+		if to, err = time.Parse("2006-01-02", v); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid to date, use YYYY-MM-DD"})
+			return
+		}
+	}
+
+	interval := c.DefaultQuery("interval", "month")
+	if interval != "day" && interval != "week" && interval != "month" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "interval must be day, week or month"})
+		return
+	}
+
+	resp, err := h.ledgerClient.GetAccountBalances(c.Request.Context(), &ledgerv1.GetAccountBalancesRequest{
+		UserId:    userID,
+		AccountId: id,
+		From:      timestamppb.New(from),
+		To:        timestamppb.New(to),
+		Interval:  interval,
+	})
+	if err != nil {
+		writeAccountError(c, err)
+		return
+	}
+
+	balances := make([]BalancePointResponse, 0, len(resp.GetBalances()))
+	for _, point := range resp.GetBalances() {
+		balances = append(balances, BalancePointResponse{
+			Date:    point.GetDate().AsTime().Format("2006-01-02"),
+			Balance: moneyFromProto(point.GetBalanceDecimal(), point.GetBalance()),
+		})
+	}
+
+	c.JSON(http.StatusOK, AccountBalancesResponse{
+		Account:  toAccountResponse(resp.GetAccount()),
+		Interval: interval,
+		Balances: balances,
+	})
+}
+
+func writeAccountError(c *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		case codes.AlreadyExists, codes.FailedPrecondition:
+			c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+			return
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func toAccountResponse(account *ledgerv1.Account) AccountResponse {
+	return AccountResponse{
+		ID:             account.GetId(),
+		Name:           account.GetName(),
+		Type:           account.GetType(),
+		Currency:       account.GetCurrency(),
+		OpeningBalance: moneyFromProto(account.GetOpeningBalanceDecimal(), account.GetOpeningBalance()),
+		Balance:        moneyFromProto(account.GetBalanceDecimal(), account.GetBalance()),
+		Archived:       account.GetArchived(),
+	}
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type CategoryRequest struct {
+	Name     string `json:"name" binding:"required,max=100"`
+	ParentID int64  `json:"parent_id" binding:"omitempty,min=1"`
+	Archived bool   `json:"archived"`
+}
+
+type MergeCategoryRequest struct {
+	TargetID int64 `json:"target_id" binding:"required,min=1"`
+}
+
+type CategoryResponse struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	ParentID int64  `json:"parent_id,omitempty"`
+	Archived bool   `json:"archived"`
+}
+
+type MergeCategoryResponse struct {
+	Category          CategoryResponse `json:"category"`
+	MovedTransactions int64            `json:"moved_transactions"`
+}
+
+func (h *LedgerHandler) CreateCategory(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.CreateCategory(c.Request.Context(), &ledgerv1.CreateCategoryRequest{
+		UserId:   userID,
+		Name:     req.Name,
+		ParentId: req.ParentID,
+	})
+	if err != nil {
+		writeCategoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCategoryResponse(resp.GetCategory()))
+}
+
+func (h *LedgerHandler) GetCategories(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	includeArchived, _ := strconv.ParseBool(c.Query("include_archived"))
+
+	resp, err := h.ledgerClient.GetCategories(c.Request.Context(), &ledgerv1.GetCategoriesRequest{
+		UserId:          userID,
+		IncludeArchived: includeArchived,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	categories := make([]CategoryResponse, 0, len(resp.GetCategories()))
+	for _, category := range resp.GetCategories() {
+		categories = append(categories, toCategoryResponse(category))
+	}
+
+	c.JSON(http.StatusOK, categories)
+}
+
+func (h *LedgerHandler) UpdateCategory(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category id"})
+		return
+	}
+
+	var req CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.UpdateCategory(c.Request.Context(), &ledgerv1.UpdateCategoryRequest{
+		Id:       id,
+		UserId:   userID,
+		Name:     req.Name,
+		ParentId: req.ParentID,
+		Archived: req.Archived,
+	})
+	if err != nil {
+		writeCategoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCategoryResponse(resp.GetCategory()))
+}
+
+func (h *LedgerHandler) DeleteCategory(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category id"})
+		return
+	}
+
+	_, err = h.ledgerClient.DeleteCategory(c.Request.Context(), &ledgerv1.DeleteCategoryRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		writeCategoryError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *LedgerHandler) MergeCategories(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category id"})
+		return
+	}
+
+	var req MergeCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.MergeCategories(c.Request.Context(), &ledgerv1.MergeCategoriesRequest{
+		UserId:   userID,
+		SourceId: id,
+		TargetId: req.TargetID,
+	})
+	if err != nil {
+		writeCategoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MergeCategoryResponse{
+		Category:          toCategoryResponse(resp.GetCategory()),
+		MovedTransactions: resp.GetMovedTransactions(),
+	})
+}
+
+func writeCategoryError(c *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		case codes.AlreadyExists, codes.FailedPrecondition:
+			c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+			return
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func toCategoryResponse(category *ledgerv1.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:       category.GetId(),
+		Name:     category.GetName(),
+		ParentID: category.GetParentId(),
+		Archived: category.GetArchived(),
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type CompareReportsRequest struct {
+	From     string `form:"from" binding:"required,datetime=2006-01-02"`
+	To       string `form:"to" binding:"required,datetime=2006-01-02"`
+	Baseline string `form:"baseline" binding:"omitempty,oneof=previous year_ago"`
+}
+
+type PeriodChangeResponse struct {
+	Current      Money   `json:"current"`
+	Previous     Money   `json:"previous"`
+	Delta        Money   `json:"delta"`
+	DeltaPercent float64 `json:"delta_percent"`
+}
+
+type CategoryComparisonResponse struct {
+	Category string               `json:"category"`
+	Parent   string               `json:"parent,omitempty"`
+	Change   PeriodChangeResponse `json:"change"`
+	Status   string               `json:"status"`
+}
+
+type ComparisonResponse struct {
+	BaseCurrency          string                       `json:"base_currency"`
+	From                  string                       `json:"from"`
+	To                    string                       `json:"to"`
+	Baseline              string                       `json:"baseline"`
+	BaselineFrom          string                       `json:"baseline_from"`
+	BaselineTo            string                       `json:"baseline_to"`
+	Expenses              PeriodChangeResponse         `json:"expenses"`
+	Income                PeriodChangeResponse         `json:"income"`
+	NetBalance            PeriodChangeResponse         `json:"net_balance"`
+	Categories            []CategoryComparisonResponse `json:"categories"`
+	NewCategories         []string                     `json:"new_categories,omitempty"`
+	DisappearedCategories []string                     `json:"disappeared_categories,omitempty"`
+}
+
+func (h *LedgerHandler) CompareReports(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req CompareReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	from, _ := time.Parse("2006-01-02", req.From)
+	to, _ := time.Parse("2006-01-02", req.To)
+
+	resp, err := h.ledgerClient.CompareReports(c.Request.Context(), &ledgerv1.CompareReportsRequest{
+		UserId:   userID,
+		From:     timestamppb.New(from),
+		To:       timestamppb.New(to),
+		Baseline: req.Baseline,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": st.Message()})
+				return
+			}
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	categories := make([]CategoryComparisonResponse, 0, len(resp.GetCategories()))
+	for _, cat := range resp.GetCategories() {
+		categories = append(categories, CategoryComparisonResponse{
+			Category: cat.GetCategory(),
+			Parent:   cat.GetParent(),
+			Change:   toPeriodChangeResponse(cat.GetChange()),
+			Status:   cat.GetStatus(),
+		})
+	}
+
+	c.JSON(http.StatusOK, ComparisonResponse{
+		BaseCurrency:          resp.GetBaseCurrency(),
+		From:                  req.From,
+		To:                    req.To,
+		Baseline:              resp.GetBaseline(),
+		BaselineFrom:          resp.GetBaselineFrom().AsTime().Format("2006-01-02"),
+		BaselineTo:            resp.GetBaselineTo().AsTime().Format("2006-01-02"),
+		Expenses:              toPeriodChangeResponse(resp.GetExpenses()),
+		Income:                toPeriodChangeResponse(resp.GetIncome()),
+		NetBalance:            toPeriodChangeResponse(resp.GetNetBalance()),
+		Categories:            categories,
+		NewCategories:         resp.GetNewCategories(),
+		DisappearedCategories: resp.GetDisappearedCategories(),
+	})
+}
+
+func toPeriodChangeResponse(change *ledgerv1.PeriodChange) PeriodChangeResponse {
+	return PeriodChangeResponse{
+		Current:      moneyFromProto(change.GetCurrentDecimal(), change.GetCurrent()),
+		Previous:     moneyFromProto(change.GetPreviousDecimal(), change.GetPrevious()),
+		Delta:        moneyFromProto(change.GetDeltaDecimal(), change.GetDelta()),
+		DeltaPercent: change.GetDeltaPercent(),
+	}
+}
@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type SettingsRequest struct {
+	BaseCurrency string `json:"base_currency" binding:"required,len=3,alpha"`
+}
+
+type SettingsResponse struct {
+	BaseCurrency string `json:"base_currency"`
+}
+
+func (h *LedgerHandler) GetSettings(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	resp, err := h.ledgerClient.GetSettings(c.Request.Context(), &ledgerv1.GetSettingsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, SettingsResponse{BaseCurrency: resp.GetBaseCurrency()})
+}
+
+func (h *LedgerHandler) UpdateSettings(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req SettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.SetBaseCurrency(c.Request.Context(), &ledgerv1.SetBaseCurrencyRequest{
+		UserId:       userID,
+		BaseCurrency: req.BaseCurrency,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, SettingsResponse{BaseCurrency: resp.GetBaseCurrency()})
+}
+
+type ExchangeRateRequest struct {
+	BaseCurrency  string  `json:"base_currency" binding:"required,len=3,alpha"`
+	QuoteCurrency string  `json:"quote_currency" binding:"required,len=3,alpha"`
+	Date          string  `json:"date" binding:"required"`
+	Rate          float64 `json:"rate" binding:"required,gt=0"`
+}
+
+type SetExchangeRatesRequest struct {
+	Rates []ExchangeRateRequest `json:"rates" binding:"required,min=1,dive"`
+}
+
+type ExchangeRateResponse struct {
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Date          string  `json:"date"`
+	Rate          float64 `json:"rate"`
+}
+
+func (h *LedgerHandler) SetExchangeRates(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req SetExchangeRatesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rates := make([]*ledgerv1.ExchangeRate, 0, len(req.Rates))
+	for _, r := range req.Rates {
+		t, err := time.Parse("2006-01-02", r.Date)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date format, use YYYY-MM-DD"})
+			return
+		}
+		rates = append(rates, &ledgerv1.ExchangeRate{
+			BaseCurrency:  r.BaseCurrency,
+			QuoteCurrency: r.QuoteCurrency,
+			Date:          timestamppb.New(t),
+			Rate:          r.Rate,
+		})
+	}
+
+	resp, err := h.ledgerClient.SetExchangeRates(c.Request.Context(), &ledgerv1.SetExchangeRatesRequest{
+		UserId: userID,
+		Rates:  rates,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"saved_count": resp.GetSavedCount()})
+}
+
+func (h *LedgerHandler) ImportExchangeRates(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req ImportCSVRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	csvData, err := base64.StdEncoding.DecodeString(req.CSVData)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid base64 data"})
+		return
+	}
+
+	resp, err := h.ledgerClient.ImportExchangeRates(c.Request.Context(), &ledgerv1.ImportExchangeRatesRequest{
+		UserId:  userID,
+		CsvData: csvData,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ImportCSVResponse{
+		ImportedCount: resp.GetImportedCount(),
+		SkippedCount:  resp.GetSkippedCount(),
+		Errors:        resp.GetErrors(),
+	})
+}
+
+func (h *LedgerHandler) GetExchangeRates(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	req := &ledgerv1.GetExchangeRatesRequest{
+		UserId: userID,
+	}
+
+	if from := c.Query("from"); from != "" {
+		t, err := time.Parse("2006-01-02", from)
+		if err == nil {
+			req.From = timestamppb.New(t)
+		}
+	}
+	if to := c.Query("to"); to != "" {
+		t, err := time.Parse("2006-01-02", to)
+		if err == nil {
+			req.To = timestamppb.New(t)
+		}
+	}
+
+	resp, err := h.ledgerClient.GetExchangeRates(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	rates := make([]ExchangeRateResponse, 0, len(resp.GetRates()))
+	for _, r := range resp.GetRates() {
+		rates = append(rates, ExchangeRateResponse{
+			BaseCurrency:  r.GetBaseCurrency(),
+			QuoteCurrency: r.GetQuoteCurrency(),
+			Date:          r.GetDate().AsTime().Format("2006-01-02"),
+			Rate:          r.GetRate(),
+		})
+	}
+
+	c.JSON(http.StatusOK, rates)
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type ExportTransactionsRequest struct {
+	Format  string `form:"format" binding:"omitempty,oneof=csv jsonl xlsx ofx"`
+	Columns string `form:"columns" binding:"max=200"`
+	From    string `form:"from"`
+	To      string `form:"to"`
+}
+
+func (h *LedgerHandler) ExportTransactions(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	req, ok := bindExportRequest(c, userID)
+	if !ok {
+		return
+	}
+
+	resp, err := h.ledgerClient.ExportTransactions(c.Request.Context(), req)
+	if err != nil {
+		writeExportError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.GetFilename()}))
+	c.Header("X-Rows-Count", strconv.Itoa(int(resp.GetRowsCount())))
+	c.Data(http.StatusOK, resp.GetContentType(), resp.GetData())
+}
+
+func (h *LedgerHandler) ExportStream(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	req, ok := bindExportRequest(c, userID)
+	if !ok {
+		return
+	}
+
+	extendDeadlines(c)
+
+	stream, err := h.ledgerClient.ExportStream(c.Request.Context(), req)
+	if err != nil {
+		writeExportError(c, err)
+		return
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		writeExportError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", first.GetContentType())
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": first.GetFilename()}))
+	c.Status(http.StatusOK)
+
+	chunk := first
+	for {
+		if _, err := c.Writer.Write(chunk.GetChunk()); err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.Writer.Flush()
+
+		chunk, err = stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+}
+
+func bindExportRequest(c *gin.Context, userID int64) (*ledgerv1.ExportTransactionsRequest, bool) {
+	var query ExportTransactionsRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+
+	req := &ledgerv1.ExportTransactionsRequest{
+		UserId: userID,
+		Format: query.Format,
+	}
+	for _, column := range strings.Split(query.Columns, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			req.Columns = append(req.Columns, column)
+		}
+	}
+
+	if query.From != "" {
+		t, err := time.Parse("2006-01-02", query.From)
+		if err == nil {
+			req.From = timestamppb.New(t)
+		}
+	}
+	if query.To != "" {
+		t, err := time.Parse("2006-01-02", query.To)
+		if err == nil {
+			req.To = timestamppb.New(t)
+		}
+	}
+	return req, true
+}
+
+func writeExportError(c *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
@@ -2,12 +2,21 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
 )
 
 func init() {
@@ -127,10 +136,112 @@ func TestAddTransactionRequest_Validation(t *testing.T) {
 			wantStatus: http.StatusBadRequest,
 		},
 		{
-			name: "missing category",
+			name: "missing category left to rules",
 			body: map[string]interface{}{
 				"amount": 1500,
 			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "income transaction",
+			body: map[string]interface{}{
+				"kind":     "income",
+				"amount":   50000,
+				"category": "salary",
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "unknown kind",
+			body: map[string]interface{}{
+				"kind":     "refund",
+				"amount":   1500,
+				"category": "food",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "decimal string amount",
+			body: map[string]interface{}{
+				"amount":   "1500.50",
+				"category": "food",
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "with tags",
+			body: map[string]interface{}{
+				"amount":   1500,
+				"category": "travel",
+				"tags":     []string{"vacation-2026", "reimbursable"},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "tag too long",
+			body: map[string]interface{}{
+				"amount":   1500,
+				"category": "travel",
+				"tags":     []string{strings.Repeat("x", 51)},
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "split without category",
+			body: map[string]interface{}{
+				"amount": 1000,
+				"splits": []map[string]interface{}{
+					{"category": "groceries", "amount": 700},
+					{"category": "household", "amount": 300, "note": "detergent"},
+				},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "single split line",
+			body: map[string]interface{}{
+				"amount": 1000,
+				"splits": []map[string]interface{}{
+					{"category": "groceries", "amount": 1000},
+				},
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "split line without amount",
+			body: map[string]interface{}{
+				"amount": 1000,
+				"splits": []map[string]interface{}{
+					{"category": "groceries", "amount": 1000},
+					{"category": "household"},
+				},
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "with account",
+			body: map[string]interface{}{
+				"amount":     1500.50,
+				"category":   "food",
+				"account_id": 3,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "invalid account id",
+			body: map[string]interface{}{
+				"amount":     1500.50,
+				"category":   "food",
+				"account_id": -3,
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "too many decimal places",
+			body: map[string]interface{}{
+				"amount":   1500.505,
+				"category": "food",
+			},
 			wantStatus: http.StatusBadRequest,
 		},
 	}
@@ -207,6 +318,63 @@ func TestSetBudgetRequest_Validation(t *testing.T) {
 			},
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name: "salary-day month with rollover",
+			body: map[string]interface{}{
+				"category":     "food",
+				"limit_amount": 15000,
+				"period":       "monthly",
+				"start_day":    25,
+				"rollover":     true,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "quarterly budget",
+			body: map[string]interface{}{
+				"category":     "travel",
+				"limit_amount": 90000,
+				"period":       "quarterly",
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "unknown period",
+			body: map[string]interface{}{
+				"category":     "food",
+				"limit_amount": 15000,
+				"period":       "fortnightly",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "soft budget with thresholds",
+			body: map[string]interface{}{
+				"category":           "food",
+				"limit_amount":       15000,
+				"enforcement":        "soft",
+				"warning_thresholds": []int{50, 80, 100},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "unknown enforcement",
+			body: map[string]interface{}{
+				"category":     "food",
+				"limit_amount": 15000,
+				"enforcement":  "strict",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "zero threshold",
+			body: map[string]interface{}{
+				"category":           "food",
+				"limit_amount":       15000,
+				"warning_thresholds": []int{0, 80},
+			},
+			wantStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -235,3 +403,939 @@ func TestSetBudgetRequest_Validation(t *testing.T) {
 	}
 }
 
+
+func TestRecurringRuleRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name: "valid monthly rule",
+			body: map[string]interface{}{
+				"amount":     30000,
+				"category":   "rent",
+				"frequency":  "monthly",
+				"start_date": "2024-12-01",
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "with interval and end date",
+			body: map[string]interface{}{
+				"amount":     "299.00",
+				"category":   "subscriptions",
+				"frequency":  "weekly",
+				"interval":   2,
+				"start_date": "2024-12-01",
+				"end_date":   "2025-12-01",
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "unknown frequency",
+			body: map[string]interface{}{
+				"amount":     30000,
+				"category":   "rent",
+				"frequency":  "hourly",
+				"start_date": "2024-12-01",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "missing start date",
+			body: map[string]interface{}{
+				"amount":    30000,
+				"category":  "rent",
+				"frequency": "monthly",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "negative interval",
+			body: map[string]interface{}{
+				"amount":     30000,
+				"category":   "rent",
+				"frequency":  "monthly",
+				"interval":   -1,
+				"start_date": "2024-12-01",
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/recurring", func(c *gin.Context) {
+				var req RecurringRuleRequest
+				if err := c.ShouldBindJSON(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"category": req.Category, "frequency": req.Frequency})
+			})
+
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/recurring", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestCategoryRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "top-level category",
+			body:       map[string]interface{}{"name": "Food"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "subcategory",
+			body:       map[string]interface{}{"name": "Groceries", "parent_id": 1},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "archived",
+			body:       map[string]interface{}{"name": "Old", "archived": true},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing name",
+			body:       map[string]interface{}{"parent_id": 1},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "negative parent",
+			body:       map[string]interface{}{"name": "Food", "parent_id": -1},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/categories", func(c *gin.Context) {
+				var req CategoryRequest
+				if err := c.ShouldBindJSON(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"name": req.Name})
+			})
+
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/categories", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAccountRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "cash account",
+			body:       map[string]interface{}{"name": "Wallet"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "credit card with negative opening balance",
+			body:       map[string]interface{}{"name": "Visa", "type": "credit", "currency": "USD", "opening_balance": "-250.00"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing name",
+			body:       map[string]interface{}{"type": "debit"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown type",
+			body:       map[string]interface{}{"name": "Wallet", "type": "crypto"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid currency",
+			body:       map[string]interface{}{"name": "Wallet", "currency": "RUBL"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/accounts", func(c *gin.Context) {
+				var req AccountRequest
+				if err := c.ShouldBindJSON(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"name": req.Name})
+			})
+
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/accounts", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestTransferRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "same currency transfer",
+			body:       map[string]interface{}{"from_account_id": 1, "to_account_id": 2, "amount": "100.00"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "cross currency transfer",
+			body:       map[string]interface{}{"from_account_id": 1, "to_account_id": 2, "amount": "100.00", "to_amount": "1.10", "date": "2026-01-15"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "same account",
+			body:       map[string]interface{}{"from_account_id": 1, "to_account_id": 1, "amount": "100.00"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing destination",
+			body:       map[string]interface{}{"from_account_id": 1, "amount": "100.00"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "zero amount",
+			body:       map[string]interface{}{"from_account_id": 1, "to_account_id": 2, "amount": "0"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "negative to_amount",
+			body:       map[string]interface{}{"from_account_id": 1, "to_account_id": 2, "amount": "100.00", "to_amount": "-1"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/accounts/transfers", func(c *gin.Context) {
+				var req TransferRequest
+				if err := c.ShouldBindJSON(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"amount": req.Amount})
+			})
+
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/accounts/transfers", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestCategoryRuleRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "contains rule",
+			body:       map[string]interface{}{"pattern": "Пятёрочка", "category": "groceries"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "regex rule with amount range and tags",
+			body:       map[string]interface{}{"match_type": "regex", "pattern": "^uber", "min_amount": "100", "max_amount": "2000.50", "category": "taxi", "tags": []string{"work"}, "priority": 10},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing category",
+			body:       map[string]interface{}{"pattern": "uber"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown match type",
+			body:       map[string]interface{}{"match_type": "glob", "pattern": "uber*", "category": "taxi"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "negative min amount",
+			body:       map[string]interface{}{"min_amount": "-5", "category": "taxi"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/rules", func(c *gin.Context) {
+				var req CategoryRuleRequest
+				if err := c.ShouldBindJSON(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"category": req.Category})
+			})
+
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/rules", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestImportCSVRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "default duplicate mode",
+			body:       map[string]interface{}{"csv_data": "YW1vdW50"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "flag probable duplicates",
+			body:       map[string]interface{}{"csv_data": "YW1vdW50", "duplicate_mode": "flag"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "atomic preview",
+			body:       map[string]interface{}{"csv_data": "YW1vdW50", "preview": true, "atomic": true},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "saved profile",
+			body:       map[string]interface{}{"csv_data": "YW1vdW50", "profile": "Сбербанк"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "ofx statement",
+			body:       map[string]interface{}{"csv_data": "PE9GWD4=", "format": "ofx"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unknown format",
+			body:       map[string]interface{}{"csv_data": "YW1vdW50", "format": "xlsx"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown duplicate mode",
+			body:       map[string]interface{}{"csv_data": "YW1vdW50", "duplicate_mode": "merge"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing data",
+			body:       map[string]interface{}{"duplicate_mode": "force"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/csv/import", func(c *gin.Context) {
+				var req ImportCSVRequest
+				if err := c.ShouldBindJSON(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"duplicate_mode": req.DuplicateMode})
+			})
+
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/csv/import", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestImportProfileRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "name only",
+			body:       map[string]interface{}{"name": "По умолчанию"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "bank statement",
+			body: map[string]interface{}{
+				"name": "Сбербанк", "delimiter": ";", "decimal_comma": true, "date_layouts": []string{"02.01.2006"},
+				"encoding": "windows-1251", "sign_convention": "negative_expense",
+				"columns": map[string]string{"amount": "Сумма", "date": "Дата операции", "description": "3"},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing name",
+			body:       map[string]interface{}{"delimiter": ";"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown encoding",
+			body:       map[string]interface{}{"name": "x", "encoding": "latin-1"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown sign convention",
+			body:       map[string]interface{}{"name": "x", "sign_convention": "inverted"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown column",
+			body:       map[string]interface{}{"name": "x", "columns": map[string]string{"memo": "2"}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "empty column reference",
+			body:       map[string]interface{}{"name": "x", "columns": map[string]string{"amount": ""}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "empty date layout",
+			body:       map[string]interface{}{"name": "x", "date_layouts": []string{""}},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/csv/profiles", func(c *gin.Context) {
+				var req ImportProfileRequest
+				if err := c.ShouldBindJSON(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"name": req.Name})
+			})
+
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/csv/profiles", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestUploadStatementRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		fields     map[string]string
+		filename   string
+		wantStatus int
+		wantFormat string
+	}{
+		{
+			name:       "format from extension",
+			filename:   "statement.QFX",
+			wantStatus: http.StatusOK,
+			wantFormat: "ofx",
+		},
+		{
+			name:       "explicit format",
+			fields:     map[string]string{"format": "qif", "duplicate_mode": "flag", "preview": "true"},
+			filename:   "export.txt",
+			wantStatus: http.StatusOK,
+			wantFormat: "qif",
+		},
+		{
+			name:       "csv by default",
+			fields:     map[string]string{"profile": "Сбербанк"},
+			filename:   "statement.csv",
+			wantStatus: http.StatusOK,
+			wantFormat: "csv",
+		},
+		{
+			name:       "missing file",
+			fields:     map[string]string{"format": "ofx"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown format",
+			fields:     map[string]string{"format": "xlsx"},
+			filename:   "statement.xlsx",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/imports/upload", func(c *gin.Context) {
+				var req UploadStatementRequest
+				if err := c.ShouldBind(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				header, err := c.FormFile("file")
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+					return
+				}
+				format := req.Format
+				if format == "" {
+					format = statementFormat(header.Filename)
+				}
+				c.JSON(http.StatusOK, gin.H{"format": format})
+			})
+
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			for name, value := range tt.fields {
+				_ = writer.WriteField(name, value)
+			}
+			if tt.filename != "" {
+				part, _ := writer.CreateFormFile("file", tt.filename)
+				_, _ = part.Write([]byte("<OFX></OFX>"))
+			}
+			_ = writer.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/imports/upload", &body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantFormat != "" && !strings.Contains(w.Body.String(), `"format":"`+tt.wantFormat+`"`) {
+				t.Errorf("body = %s, want format %q", w.Body.String(), tt.wantFormat)
+			}
+		})
+	}
+}
+
+func TestImportStreamQuery_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "defaults", query: "", wantStatus: http.StatusOK},
+		{name: "all options", query: "?format=ofx&duplicate_mode=flag&preview=true&atomic=true", wantStatus: http.StatusOK},
+		{name: "unknown format", query: "?format=xlsx", wantStatus: http.StatusBadRequest},
+		{name: "unknown duplicate mode", query: "?duplicate_mode=merge", wantStatus: http.StatusBadRequest},
+		{name: "invalid preview", query: "?preview=maybe", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/imports/stream", func(c *gin.Context) {
+				var req UploadStatementRequest
+				if err := c.ShouldBindQuery(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/imports/stream"+tt.query, strings.NewReader("350,cafe\n"))
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestExportTransactionsRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "defaults", query: "", wantStatus: http.StatusOK},
+		{name: "xlsx with period", query: "?format=xlsx&from=2025-01-01&to=2025-03-31", wantStatus: http.StatusOK},
+		{name: "csv columns", query: "?format=csv&columns=date,amount,category", wantStatus: http.StatusOK},
+		{name: "unknown format", query: "?format=pdf", wantStatus: http.StatusBadRequest},
+		{name: "columns too long", query: "?columns=" + strings.Repeat("amount,", 30), wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/transactions/export", func(c *gin.Context) {
+				var req ExportTransactionsRequest
+				if err := c.ShouldBindQuery(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/transactions/export"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestSubmitImportRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		fields     map[string]string
+		wantStatus int
+	}{
+		{
+			name:       "defaults",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "atomic csv with profile",
+			fields:     map[string]string{"format": "csv", "profile": "Сбербанк", "duplicate_mode": "flag", "atomic": "true"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unknown format",
+			fields:     map[string]string{"format": "xlsx"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown duplicate mode",
+			fields:     map[string]string{"duplicate_mode": "merge"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid atomic",
+			fields:     map[string]string{"atomic": "maybe"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/imports", func(c *gin.Context) {
+				var req SubmitImportRequest
+				if err := c.ShouldBind(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, req)
+			})
+
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			for name, value := range tt.fields {
+				_ = writer.WriteField(name, value)
+			}
+			part, _ := writer.CreateFormFile("file", "statement.csv")
+			_, _ = part.Write([]byte("350.00,cafe"))
+			_ = writer.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/imports", &body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestCompareReportsRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "previous period by default", query: "?from=2025-03-01&to=2025-03-31", wantStatus: http.StatusOK},
+		{name: "year ago", query: "?from=2025-03-01&to=2025-03-31&baseline=year_ago", wantStatus: http.StatusOK},
+		{name: "missing to", query: "?from=2025-03-01", wantStatus: http.StatusBadRequest},
+		{name: "invalid date", query: "?from=01.03.2025&to=2025-03-31", wantStatus: http.StatusBadRequest},
+		{name: "unknown baseline", query: "?from=2025-03-01&to=2025-03-31&baseline=budget", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/reports/compare", func(c *gin.Context) {
+				var req CompareReportsRequest
+				if err := c.ShouldBindQuery(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/reports/compare"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestTrendsRequest_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "monthly by default", query: "?from=2025-01-01&to=2025-12-31", wantStatus: http.StatusOK},
+		{name: "weekly by category", query: "?from=2025-01-01&to=2025-03-31&granularity=week&by_category=true&window=4", wantStatus: http.StatusOK},
+		{name: "missing from", query: "?to=2025-12-31", wantStatus: http.StatusBadRequest},
+		{name: "unknown granularity", query: "?from=2025-01-01&to=2025-12-31&granularity=quarter", wantStatus: http.StatusBadRequest},
+		{name: "window too large", query: "?from=2025-01-01&to=2025-12-31&window=101", wantStatus: http.StatusBadRequest},
+		{name: "invalid by_category", query: "?from=2025-01-01&to=2025-12-31&by_category=maybe", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/reports/trends", func(c *gin.Context) {
+				var req TrendsRequest
+				if err := c.ShouldBindQuery(&req); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/reports/trends"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+type fakeLedgerClient struct {
+	ledgerv1.LedgerServiceClient
+	transactionsReq  *ledgerv1.GetTransactionsRequest
+	transactionsResp *ledgerv1.GetTransactionsResponse
+	transactionsErr  error
+}
+
+func (f *fakeLedgerClient) GetTransactions(_ context.Context, in *ledgerv1.GetTransactionsRequest, _ ...grpc.CallOption) (*ledgerv1.GetTransactionsResponse, error) {
+	f.transactionsReq = in
+	if f.transactionsErr != nil {
+		return nil, f.transactionsErr
+	}
+	if f.transactionsResp == nil {
+		return &ledgerv1.GetTransactionsResponse{}, nil
+	}
+	return f.transactionsResp, nil
+}
+
+func TestLedgerHandler_GetTransactionsQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		ledgerErr  error
+		wantStatus int
+		wantReq    *ledgerv1.GetTransactionsRequest
+	}{
+		{
+			name:       "defaults",
+			query:      "",
+			wantStatus: http.StatusOK,
+			wantReq:    &ledgerv1.GetTransactionsRequest{UserId: 1},
+		},
+		{
+			name:       "page with sort and cursor",
+			query:      "?sort=date_asc&limit=50&cursor=abc",
+			wantStatus: http.StatusOK,
+			wantReq:    &ledgerv1.GetTransactionsRequest{UserId: 1, Sort: "date_asc", PageSize: 50, PageToken: "abc"},
+		},
+		{
+			name:       "search with filters",
+			query:      "?q=coffee&category=cafe&tags=work,trip&tag_match=all&account_id=3&min_amount=100&max_amount=250.50",
+			wantStatus: http.StatusOK,
+			wantReq: &ledgerv1.GetTransactionsRequest{
+				UserId: 1, Query: "coffee", Category: "cafe", Tags: []string{"work", "trip"}, TagMatch: "all", AccountId: 3,
+				MinAmount: 100, MinAmountDecimal: "100.00", MaxAmount: 250.5, MaxAmountDecimal: "250.50",
+			},
+		},
+		{
+			name:       "amount range in currency",
+			query:      "?min_amount=10&currency=usd",
+			wantStatus: http.StatusOK,
+			wantReq:    &ledgerv1.GetTransactionsRequest{UserId: 1, MinAmount: 10, MinAmountDecimal: "10.00", Currency: "usd"},
+		},
+		{name: "zero limit", query: "?limit=0", wantStatus: http.StatusBadRequest},
+		{name: "non-numeric limit", query: "?limit=ten", wantStatus: http.StatusBadRequest},
+		{name: "invalid account", query: "?account_id=-1", wantStatus: http.StatusBadRequest},
+		{name: "invalid min amount", query: "?min_amount=1.234", wantStatus: http.StatusBadRequest},
+		{
+			name:       "cursor from another sort",
+			query:      "?sort=date_asc&cursor=abc",
+			ledgerErr:  status.Error(codes.InvalidArgument, "page token does not match sort order"),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "ledger failure",
+			query:      "?sort=date_desc",
+			ledgerErr:  status.Error(codes.Unavailable, "ledger is down"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeLedgerClient{transactionsErr: tt.ledgerErr}
+			h := NewLedgerHandler(client)
+			router := gin.New()
+			router.GET("/transactions", func(c *gin.Context) {
+				c.Set("user_id", int64(1))
+				h.GetTransactions(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/transactions"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantReq != nil && !proto.Equal(client.transactionsReq, tt.wantReq) {
+				t.Errorf("ledger request = %v, want %v", client.transactionsReq, tt.wantReq)
+			}
+		})
+	}
+}
+
+func TestLedgerHandler_GetTransactionsNextCursor(t *testing.T) {
+	client := &fakeLedgerClient{transactionsResp: &ledgerv1.GetTransactionsResponse{NextPageToken: "next"}}
+	h := NewLedgerHandler(client)
+	router := gin.New()
+	router.GET("/transactions", func(c *gin.Context) {
+		c.Set("user_id", int64(1))
+		h.GetTransactions(c)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/transactions?limit=1", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	if got := w.Header().Get("X-Next-Cursor"); got != "next" {
+		t.Errorf("X-Next-Cursor = %q, want %q", got, "next")
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr bool
+	}{
+		{in: `1500.5`, want: 150050},
+		{in: `"1500.50"`, want: 150050},
+		{in: `0.1`, want: 10},
+		{in: `100`, want: 10000},
+		{in: `"abc"`, wantErr: true},
+		{in: `1e3`, wantErr: true},
+		{in: `0.001`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var m Money
+		err := json.Unmarshal([]byte(tt.in), &m)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Unmarshal(%s) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if m != tt.want {
+			t.Errorf("Unmarshal(%s) = %d, want %d", tt.in, m, tt.want)
+		}
+	}
+
+	out, err := json.Marshal(struct {
+		Amount Money `json:"amount"`
+	}{Amount: 30})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(out) != `{"amount":0.30}` {
+		t.Errorf("Marshal() = %s, want {\"amount\":0.30}", out)
+	}
+}
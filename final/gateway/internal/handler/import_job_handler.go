@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type SubmitImportRequest struct {
+	Format        string `form:"format" binding:"omitempty,oneof=csv ofx qfx qif"`
+	Profile       string `form:"profile" binding:"max=100"`
+	DuplicateMode string `form:"duplicate_mode" binding:"omitempty,oneof=skip force flag"`
+	Atomic        bool   `form:"atomic"`
+}
+
+type ImportJobResponse struct {
+	ID             int64                `json:"id"`
+	Status         string               `json:"status"`
+	Format         string               `json:"format"`
+	Profile        string               `json:"profile,omitempty"`
+	DuplicateMode  string               `json:"duplicate_mode"`
+	Atomic         bool                 `json:"atomic"`
+	TotalRows      int32                `json:"total_rows"`
+	ProcessedRows  int32                `json:"processed_rows"`
+	ImportedCount  int32                `json:"imported_count"`
+	DuplicateCount int32                `json:"duplicate_count"`
+	FailedCount    int32                `json:"failed_count"`
+	Error          string               `json:"error,omitempty"`
+	CreatedAt      string               `json:"created_at"`
+	StartedAt      string               `json:"started_at,omitempty"`
+	FinishedAt     string               `json:"finished_at,omitempty"`
+	Errors         []ImportJobErrorItem `json:"errors,omitempty"`
+}
+
+type ImportJobErrorItem struct {
+	Line  int32  `json:"line"`
+	Error string `json:"error"`
+}
+
+func (h *LedgerHandler) SubmitImport(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req SubmitImportRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	if header.Size > maxStatementSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file must be at most 3 MB"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read file"})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxStatementSize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read file"})
+		return
+	}
+	if len(data) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is empty"})
+		return
+	}
+
+	format := req.Format
+	if format == "" {
+		format = statementFormat(header.Filename)
+	}
+
+	resp, err := h.ledgerClient.SubmitImport(c.Request.Context(), &ledgerv1.SubmitImportRequest{
+		UserId:        userID,
+		Data:          data,
+		DuplicateMode: req.DuplicateMode,
+		Atomic:        req.Atomic,
+		Profile:       req.Profile,
+		Format:        format,
+	})
+	if err != nil {
+		writeImportJobError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, toImportJobResponse(resp.GetJob()))
+}
+
+func (h *LedgerHandler) GetImport(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid import id"})
+		return
+	}
+
+	resp, err := h.ledgerClient.GetImport(c.Request.Context(), &ledgerv1.GetImportRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		writeImportJobError(c, err)
+		return
+	}
+
+	job := toImportJobResponse(resp.GetJob())
+	for _, rowError := range resp.GetErrors() {
+		job.Errors = append(job.Errors, ImportJobErrorItem{
+			Line:  rowError.GetLine(),
+			Error: rowError.GetError(),
+		})
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+func (h *LedgerHandler) CancelImport(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid import id"})
+		return
+	}
+
+	resp, err := h.ledgerClient.CancelImport(c.Request.Context(), &ledgerv1.CancelImportRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		writeImportJobError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toImportJobResponse(resp.GetJob()))
+}
+
+func writeImportJobError(c *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+			return
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func toImportJobResponse(job *ledgerv1.ImportJob) ImportJobResponse {
+	return ImportJobResponse{
+		ID:             job.GetId(),
+		Status:         job.GetStatus(),
+		Format:         job.GetFormat(),
+		Profile:        job.GetProfile(),
+		DuplicateMode:  job.GetDuplicateMode(),
+		Atomic:         job.GetAtomic(),
+		TotalRows:      job.GetTotalRows(),
+		ProcessedRows:  job.GetProcessedRows(),
+		ImportedCount:  job.GetImportedCount(),
+		DuplicateCount: job.GetDuplicateCount(),
+		FailedCount:    job.GetFailedCount(),
+		Error:          job.GetError(),
+		CreatedAt:      formatTimestamp(job.GetCreatedAt()),
+		StartedAt:      formatTimestamp(job.GetStartedAt()),
+		FinishedAt:     formatTimestamp(job.GetFinishedAt()),
+	}
+}
+
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type ImportProfileRequest struct {
+	Name           string            `json:"name" binding:"required,max=100"`
+	Delimiter      string            `json:"delimiter" binding:"max=4"`
+	DecimalComma   bool              `json:"decimal_comma"`
+	DateLayouts    []string          `json:"date_layouts" binding:"omitempty,max=10,dive,required,max=50"`
+	Encoding       string            `json:"encoding" binding:"omitempty,oneof=utf-8 windows-1251 koi8-r"`
+	SignConvention string            `json:"sign_convention" binding:"omitempty,oneof=positive negative_expense negative_income"`
+	Columns        map[string]string `json:"columns" binding:"omitempty,dive,keys,oneof=amount category description date kind currency tags,endkeys,required,max=100"`
+}
+
+type ImportProfileResponse struct {
+	ID             int64             `json:"id"`
+	Name           string            `json:"name"`
+	Delimiter      string            `json:"delimiter"`
+	DecimalComma   bool              `json:"decimal_comma"`
+	DateLayouts    []string          `json:"date_layouts"`
+	Encoding       string            `json:"encoding"`
+	SignConvention string            `json:"sign_convention"`
+	Columns        map[string]string `json:"columns"`
+}
+
+func (h *LedgerHandler) CreateImportProfile(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req ImportProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.CreateImportProfile(c.Request.Context(), &ledgerv1.CreateImportProfileRequest{
+		UserId:         userID,
+		Name:           req.Name,
+		Delimiter:      req.Delimiter,
+		DecimalComma:   req.DecimalComma,
+		DateLayouts:    req.DateLayouts,
+		Encoding:       req.Encoding,
+		SignConvention: req.SignConvention,
+		Columns:        req.Columns,
+	})
+	if err != nil {
+		writeImportProfileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toImportProfileResponse(resp.GetProfile()))
+}
+
+func (h *LedgerHandler) GetImportProfiles(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	resp, err := h.ledgerClient.GetImportProfiles(c.Request.Context(), &ledgerv1.GetImportProfilesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	profiles := make([]ImportProfileResponse, 0, len(resp.GetProfiles()))
+	for _, profile := range resp.GetProfiles() {
+		profiles = append(profiles, toImportProfileResponse(profile))
+	}
+
+	c.JSON(http.StatusOK, profiles)
+}
+
+func (h *LedgerHandler) UpdateImportProfile(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid profile id"})
+		return
+	}
+
+	var req ImportProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.UpdateImportProfile(c.Request.Context(), &ledgerv1.UpdateImportProfileRequest{
+		Id:             id,
+		UserId:         userID,
+		Name:           req.Name,
+		Delimiter:      req.Delimiter,
+		DecimalComma:   req.DecimalComma,
+		DateLayouts:    req.DateLayouts,
+		Encoding:       req.Encoding,
+		SignConvention: req.SignConvention,
+		Columns:        req.Columns,
+	})
+	if err != nil {
+		writeImportProfileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toImportProfileResponse(resp.GetProfile()))
+}
+
+func (h *LedgerHandler) DeleteImportProfile(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid profile id"})
+		return
+	}
+
+	_, err = h.ledgerClient.DeleteImportProfile(c.Request.Context(), &ledgerv1.DeleteImportProfileRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		writeImportProfileError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func writeImportProfileError(c *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		case codes.AlreadyExists:
+			c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+			return
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func toImportProfileResponse(profile *ledgerv1.ImportProfile) ImportProfileResponse {
+	return ImportProfileResponse{
+		ID:             profile.GetId(),
+		Name:           profile.GetName(),
+		Delimiter:      profile.GetDelimiter(),
+		DecimalComma:   profile.GetDecimalComma(),
+		DateLayouts:    profile.GetDateLayouts(),
+		Encoding:       profile.GetEncoding(),
+		SignConvention: profile.GetSignConvention(),
+		Columns:        profile.GetColumns(),
+	}
+}
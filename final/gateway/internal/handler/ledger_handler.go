@@ -3,6 +3,8 @@ package handler
 import (
 	"encoding/base64"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -28,19 +30,40 @@ type ErrorResponse struct {
 
 
 type AddTransactionRequest struct {
-	Amount      float64 `json:"amount" binding:"required,gt=0"`
-	Category    string  `json:"category" binding:"required"`
-	Description string  `json:"description"`
-	Date        string  `json:"date"`
+	Kind        string             `json:"kind" binding:"omitempty,oneof=expense income transfer"`
+	Amount      Money              `json:"amount" binding:"required,gt=0"`
+	Currency    string             `json:"currency" binding:"omitempty,len=3,alpha"`
+	Category    string             `json:"category"`
+	Description string             `json:"description"`
+	Date        string             `json:"date"`
+	Tags        []string           `json:"tags" binding:"omitempty,max=20,dive,max=50"`
+	Splits      []TransactionSplit `json:"splits" binding:"omitempty,min=2,max=50,dive"`
+	AccountID   int64              `json:"account_id" binding:"omitempty,min=1"`
+}
+
+type TransactionSplit struct {
+	Category string `json:"category" binding:"required,max=100"`
+	Amount   Money  `json:"amount" binding:"required,gt=0"`
+	Note     string `json:"note,omitempty" binding:"max=200"`
 }
 
 type TransactionResponse struct {
-	ID            int64   `json:"id"`
-	Amount        float64 `json:"amount"`
-	Category      string  `json:"category"`
-	Description   string  `json:"description"`
-	Date          string  `json:"date"`
-	BudgetWarning string  `json:"budget_warning,omitempty"`
+	ID              int64              `json:"id"`
+	Kind            string             `json:"kind"`
+	Amount          Money              `json:"amount"`
+	Currency        string             `json:"currency"`
+	Category        string             `json:"category"`
+	Description     string             `json:"description"`
+	Date            string             `json:"date"`
+	Tags            []string           `json:"tags,omitempty"`
+	Splits          []TransactionSplit `json:"splits,omitempty"`
+	AccountID       int64              `json:"account_id,omitempty"`
+	TransferID      int64              `json:"transfer_id,omitempty"`
+	RecurringRuleID int64              `json:"recurring_rule_id,omitempty"`
+	OverBudget      bool               `json:"over_budget,omitempty"`
+	Rank            float32            `json:"rank,omitempty"`
+	BudgetExceeded  bool               `json:"budget_exceeded,omitempty"`
+	BudgetWarning   string             `json:"budget_warning,omitempty"`
 }
 
 func (h *LedgerHandler) AddTransaction(c *gin.Context) {
@@ -67,16 +90,31 @@ func (h *LedgerHandler) AddTransaction(c *gin.Context) {
 	}
 
 	resp, err := h.ledgerClient.AddTransaction(c.Request.Context(), &ledgerv1.AddTransactionRequest{
-		UserId:      userID,
-		Amount:      req.Amount,
-		Category:    req.Category,
-		Description: req.Description,
-		Date:        date,
+		UserId:        userID,
+		Kind:          req.Kind,
+		Amount:        req.Amount.Float64(),
+		AmountDecimal: req.Amount.String(),
+		Currency:      req.Currency,
+		Category:      req.Category,
+		Description:   req.Description,
+		Date:          date,
+		Tags:          req.Tags,
+		Splits:        splitsToProto(req.Splits),
+		AccountId:     req.AccountID,
 	})
 	if err != nil {
-		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
-			c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
-			return
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+				return
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+				return
+			}
 		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -85,14 +123,68 @@ func (h *LedgerHandler) AddTransaction(c *gin.Context) {
 	tx := resp.GetTransaction()
 	c.JSON(http.StatusCreated, TransactionResponse{
 		ID:             tx.GetId(),
-		Amount:         tx.GetAmount(),
+		Kind:           tx.GetKind(),
+		Amount:         moneyFromProto(tx.GetAmountDecimal(), tx.GetAmount()),
+		Currency:       tx.GetCurrency(),
 		Category:       tx.GetCategory(),
 		Description:    tx.GetDescription(),
 		Date:           tx.GetDate().AsTime().Format("2006-01-02"),
+		Tags:           tx.GetTags(),
+		Splits:         splitsFromProto(tx.GetSplits()),
+		AccountID:      tx.GetAccountId(),
+		TransferID:     tx.GetTransferId(),
+		OverBudget:     tx.GetOverBudget(),
+		BudgetExceeded: resp.GetBudgetExceeded(),
 		BudgetWarning:  resp.GetBudgetWarning(),
 	})
 }
 
+func splitsToProto(splits []TransactionSplit) []*ledgerv1.TransactionSplit {
+	result := make([]*ledgerv1.TransactionSplit, 0, len(splits))
+	for _, split := range splits {
+		result = append(result, &ledgerv1.TransactionSplit{
+			Category:      split.Category,
+			Amount:        split.Amount.Float64(),
+			AmountDecimal: split.Amount.String(),
+			Note:          split.Note,
+		})
+	}
+	return result
+}
+
+func splitsFromProto(splits []*ledgerv1.TransactionSplit) []TransactionSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+	result := make([]TransactionSplit, 0, len(splits))
+	for _, split := range splits {
+		result = append(result, TransactionSplit{
+			Category: split.GetCategory(),
+			Amount:   moneyFromProto(split.GetAmountDecimal(), split.GetAmount()),
+			Note:     split.GetNote(),
+		})
+	}
+	return result
+}
+
+func toTransactionResponse(tx *ledgerv1.Transaction) TransactionResponse {
+	return TransactionResponse{
+		ID:              tx.GetId(),
+		Kind:            tx.GetKind(),
+		Amount:          moneyFromProto(tx.GetAmountDecimal(), tx.GetAmount()),
+		Currency:        tx.GetCurrency(),
+		Category:        tx.GetCategory(),
+		Description:     tx.GetDescription(),
+		Date:            tx.GetDate().AsTime().Format("2006-01-02"),
+		Tags:            tx.GetTags(),
+		Splits:          splitsFromProto(tx.GetSplits()),
+		AccountID:       tx.GetAccountId(),
+		TransferID:      tx.GetTransferId(),
+		RecurringRuleID: tx.GetRecurringRuleId(),
+		OverBudget:      tx.GetOverBudget(),
+	}
+}
+
 func (h *LedgerHandler) GetTransactions(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 	if userID == 0 {
@@ -101,8 +193,49 @@ func (h *LedgerHandler) GetTransactions(c *gin.Context) {
 	}
 
 	req := &ledgerv1.GetTransactionsRequest{
-		UserId:   userID,
-		Category: c.Query("category"),
+		UserId:    userID,
+		Category:  c.Query("category"),
+		PageToken: c.Query("cursor"),
+		Sort:      c.Query("sort"),
+		TagMatch:  c.Query("tag_match"),
+		Query:     c.Query("q"),
+		Currency:  c.Query("currency"),
+	}
+	if tags := c.Query("tags"); tags != "" {
+		req.Tags = strings.Split(tags, ",")
+	}
+	if accountID := c.Query("account_id"); accountID != "" {
+		id, err := strconv.ParseInt(accountID, 10, 64)
+		if err != nil || id <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid account id"})
+			return
+		}
+		req.AccountId = id
+	}
+	if minAmount := c.Query("min_amount"); minAmount != "" {
+		m, err := ParseMoney(minAmount)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "min_amount: " + err.Error()})
+			return
+		}
+		req.MinAmount, req.MinAmountDecimal = m.Float64(), m.String()
+	}
+	if maxAmount := c.Query("max_amount"); maxAmount != "" {
+		m, err := ParseMoney(maxAmount)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "max_amount: " + err.Error()})
+			return
+		}
+		req.MaxAmount, req.MaxAmountDecimal = m.Float64(), m.String()
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+			return
+		}
+		req.PageSize = int32(n)
 	}
 
 	if from := c.Query("from"); from != "" {
@@ -120,6 +253,10 @@ func (h *LedgerHandler) GetTransactions(c *gin.Context) {
 
 	resp, err := h.ledgerClient.GetTransactions(c.Request.Context(), req)
 	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -127,29 +264,186 @@ func (h *LedgerHandler) GetTransactions(c *gin.Context) {
 	transactions := make([]TransactionResponse, 0, len(resp.GetTransactions()))
 	for _, tx := range resp.GetTransactions() {
 		transactions = append(transactions, TransactionResponse{
-			ID:          tx.GetId(),
-			Amount:      tx.GetAmount(),
-			Category:    tx.GetCategory(),
-			Description: tx.GetDescription(),
-			Date:        tx.GetDate().AsTime().Format("2006-01-02"),
+			ID:              tx.GetId(),
+			Kind:            tx.GetKind(),
+			Amount:          moneyFromProto(tx.GetAmountDecimal(), tx.GetAmount()),
+			Currency:        tx.GetCurrency(),
+			Category:        tx.GetCategory(),
+			Description:     tx.GetDescription(),
+			Date:            tx.GetDate().AsTime().Format("2006-01-02"),
+			Tags:            tx.GetTags(),
+			Splits:          splitsFromProto(tx.GetSplits()),
+			AccountID:       tx.GetAccountId(),
+			TransferID:      tx.GetTransferId(),
+			RecurringRuleID: tx.GetRecurringRuleId(),
+			OverBudget:      tx.GetOverBudget(),
+			Rank:            tx.GetRank(),
 		})
 	}
 
+	if next := resp.GetNextPageToken(); next != "" {
+		c.Header("X-Next-Cursor", next)
+	}
 	c.JSON(http.StatusOK, transactions)
 }
 
+type UpdateTransactionRequest struct {
+	Kind        string             `json:"kind" binding:"omitempty,oneof=expense income transfer"`
+	Amount      Money              `json:"amount" binding:"required,gt=0"`
+	Currency    string             `json:"currency" binding:"omitempty,len=3,alpha"`
+	Category    string             `json:"category" binding:"required_without=Splits"`
+	Description string             `json:"description"`
+	Date        string             `json:"date"`
+	Tags        []string           `json:"tags" binding:"omitempty,max=20,dive,max=50"`
+	Splits      []TransactionSplit `json:"splits" binding:"omitempty,min=2,max=50,dive"`
+	AccountID   int64              `json:"account_id" binding:"omitempty,min=1"`
+}
+
+func (h *LedgerHandler) UpdateTransaction(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transaction id"})
+		return
+	}
+
+	var req UpdateTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var date *timestamppb.Timestamp
+	if req.Date != "" {
+		t, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date format, use YYYY-MM-DD"})
+			return
+		}
+		date = timestamppb.New(t)
+	}
+
+	resp, err := h.ledgerClient.UpdateTransaction(c.Request.Context(), &ledgerv1.UpdateTransactionRequest{
+		Id:            id,
+		UserId:        userID,
+		Kind:          req.Kind,
+		Amount:        req.Amount.Float64(),
+		AmountDecimal: req.Amount.String(),
+		Currency:      req.Currency,
+		Category:      req.Category,
+		Description:   req.Description,
+		Date:          date,
+		Tags:          req.Tags,
+		Splits:        splitsToProto(req.Splits),
+		AccountId:     req.AccountID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+				return
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+				return
+			}
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	tx := resp.GetTransaction()
+	c.JSON(http.StatusOK, TransactionResponse{
+		ID:             tx.GetId(),
+		Kind:           tx.GetKind(),
+		Amount:         moneyFromProto(tx.GetAmountDecimal(), tx.GetAmount()),
+		Currency:       tx.GetCurrency(),
+		Category:       tx.GetCategory(),
+		Description:    tx.GetDescription(),
+		Date:           tx.GetDate().AsTime().Format("2006-01-02"),
+		Tags:           tx.GetTags(),
+		Splits:         splitsFromProto(tx.GetSplits()),
+		AccountID:      tx.GetAccountId(),
+		TransferID:     tx.GetTransferId(),
+		OverBudget:     tx.GetOverBudget(),
+		BudgetExceeded: resp.GetBudgetExceeded(),
+		BudgetWarning:  resp.GetBudgetWarning(),
+	})
+}
+
+func (h *LedgerHandler) DeleteTransaction(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transaction id"})
+		return
+	}
+
+	_, err = h.ledgerClient.DeleteTransaction(c.Request.Context(), &ledgerv1.DeleteTransactionRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
 
 type SetBudgetRequest struct {
 	Category    string  `json:"category" binding:"required"`
-	LimitAmount float64 `json:"limit_amount" binding:"required,gt=0"`
-	Period      string  `json:"period"`
+	LimitAmount Money   `json:"limit_amount" binding:"required,gt=0"`
+	Currency    string  `json:"currency" binding:"omitempty,len=3,alpha"`
+	Period      string  `json:"period" binding:"omitempty,oneof=daily weekly monthly quarterly yearly"`
+	StartDay    int32   `json:"start_day" binding:"omitempty,min=1,max=31"`
+	Rollover    bool    `json:"rollover"`
+	Enforcement string  `json:"enforcement" binding:"omitempty,oneof=hard soft warn"`
+	Thresholds  []int32 `json:"warning_thresholds" binding:"omitempty,max=10,dive,min=1,max=1000"`
 }
 
 type BudgetResponse struct {
 	ID          int64   `json:"id"`
 	Category    string  `json:"category"`
-	LimitAmount float64 `json:"limit_amount"`
+	LimitAmount Money   `json:"limit_amount"`
+	Currency    string  `json:"currency"`
 	Period      string  `json:"period"`
+	StartDay    int32   `json:"start_day"`
+	Rollover    bool    `json:"rollover"`
+	Enforcement string  `json:"enforcement"`
+	Thresholds  []int32 `json:"warning_thresholds"`
+	ValidFrom   string  `json:"valid_from,omitempty"`
+	ValidTo     string  `json:"valid_to,omitempty"`
+}
+
+type BudgetStatusResponse struct {
+	Budget     BudgetResponse `json:"budget"`
+	Currency   string         `json:"currency"`
+	PeriodFrom string         `json:"period_from"`
+	PeriodTo   string         `json:"period_to"`
+	Limit      Money          `json:"limit"`
+	Spent      Money          `json:"spent"`
+	Remaining  Money          `json:"remaining"`
+	Percentage float64        `json:"percentage"`
+	Projected  Money          `json:"projected"`
 }
 
 func (h *LedgerHandler) SetBudget(c *gin.Context) {
@@ -170,23 +464,33 @@ func (h *LedgerHandler) SetBudget(c *gin.Context) {
 	}
 
 	resp, err := h.ledgerClient.SetBudget(c.Request.Context(), &ledgerv1.SetBudgetRequest{
-		UserId:      userID,
-		Category:    req.Category,
-		LimitAmount: req.LimitAmount,
-		Period:      req.Period,
+		UserId:             userID,
+		Category:           req.Category,
+		LimitAmount:        req.LimitAmount.Float64(),
+		LimitAmountDecimal: req.LimitAmount.String(),
+		Currency:           req.Currency,
+		Period:             req.Period,
+		StartDay:           req.StartDay,
+		Rollover:           req.Rollover,
+		Enforcement:        req.Enforcement,
+		WarningThresholds:  req.Thresholds,
 	})
 	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+				return
+			}
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	budget := resp.GetBudget()
-	c.JSON(http.StatusCreated, BudgetResponse{
-		ID:          budget.GetId(),
-		Category:    budget.GetCategory(),
-		LimitAmount: budget.GetLimitAmount(),
-		Period:      budget.GetPeriod(),
-	})
+	c.JSON(http.StatusCreated, toBudgetResponse(resp.GetBudget()))
 }
 
 func (h *LedgerHandler) GetBudgets(c *gin.Context) {
@@ -206,28 +510,145 @@ func (h *LedgerHandler) GetBudgets(c *gin.Context) {
 
 	budgets := make([]BudgetResponse, 0, len(resp.GetBudgets()))
 	for _, b := range resp.GetBudgets() {
-		budgets = append(budgets, BudgetResponse{
-			ID:          b.GetId(),
-			Category:    b.GetCategory(),
-			LimitAmount: b.GetLimitAmount(),
-			Period:      b.GetPeriod(),
-		})
+		budgets = append(budgets, toBudgetResponse(b))
 	}
 
 	c.JSON(http.StatusOK, budgets)
 }
 
+func (h *LedgerHandler) GetBudgetHistory(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	resp, err := h.ledgerClient.GetBudgetHistory(c.Request.Context(), &ledgerv1.GetBudgetHistoryRequest{
+		UserId:   userID,
+		Category: c.Param("category"),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	versions := make([]BudgetResponse, 0, len(resp.GetVersions()))
+	for _, b := range resp.GetVersions() {
+		versions = append(versions, toBudgetResponse(b))
+	}
+
+	c.JSON(http.StatusOK, versions)
+}
+
+func (h *LedgerHandler) DeleteBudget(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	_, err := h.ledgerClient.DeleteBudget(c.Request.Context(), &ledgerv1.DeleteBudgetRequest{
+		UserId:   userID,
+		Category: c.Param("category"),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *LedgerHandler) GetBudgetStatus(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	resp, err := h.ledgerClient.GetBudgetStatus(c.Request.Context(), &ledgerv1.GetBudgetStatusRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": st.Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	statuses := make([]BudgetStatusResponse, 0, len(resp.GetStatuses()))
+	for _, st := range resp.GetStatuses() {
+		statuses = append(statuses, BudgetStatusResponse{
+			Budget:     toBudgetResponse(st.GetBudget()),
+			Currency:   st.GetCurrency(),
+			PeriodFrom: st.GetPeriodFrom().AsTime().Format("2006-01-02"),
+			PeriodTo:   st.GetPeriodTo().AsTime().Format("2006-01-02"),
+			Limit:      moneyFromProto(st.GetLimitDecimal(), st.GetLimit()),
+			Spent:      moneyFromProto(st.GetSpentDecimal(), st.GetSpent()),
+			Remaining:  moneyFromProto(st.GetRemainingDecimal(), st.GetRemaining()),
+			Percentage: st.GetPercentage(),
+			Projected:  moneyFromProto(st.GetProjectedDecimal(), st.GetProjected()),
+		})
+	}
+
+	c.JSON(http.StatusOK, statuses)
+}
+
+func toBudgetResponse(b *ledgerv1.Budget) BudgetResponse {
+	resp := BudgetResponse{
+		ID:          b.GetId(),
+		Category:    b.GetCategory(),
+		LimitAmount: moneyFromProto(b.GetLimitAmountDecimal(), b.GetLimitAmount()),
+		Currency:    b.GetCurrency(),
+		Period:      b.GetPeriod(),
+		StartDay:    b.GetStartDay(),
+		Rollover:    b.GetRollover(),
+		Enforcement: b.GetEnforcement(),
+		Thresholds:  b.GetWarningThresholds(),
+	}
+	if b.GetValidFrom() != nil {
+		resp.ValidFrom = b.GetValidFrom().AsTime().Format("2006-01-02")
+	}
+	if b.GetValidTo() != nil {
+		resp.ValidTo = b.GetValidTo().AsTime().Format("2006-01-02")
+	}
+	return resp
+}
+
+
+type CurrencyTotalResponse struct {
+	Currency  string `json:"currency"`
+	Amount    Money  `json:"amount"`
+	Converted Money  `json:"converted"`
+}
 
 type CategorySummaryResponse struct {
-	Category         string  `json:"category"`
-	Total            float64 `json:"total"`
-	BudgetLimit      float64 `json:"budget_limit,omitempty"`
-	BudgetPercentage float64 `json:"budget_percentage,omitempty"`
+	Category         string                  `json:"category"`
+	Parent           string                  `json:"parent,omitempty"`
+	Total            Money                   `json:"total"`
+	RollupTotal      Money                   `json:"rollup_total"`
+	Currencies       []CurrencyTotalResponse `json:"currencies,omitempty"`
+	BudgetLimit      Money                   `json:"budget_limit,omitempty"`
+	BudgetPercentage float64                 `json:"budget_percentage,omitempty"`
 }
 
 type ReportResponse struct {
+	BaseCurrency  string                    `json:"base_currency"`
 	Categories    []CategorySummaryResponse `json:"categories"`
-	TotalExpenses float64                   `json:"total_expenses"`
+	TotalExpenses Money                     `json:"total_expenses"`
+	TotalIncome   Money                     `json:"total_income"`
+	NetBalance    Money                     `json:"net_balance"`
+	SavingsRate   float64                   `json:"savings_rate"`
 	From          string                    `json:"from"`
 	To            string                    `json:"to"`
 }
@@ -265,6 +686,10 @@ func (h *LedgerHandler) GetReport(c *gin.Context) {
 		To:     timestamppb.New(to),
 	})
 	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": st.Message()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -273,29 +698,87 @@ func (h *LedgerHandler) GetReport(c *gin.Context) {
 	for _, cat := range resp.GetCategories() {
 		categories = append(categories, CategorySummaryResponse{
 			Category:         cat.GetCategory(),
-			Total:            cat.GetTotal(),
-			BudgetLimit:      cat.GetBudgetLimit(),
+			Parent:           cat.GetParent(),
+			Total:            moneyFromProto(cat.GetTotalDecimal(), cat.GetTotal()),
+			RollupTotal:      moneyFromProto(cat.GetRollupTotalDecimal(), cat.GetRollupTotal()),
+			Currencies:       toCurrencyTotalResponses(cat.GetCurrencies()),
+			BudgetLimit:      moneyFromProto(cat.GetBudgetLimitDecimal(), cat.GetBudgetLimit()),
 			BudgetPercentage: cat.GetBudgetPercentage(),
 		})
 	}
 
 	c.JSON(http.StatusOK, ReportResponse{
+		BaseCurrency:  resp.GetBaseCurrency(),
 		Categories:    categories,
-		TotalExpenses: resp.GetTotalExpenses(),
+		TotalExpenses: moneyFromProto(resp.GetTotalExpensesDecimal(), resp.GetTotalExpenses()),
+		TotalIncome:   moneyFromProto(resp.GetTotalIncomeDecimal(), resp.GetTotalIncome()),
+		NetBalance:    moneyFromProto(resp.GetNetBalanceDecimal(), resp.GetNetBalance()),
+		SavingsRate:   resp.GetSavingsRate(),
 		From:          fromStr,
 		To:            toStr,
 	})
 }
 
+func toCurrencyTotalResponses(totals []*ledgerv1.CurrencyTotal) []CurrencyTotalResponse {
+	currencies := make([]CurrencyTotalResponse, 0, len(totals))
+	for _, ct := range totals {
+		currencies = append(currencies, CurrencyTotalResponse{
+			Currency:  ct.GetCurrency(),
+			Amount:    moneyFromProto(ct.GetAmountDecimal(), ct.GetAmount()),
+			Converted: moneyFromProto(ct.GetConvertedDecimal(), ct.GetConverted()),
+		})
+	}
+	return currencies
+}
+
 
 type ImportCSVRequest struct {
-	CSVData string `json:"csv_data" binding:"required"`
+	CSVData       string `json:"csv_data" binding:"required"`
+	DuplicateMode string `json:"duplicate_mode" binding:"omitempty,oneof=skip force flag"`
+	Preview       bool   `json:"preview"`
+	Atomic        bool   `json:"atomic"`
+	Profile       string `json:"profile" binding:"max=100"`
+	Format        string `json:"format" binding:"omitempty,oneof=csv ofx qfx qif"`
 }
 
 type ImportCSVResponse struct {
-	ImportedCount int32    `json:"imported_count"`
-	SkippedCount  int32    `json:"skipped_count"`
-	Errors        []string `json:"errors,omitempty"`
+	ImportedCount  int32                     `json:"imported_count"`
+	SkippedCount   int32                     `json:"skipped_count"`
+	Errors         []string                  `json:"errors,omitempty"`
+	DuplicateCount int32                     `json:"duplicate_count,omitempty"`
+	Duplicates     []ImportDuplicateResponse `json:"duplicates,omitempty"`
+	Rows           []ImportRowResponse       `json:"rows,omitempty"`
+	BudgetImpact   []BudgetImpactResponse    `json:"budget_impact,omitempty"`
+	RolledBack     bool                      `json:"rolled_back,omitempty"`
+}
+
+type ImportDuplicateResponse struct {
+	Line                  int32               `json:"line,omitempty"`
+	Transaction           TransactionResponse `json:"transaction"`
+	ExistingTransactionID int64               `json:"existing_transaction_id,omitempty"`
+	Probable              bool                `json:"probable"`
+	Imported              bool                `json:"imported"`
+}
+
+type ImportRowResponse struct {
+	Line                  int32                `json:"line"`
+	Status                string               `json:"status"`
+	Transaction           *TransactionResponse `json:"transaction,omitempty"`
+	Error                 string               `json:"error,omitempty"`
+	Warning               string               `json:"warning,omitempty"`
+	ExistingTransactionID int64                `json:"existing_transaction_id,omitempty"`
+}
+
+type BudgetImpactResponse struct {
+	Category    string `json:"category"`
+	Enforcement string `json:"enforcement"`
+	Currency    string `json:"currency"`
+	PeriodFrom  string `json:"period_from"`
+	PeriodTo    string `json:"period_to"`
+	Limit       Money  `json:"limit"`
+	Spent       Money  `json:"spent"`
+	Incoming    Money  `json:"incoming"`
+	Exceeded    bool   `json:"exceeded"`
 }
 
 func (h *LedgerHandler) ImportCSV(c *gin.Context) {
@@ -318,19 +801,84 @@ func (h *LedgerHandler) ImportCSV(c *gin.Context) {
 	}
 
 	resp, err := h.ledgerClient.ImportCSV(c.Request.Context(), &ledgerv1.ImportCSVRequest{
-		UserId:  userID,
-		CsvData: csvData,
+		UserId:        userID,
+		CsvData:       csvData,
+		DuplicateMode: req.DuplicateMode,
+		Preview:       req.Preview,
+		Atomic:        req.Atomic,
+		Profile:       req.Profile,
+		Format:        req.Format,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeImportError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, ImportCSVResponse{
-		ImportedCount: resp.GetImportedCount(),
-		SkippedCount:  resp.GetSkippedCount(),
-		Errors:        resp.GetErrors(),
-	})
+	c.JSON(http.StatusOK, toImportCSVResponse(resp))
+}
+
+func writeImportError(c *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		case codes.Aborted:
+			c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+			return
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func toImportCSVResponse(resp *ledgerv1.ImportCSVResponse) ImportCSVResponse {
+	result := ImportCSVResponse{
+		ImportedCount:  resp.GetImportedCount(),
+		SkippedCount:   resp.GetSkippedCount(),
+		Errors:         resp.GetErrors(),
+		DuplicateCount: resp.GetDuplicateCount(),
+		RolledBack:     resp.GetRolledBack(),
+	}
+	for _, duplicate := range resp.GetDuplicates() {
+		result.Duplicates = append(result.Duplicates, ImportDuplicateResponse{
+			Line:                  duplicate.GetLine(),
+			Transaction:           toTransactionResponse(duplicate.GetTransaction()),
+			ExistingTransactionID: duplicate.GetExistingTransactionId(),
+			Probable:              duplicate.GetProbable(),
+			Imported:              duplicate.GetImported(),
+		})
+	}
+	for _, row := range resp.GetRows() {
+		rowResp := ImportRowResponse{
+			Line:                  row.GetLine(),
+			Status:                row.GetStatus(),
+			Error:                 row.GetError(),
+			Warning:               row.GetWarning(),
+			ExistingTransactionID: row.GetExistingTransactionId(),
+		}
+		if row.GetTransaction() != nil {
+			tx := toTransactionResponse(row.GetTransaction())
+			rowResp.Transaction = &tx
+		}
+		result.Rows = append(result.Rows, rowResp)
+	}
+	for _, impact := range resp.GetBudgetImpact() {
+		result.BudgetImpact = append(result.BudgetImpact, BudgetImpactResponse{
+			Category:    impact.GetCategory(),
+			Enforcement: impact.GetEnforcement(),
+			Currency:    impact.GetCurrency(),
+			PeriodFrom:  impact.GetPeriodFrom().AsTime().Format("2006-01-02"),
+			PeriodTo:    impact.GetPeriodTo().AsTime().Format("2006-01-02"),
+			Limit:       moneyFromProto(impact.GetLimitDecimal(), impact.GetLimit()),
+			Spent:       moneyFromProto(impact.GetSpentDecimal(), impact.GetSpent()),
+			Incoming:    moneyFromProto(impact.GetIncomingDecimal(), impact.GetIncoming()),
+			Exceeded:    impact.GetExceeded(),
+		})
+	}
+	return result
 }
 
 func (h *LedgerHandler) ExportCSV(c *gin.Context) {
@@ -375,6 +923,10 @@ func (h *LedgerHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware *middl
 	{
 		transactions.POST("", h.AddTransaction)
 		transactions.GET("", h.GetTransactions)
+		transactions.GET("/export", h.ExportTransactions)
+		transactions.GET("/export/stream", h.ExportStream)
+		transactions.PUT("/:id", h.UpdateTransaction)
+		transactions.DELETE("/:id", h.DeleteTransaction)
 	}
 
 	budgets := r.Group("/budgets")
@@ -382,12 +934,73 @@ func (h *LedgerHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware *middl
 	{
 		budgets.POST("", h.SetBudget)
 		budgets.GET("", h.GetBudgets)
+		budgets.GET("/status", h.GetBudgetStatus)
+		budgets.GET("/:category/history", h.GetBudgetHistory)
+		budgets.DELETE("/:category", h.DeleteBudget)
 	}
 
 	reports := r.Group("/reports")
 	reports.Use(authMiddleware.RequireAuth())
 	{
 		reports.GET("", h.GetReport)
+		reports.GET("/tags", h.GetTagReport)
+		reports.GET("/compare", h.CompareReports)
+		reports.GET("/trends", h.GetTrends)
+	}
+
+	settings := r.Group("/settings")
+	settings.Use(authMiddleware.RequireAuth())
+	{
+		settings.GET("", h.GetSettings)
+		settings.PUT("", h.UpdateSettings)
+	}
+
+	rates := r.Group("/rates")
+	rates.Use(authMiddleware.RequireAuth())
+	{
+		rates.POST("", h.SetExchangeRates)
+		rates.GET("", h.GetExchangeRates)
+		rates.POST("/import", h.ImportExchangeRates)
+	}
+
+	recurring := r.Group("/recurring")
+	recurring.Use(authMiddleware.RequireAuth())
+	{
+		recurring.POST("", h.CreateRecurringRule)
+		recurring.GET("", h.GetRecurringRules)
+		recurring.PUT("/:id", h.UpdateRecurringRule)
+		recurring.DELETE("/:id", h.DeleteRecurringRule)
+	}
+
+	categories := r.Group("/categories")
+	categories.Use(authMiddleware.RequireAuth())
+	{
+		categories.POST("", h.CreateCategory)
+		categories.GET("", h.GetCategories)
+		categories.PUT("/:id", h.UpdateCategory)
+		categories.DELETE("/:id", h.DeleteCategory)
+		categories.POST("/:id/merge", h.MergeCategories)
+	}
+
+	accounts := r.Group("/accounts")
+	accounts.Use(authMiddleware.RequireAuth())
+	{
+		accounts.POST("", h.CreateAccount)
+		accounts.GET("", h.GetAccounts)
+		accounts.PUT("/:id", h.UpdateAccount)
+		accounts.DELETE("/:id", h.DeleteAccount)
+		accounts.GET("/:id/balances", h.GetAccountBalances)
+		accounts.POST("/transfers", h.CreateTransfer)
+	}
+
+	rules := r.Group("/rules")
+	rules.Use(authMiddleware.RequireAuth())
+	{
+		rules.POST("", h.CreateCategoryRule)
+		rules.GET("", h.GetCategoryRules)
+		rules.PUT("/:id", h.UpdateCategoryRule)
+		rules.DELETE("/:id", h.DeleteCategoryRule)
+		rules.POST("/test", h.TestCategoryRule)
 	}
 
 	csv := r.Group("/csv")
@@ -395,6 +1008,20 @@ func (h *LedgerHandler) RegisterRoutes(r *gin.RouterGroup, authMiddleware *middl
 	{
 		csv.POST("/import", h.ImportCSV)
 		csv.GET("/export", h.ExportCSV)
+		csv.POST("/profiles", h.CreateImportProfile)
+		csv.GET("/profiles", h.GetImportProfiles)
+		csv.PUT("/profiles/:id", h.UpdateImportProfile)
+		csv.DELETE("/profiles/:id", h.DeleteImportProfile)
+	}
+
+	imports := r.Group("/imports")
+	imports.Use(authMiddleware.RequireAuth())
+	{
+		imports.POST("/upload", h.UploadStatement)
+		imports.POST("/stream", h.ImportStream)
+		imports.POST("", h.SubmitImport)
+		imports.GET("/:id", h.GetImport)
+		imports.POST("/:id/cancel", h.CancelImport)
 	}
 }
 
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Money int64
+
+var errInvalidMoney = errors.New("invalid money amount: expected a decimal with at most 2 fraction digits")
+
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return 0, errInvalidMoney
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	if len(fracPart) > 2 {
+		return 0, errInvalidMoney
+	}
+	for len(fracPart) < 2 {
+		fracPart += "0"
+	}
+
+	units, err := strconv.ParseUint(intPart+fracPart, 10, 63)
+	if err != nil {
+		return 0, errInvalidMoney
+	}
+	if negative {
+		return -Money(units), nil
+	}
+	return Money(units), nil
+}
+
+func MoneyFromFloat(f float64) Money {
+	return Money(math.Round(f * 100))
+}
+
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+func (m Money) String() string {
+	sign := ""
+	v := int64(m)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	parsed, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+func moneyFromProto(decimal string, value float64) Money {
+	if decimal != "" {
+		if m, err := ParseMoney(decimal); err == nil {
+			return m
+		}
+	}
+	return MoneyFromFloat(value)
+}
@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type RecurringRuleRequest struct {
+	Kind        string `json:"kind" binding:"omitempty,oneof=expense income transfer"`
+	Amount      Money  `json:"amount" binding:"required,gt=0"`
+	Currency    string `json:"currency" binding:"omitempty,len=3,alpha"`
+	Category    string `json:"category" binding:"required"`
+	Description string `json:"description"`
+	Frequency   string `json:"frequency" binding:"required,oneof=daily weekly monthly yearly"`
+	Interval    int32  `json:"interval" binding:"omitempty,min=1,max=1000"`
+	StartDate   string `json:"start_date" binding:"required"`
+	EndDate     string `json:"end_date"`
+}
+
+type RecurringRuleResponse struct {
+	ID          int64  `json:"id"`
+	Kind        string `json:"kind"`
+	Amount      Money  `json:"amount"`
+	Currency    string `json:"currency"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	Frequency   string `json:"frequency"`
+	Interval    int32  `json:"interval"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date,omitempty"`
+	NextDate    string `json:"next_date,omitempty"`
+	LastError   string `json:"last_error,omitempty"`
+}
+
+func (h *LedgerHandler) CreateRecurringRule(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req RecurringRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	startDate, endDate, ok := parseRecurringDates(c, &req)
+	if !ok {
+		return
+	}
+
+	resp, err := h.ledgerClient.CreateRecurringRule(c.Request.Context(), &ledgerv1.CreateRecurringRuleRequest{
+		UserId:        userID,
+		Kind:          req.Kind,
+		Amount:        req.Amount.Float64(),
+		AmountDecimal: req.Amount.String(),
+		Currency:      req.Currency,
+		Category:      req.Category,
+		Description:   req.Description,
+		Frequency:     req.Frequency,
+		Interval:      req.Interval,
+		StartDate:     startDate,
+		EndDate:       endDate,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+				return
+			}
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toRecurringRuleResponse(resp.GetRule()))
+}
+
+func (h *LedgerHandler) GetRecurringRules(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	resp, err := h.ledgerClient.GetRecurringRules(c.Request.Context(), &ledgerv1.GetRecurringRulesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	rules := make([]RecurringRuleResponse, 0, len(resp.GetRules()))
+	for _, rule := range resp.GetRules() {
+		rules = append(rules, toRecurringRuleResponse(rule))
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+func (h *LedgerHandler) UpdateRecurringRule(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid recurring rule id"})
+		return
+	}
+
+	var req RecurringRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	startDate, endDate, ok := parseRecurringDates(c, &req)
+	if !ok {
+		return
+	}
+
+	resp, err := h.ledgerClient.UpdateRecurringRule(c.Request.Context(), &ledgerv1.UpdateRecurringRuleRequest{
+		Id:            id,
+		UserId:        userID,
+		Kind:          req.Kind,
+		Amount:        req.Amount.Float64(),
+		AmountDecimal: req.Amount.String(),
+		Currency:      req.Currency,
+		Category:      req.Category,
+		Description:   req.Description,
+		Frequency:     req.Frequency,
+		Interval:      req.Interval,
+		StartDate:     startDate,
+		EndDate:       endDate,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+				return
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+				return
+			}
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, toRecurringRuleResponse(resp.GetRule()))
+}
+
+func (h *LedgerHandler) DeleteRecurringRule(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid recurring rule id"})
+		return
+	}
+
+	_, err = h.ledgerClient.DeleteRecurringRule(c.Request.Context(), &ledgerv1.DeleteRecurringRuleRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseRecurringDates(c *gin.Context, req *RecurringRuleRequest) (*timestamppb.Timestamp, *timestamppb.Timestamp, bool) {
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid start_date format, use YYYY-MM-DD"})
+		return nil, nil, false
+	}
+
+	var endDate *timestamppb.Timestamp
+	if req.EndDate != "" {
+		end, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid end_date format, use YYYY-MM-DD"})
+			return nil, nil, false
+		}
+		endDate = timestamppb.New(end)
+	}
+
+	return timestamppb.New(start), endDate, true
+}
+
+func toRecurringRuleResponse(rule *ledgerv1.RecurringRule) RecurringRuleResponse {
+	resp := RecurringRuleResponse{
+		ID:          rule.GetId(),
+		Kind:        rule.GetKind(),
+		Amount:      moneyFromProto(rule.GetAmountDecimal(), rule.GetAmount()),
+		Currency:    rule.GetCurrency(),
+		Category:    rule.GetCategory(),
+		Description: rule.GetDescription(),
+		Frequency:   rule.GetFrequency(),
+		Interval:    rule.GetInterval(),
+		StartDate:   rule.GetStartDate().AsTime().Format("2006-01-02"),
+		LastError:   rule.GetLastError(),
+	}
+	if rule.GetEndDate() != nil {
+		resp.EndDate = rule.GetEndDate().AsTime().Format("2006-01-02")
+	}
+	if rule.GetNextDate() != nil {
+		resp.NextDate = rule.GetNextDate().AsTime().Format("2006-01-02")
+	}
+	return resp
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type CategoryRuleRequest struct {
+	MatchType string   `json:"match_type" binding:"omitempty,oneof=contains regex"`
+	Pattern   string   `json:"pattern" binding:"max=200"`
+	MinAmount Money    `json:"min_amount" binding:"omitempty,gt=0"`
+	MaxAmount Money    `json:"max_amount" binding:"omitempty,gt=0"`
+	Category  string   `json:"category" binding:"required,max=100"`
+	Tags      []string `json:"tags" binding:"omitempty,max=20,dive,max=50"`
+	Priority  int32    `json:"priority"`
+}
+
+type TestCategoryRuleRequest struct {
+	CategoryRuleRequest
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type CategoryRuleResponse struct {
+	ID        int64    `json:"id"`
+	MatchType string   `json:"match_type"`
+	Pattern   string   `json:"pattern,omitempty"`
+	MinAmount Money    `json:"min_amount,omitempty"`
+	MaxAmount Money    `json:"max_amount,omitempty"`
+	Category  string   `json:"category"`
+	Tags      []string `json:"tags,omitempty"`
+	Priority  int32    `json:"priority"`
+}
+
+type TestCategoryRuleResponse struct {
+	MatchedCount int32                 `json:"matched_count"`
+	ChangedCount int32                 `json:"changed_count"`
+	Samples      []TransactionResponse `json:"samples"`
+}
+
+func (h *LedgerHandler) CreateCategoryRule(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req CategoryRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.CreateCategoryRule(c.Request.Context(), &ledgerv1.CreateCategoryRuleRequest{
+		UserId:           userID,
+		MatchType:        req.MatchType,
+		Pattern:          req.Pattern,
+		MinAmount:        req.MinAmount.Float64(),
+		MinAmountDecimal: optionalMoneyString(req.MinAmount),
+		MaxAmount:        req.MaxAmount.Float64(),
+		MaxAmountDecimal: optionalMoneyString(req.MaxAmount),
+		Category:         req.Category,
+		Tags:             req.Tags,
+		Priority:         req.Priority,
+	})
+	if err != nil {
+		writeRuleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCategoryRuleResponse(resp.GetRule()))
+}
+
+func (h *LedgerHandler) GetCategoryRules(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	resp, err := h.ledgerClient.GetCategoryRules(c.Request.Context(), &ledgerv1.GetCategoryRulesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	rules := make([]CategoryRuleResponse, 0, len(resp.GetRules()))
+	for _, rule := range resp.GetRules() {
+		rules = append(rules, toCategoryRuleResponse(rule))
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+func (h *LedgerHandler) UpdateCategoryRule(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	var req CategoryRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ledgerClient.UpdateCategoryRule(c.Request.Context(), &ledgerv1.UpdateCategoryRuleRequest{
+		Id:               id,
+		UserId:           userID,
+		MatchType:        req.MatchType,
+		Pattern:          req.Pattern,
+		MinAmount:        req.MinAmount.Float64(),
+		MinAmountDecimal: optionalMoneyString(req.MinAmount),
+		MaxAmount:        req.MaxAmount.Float64(),
+		MaxAmountDecimal: optionalMoneyString(req.MaxAmount),
+		Category:         req.Category,
+		Tags:             req.Tags,
+		Priority:         req.Priority,
+	})
+	if err != nil {
+		writeRuleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCategoryRuleResponse(resp.GetRule()))
+}
+
+func (h *LedgerHandler) DeleteCategoryRule(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	_, err = h.ledgerClient.DeleteCategoryRule(c.Request.Context(), &ledgerv1.DeleteCategoryRuleRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		writeRuleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *LedgerHandler) TestCategoryRule(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req TestCategoryRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	testReq := &ledgerv1.TestCategoryRuleRequest{
+		UserId:           userID,
+		MatchType:        req.MatchType,
+		Pattern:          req.Pattern,
+		MinAmount:        req.MinAmount.Float64(),
+		MinAmountDecimal: optionalMoneyString(req.MinAmount),
+		MaxAmount:        req.MaxAmount.Float64(),
+		MaxAmountDecimal: optionalMoneyString(req.MaxAmount),
+		Category:         req.Category,
+		Tags:             req.Tags,
+	}
+	if req.From != "" {
+		from, err := time.Parse("2006-01-02", req.From)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid from format, use YYYY-MM-DD"})
+			return
+		}
+		testReq.From = timestamppb.New(from)
+	}
+	if req.To != "" {
+		to, err := time.Parse("2006-01-02", req.To)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid to format, use YYYY-MM-DD"})
+			return
+		}
+		testReq.To = timestamppb.New(to)
+	}
+
+	resp, err := h.ledgerClient.TestCategoryRule(c.Request.Context(), testReq)
+	if err != nil {
+		writeRuleError(c, err)
+		return
+	}
+
+	samples := make([]TransactionResponse, 0, len(resp.GetSamples()))
+	for _, tx := range resp.GetSamples() {
+		samples = append(samples, toTransactionResponse(tx))
+	}
+
+	c.JSON(http.StatusOK, TestCategoryRuleResponse{
+		MatchedCount: resp.GetMatchedCount(),
+		ChangedCount: resp.GetChangedCount(),
+		Samples:      samples,
+	})
+}
+
+func writeRuleError(c *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			return
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			return
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func optionalMoneyString(m Money) string {
+	if m == 0 {
+		return ""
+	}
+	return m.String()
+}
+
+func toCategoryRuleResponse(rule *ledgerv1.CategoryRule) CategoryRuleResponse {
+	return CategoryRuleResponse{
+		ID:        rule.GetId(),
+		MatchType: rule.GetMatchType(),
+		Pattern:   rule.GetPattern(),
+		MinAmount: moneyFromProto(rule.GetMinAmountDecimal(), rule.GetMinAmount()),
+		MaxAmount: moneyFromProto(rule.GetMaxAmountDecimal(), rule.GetMaxAmount()),
+		Category:  rule.GetCategory(),
+		Tags:      rule.GetTags(),
+		Priority:  rule.GetPriority(),
+	}
+}
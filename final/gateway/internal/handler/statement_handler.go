@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+const (
+	maxStatementSize = 3 << 20
+	importChunkSize  = 64 << 10
+	streamTimeout    = 10 * time.Minute
+)
+
+type UploadStatementRequest struct {
+	Format        string `form:"format" binding:"omitempty,oneof=csv ofx qfx qif"`
+	Profile       string `form:"profile" binding:"max=100"`
+	DuplicateMode string `form:"duplicate_mode" binding:"omitempty,oneof=skip force flag"`
+	Preview       bool   `form:"preview"`
+	Atomic        bool   `form:"atomic"`
+}
+
+func (h *LedgerHandler) UploadStatement(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req UploadStatementRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	if header.Size > maxStatementSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file must be at most 3 MB"})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read file"})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxStatementSize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read file"})
+		return
+	}
+	if len(data) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is empty"})
+		return
+	}
+
+	format := req.Format
+	if format == "" {
+		format = statementFormat(header.Filename)
+	}
+
+	resp, err := h.ledgerClient.ImportCSV(c.Request.Context(), &ledgerv1.ImportCSVRequest{
+		UserId:        userID,
+		CsvData:       data,
+		DuplicateMode: req.DuplicateMode,
+		Preview:       req.Preview,
+		Atomic:        req.Atomic,
+		Profile:       req.Profile,
+		Format:        format,
+	})
+	if err != nil {
+		writeImportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toImportCSVResponse(resp))
+}
+
+func (h *LedgerHandler) ImportStream(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req UploadStatementRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	extendDeadlines(c)
+
+	stream, err := h.ledgerClient.ImportStream(c.Request.Context())
+	if err != nil {
+		writeImportError(c, err)
+		return
+	}
+
+	first := &ledgerv1.ImportStreamRequest{
+		UserId:        userID,
+		DuplicateMode: req.DuplicateMode,
+		Preview:       req.Preview,
+		Atomic:        req.Atomic,
+		Profile:       req.Profile,
+		Format:        req.Format,
+	}
+	if err := sendImportChunks(stream, first, c.Request.Body); err != nil {
+		writeImportError(c, err)
+		return
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		writeImportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toImportCSVResponse(resp))
+}
+
+func sendImportChunks(stream ledgerv1.LedgerService_ImportStreamClient, first *ledgerv1.ImportStreamRequest, body io.Reader) error {
+	req := first
+	for {
+		chunk := make([]byte, importChunkSize)
+		n, err := io.ReadFull(body, chunk)
+		if n > 0 || req == first {
+			req.Chunk = chunk[:n]
+			if sendErr := stream.Send(req); sendErr == io.EOF {
+				return nil
+			} else if sendErr != nil {
+				return sendErr
+			}
+			req = &ledgerv1.ImportStreamRequest{}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "failed to read request body")
+		}
+	}
+}
+
+func extendDeadlines(c *gin.Context) {
+	controller := http.NewResponseController(c.Writer)
+	deadline := time.Now().Add(streamTimeout)
+	_ = controller.SetReadDeadline(deadline)
+	_ = controller.SetWriteDeadline(deadline)
+}
+
+func statementFormat(filename string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".ofx", ".qfx":
+		return "ofx"
+	case ".qif":
+		return "qif"
+	}
+	return "csv"
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type TagSummaryResponse struct {
+	Tag              string                  `json:"tag"`
+	Total            Money                   `json:"total"`
+	TransactionCount int64                   `json:"transaction_count"`
+	Currencies       []CurrencyTotalResponse `json:"currencies,omitempty"`
+}
+
+type TagReportResponse struct {
+	BaseCurrency string               `json:"base_currency"`
+	Tags         []TagSummaryResponse `json:"tags"`
+	From         string               `json:"from"`
+	To           string               `json:"to"`
+}
+
+func (h *LedgerHandler) GetTagReport(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	fromStr := c.Query("from")
+	toStr := c.Query("to")
+
+	if fromStr == "" || toStr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from and to parameters are required (YYYY-MM-DD)"})
+		return
+	}
+
+	from, err := time.Parse("2006-01-02", fromStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid from date format"})
+		return
+	}
+
+	to, err := time.Parse("2006-01-02", toStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid to date format"})
+		return
+	}
+
+	req := &ledgerv1.GetTagReportRequest{
+		UserId: userID,
+		From:   timestamppb.New(from),
+		To:     timestamppb.New(to),
+	}
+	if tags := c.Query("tags"); tags != "" {
+		req.Tags = strings.Split(tags, ",")
+	}
+
+	resp, err := h.ledgerClient.GetTagReport(c.Request.Context(), req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": st.Message()})
+				return
+			}
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	tags := make([]TagSummaryResponse, 0, len(resp.GetTags()))
+	for _, tag := range resp.GetTags() {
+		tags = append(tags, TagSummaryResponse{
+			Tag:              tag.GetTag(),
+			Total:            moneyFromProto(tag.GetTotalDecimal(), tag.GetTotal()),
+			TransactionCount: tag.GetTransactionCount(),
+			Currencies:       toCurrencyTotalResponses(tag.GetCurrencies()),
+		})
+	}
+
+	c.JSON(http.StatusOK, TagReportResponse{
+		BaseCurrency: resp.GetBaseCurrency(),
+		Tags:         tags,
+		From:         fromStr,
+		To:           toStr,
+	})
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/gateway/internal/middleware"
+	ledgerv1 "github.com/mikhailmogilnikov/go/final/gateway/internal/pb/ledger/v1"
+)
+
+type TrendsRequest struct {
+	From        string `form:"from" binding:"required,datetime=2006-01-02"`
+	To          string `form:"to" binding:"required,datetime=2006-01-02"`
+	Granularity string `form:"granularity" binding:"omitempty,oneof=day week month year"`
+	ByCategory  bool   `form:"by_category"`
+	Window      int32  `form:"window" binding:"omitempty,min=1,max=100"`
+}
+
+type TrendBucketResponse struct {
+	Period        string `json:"period"`
+	Total         Money  `json:"total"`
+	RunningTotal  Money  `json:"running_total"`
+	MovingAverage Money  `json:"moving_average"`
+}
+
+type TrendSeriesResponse struct {
+	Category string                `json:"category"`
+	Total    Money                 `json:"total"`
+	Buckets  []TrendBucketResponse `json:"buckets"`
+}
+
+type TrendsResponse struct {
+	BaseCurrency string                `json:"base_currency"`
+	From         string                `json:"from"`
+	To           string                `json:"to"`
+	Granularity  string                `json:"granularity"`
+	Window       int32                 `json:"window"`
+	Total        Money                 `json:"total"`
+	Buckets      []TrendBucketResponse `json:"buckets"`
+	Categories   []TrendSeriesResponse `json:"categories,omitempty"`
+}
+
+func (h *LedgerHandler) GetTrends(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	var req TrendsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	from, _ := time.Parse("2006-01-02", req.From)
+	to, _ := time.Parse("2006-01-02", req.To)
+
+	resp, err := h.ledgerClient.GetTrends(c.Request.Context(), &ledgerv1.GetTrendsRequest{
+		UserId:      userID,
+		From:        timestamppb.New(from),
+		To:          timestamppb.New(to),
+		Granularity: req.Granularity,
+		ByCategory:  req.ByCategory,
+		Window:      req.Window,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": st.Message()})
+				return
+			}
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var categories []TrendSeriesResponse
+	for _, series := range resp.GetCategories() {
+		categories = append(categories, TrendSeriesResponse{
+			Category: series.GetCategory(),
+			Total:    moneyFromProto(series.GetTotalDecimal(), series.GetTotal()),
+			Buckets:  toTrendBucketResponses(series.GetBuckets()),
+		})
+	}
+
+	c.JSON(http.StatusOK, TrendsResponse{
+		BaseCurrency: resp.GetBaseCurrency(),
+		From:         req.From,
+		To:           req.To,
+		Granularity:  resp.GetGranularity(),
+		Window:       resp.GetWindow(),
+		Total:        moneyFromProto(resp.GetTotalDecimal(), resp.GetTotal()),
+		Buckets:      toTrendBucketResponses(resp.GetBuckets()),
+		Categories:   categories,
+	})
+}
+
+func toTrendBucketResponses(buckets []*ledgerv1.TrendBucket) []TrendBucketResponse {
+	responses := make([]TrendBucketResponse, 0, len(buckets))
+	for _, b := range buckets {
+		responses = append(responses, TrendBucketResponse{
+			Period:        b.GetPeriod().AsTime().Format("2006-01-02"),
+			Total:         moneyFromProto(b.GetTotalDecimal(), b.GetTotal()),
+			RunningTotal:  moneyFromProto(b.GetRunningTotalDecimal(), b.GetRunningTotal()),
+			MovingAverage: moneyFromProto(b.GetMovingAverageDecimal(), b.GetMovingAverage()),
+		})
+	}
+	return responses
+}
@@ -16,16 +16,26 @@ const (
 )
 
 type Transaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount          float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category        string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Kind            string                 `protobuf:"bytes,8,opt,name=kind,proto3" json:"kind,omitempty"`                                                  
+	Currency        string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`                                          
+	AmountDecimal   string                 `protobuf:"bytes,10,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`          
+	RecurringRuleId int64                  `protobuf:"varint,11,opt,name=recurring_rule_id,json=recurringRuleId,proto3" json:"recurring_rule_id,omitempty"` 
+	OverBudget      bool                   `protobuf:"varint,12,opt,name=over_budget,json=overBudget,proto3" json:"over_budget,omitempty"`                  
+	Tags            []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`                                                 
+	Splits          []*TransactionSplit    `protobuf:"bytes,14,rep,name=splits,proto3" json:"splits,omitempty"`                                             
+	AccountId       int64                  `protobuf:"varint,15,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                     
+	TransferId      int64                  `protobuf:"varint,16,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`                  
+	Rank            float32                `protobuf:"fixed32,17,opt,name=rank,proto3" json:"rank,omitempty"`                                               
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Transaction) Reset() {
@@ -106,6 +116,143 @@ func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Transaction) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *Transaction) GetRecurringRuleId() int64 {
+	if x != nil {
+		return x.RecurringRuleId
+	}
+	return 0
+}
+
+func (x *Transaction) GetOverBudget() bool {
+	if x != nil {
+		return x.OverBudget
+	}
+	return false
+}
+
+func (x *Transaction) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *Transaction) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Transaction) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *Transaction) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type TransactionSplit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,3,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionSplit) Reset() {
+	*x = TransactionSplit{}
+	mi := &file_ledger_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSplit) ProtoMessage() {}
+
+func (x *TransactionSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*TransactionSplit) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransactionSplit) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 type AddTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -113,13 +260,19 @@ type AddTransactionRequest struct {
 	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Date          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Kind          string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`                                
+	AmountDecimal string                 `protobuf:"bytes,8,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"` 
+	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`                                        
+	Splits        []*TransactionSplit    `protobuf:"bytes,10,rep,name=splits,proto3" json:"splits,omitempty"`                                   
+	AccountId     int64                  `protobuf:"varint,11,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`           
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddTransactionRequest) Reset() {
 	*x = AddTransactionRequest{}
-	mi := &file_ledger_proto_msgTypes[1]
+	mi := &file_ledger_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -131,7 +284,7 @@ func (x *AddTransactionRequest) String() string {
 func (*AddTransactionRequest) ProtoMessage() {}
 
 func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[1]
+	mi := &file_ledger_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -143,7 +296,7 @@ func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (*AddTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{1}
+	return file_ledger_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AddTransactionRequest) GetUserId() int64 {
@@ -181,6 +334,48 @@ func (x *AddTransactionRequest) GetDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AddTransactionRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AddTransactionRequest) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *AddTransactionRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
 type AddTransactionResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Transaction    *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
@@ -192,7 +387,7 @@ type AddTransactionResponse struct {
 
 func (x *AddTransactionResponse) Reset() {
 	*x = AddTransactionResponse{}
-	mi := &file_ledger_proto_msgTypes[2]
+	mi := &file_ledger_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -204,7 +399,7 @@ func (x *AddTransactionResponse) String() string {
 func (*AddTransactionResponse) ProtoMessage() {}
 
 func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[2]
+	mi := &file_ledger_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -216,7 +411,7 @@ func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (*AddTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{2}
+	return file_ledger_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AddTransactionResponse) GetTransaction() *Transaction {
@@ -241,18 +436,30 @@ func (x *AddTransactionResponse) GetBudgetWarning() string {
 }
 
 type GetTransactionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`         
-	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`             
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"` 
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                               
+	To               *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                   
+	Category         string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`                       
+	PageSize         int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`      
+	PageToken        string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`    
+	Sort             string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`                               
+	Tags             []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                               
+	TagMatch         string                 `protobuf:"bytes,9,opt,name=tag_match,json=tagMatch,proto3" json:"tag_match,omitempty"`       
+	AccountId        int64                  `protobuf:"varint,10,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`  
+	Query            string                 `protobuf:"bytes,11,opt,name=query,proto3" json:"query,omitempty"`                            
+	MinAmount        float64                `protobuf:"fixed64,12,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"` 
+	MinAmountDecimal string                 `protobuf:"bytes,13,opt,name=min_amount_decimal,json=minAmountDecimal,proto3" json:"min_amount_decimal,omitempty"`
+	MaxAmount        float64                `protobuf:"fixed64,14,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` 
+	MaxAmountDecimal string                 `protobuf:"bytes,15,opt,name=max_amount_decimal,json=maxAmountDecimal,proto3" json:"max_amount_decimal,omitempty"`
+	Currency         string                 `protobuf:"bytes,16,opt,name=currency,proto3" json:"currency,omitempty"` 
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetTransactionsRequest) Reset() {
 	*x = GetTransactionsRequest{}
-	mi := &file_ledger_proto_msgTypes[3]
+	mi := &file_ledger_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -264,7 +471,7 @@ func (x *GetTransactionsRequest) String() string {
 func (*GetTransactionsRequest) ProtoMessage() {}
 
 func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[3]
+	mi := &file_ledger_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +483,7 @@ func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{3}
+	return file_ledger_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetTransactionsRequest) GetUserId() int64 {
@@ -307,16 +514,101 @@ func (x *GetTransactionsRequest) GetCategory() string {
 	return ""
 }
 
+func (x *GetTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetTagMatch() string {
+	if x != nil {
+		return x.TagMatch
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetMinAmountDecimal() string {
+	if x != nil {
+		return x.MinAmountDecimal
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetMaxAmountDecimal() string {
+	if x != nil {
+		return x.MaxAmountDecimal
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type GetTransactionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` 
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTransactionsResponse) Reset() {
 	*x = GetTransactionsResponse{}
-	mi := &file_ledger_proto_msgTypes[4]
+	mi := &file_ledger_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -328,7 +620,7 @@ func (x *GetTransactionsResponse) String() string {
 func (*GetTransactionsResponse) ProtoMessage() {}
 
 func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[4]
+	mi := &file_ledger_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +632,7 @@ func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{4}
+	return file_ledger_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetTransactionsResponse) GetTransactions() []*Transaction {
@@ -350,32 +642,46 @@ func (x *GetTransactionsResponse) GetTransactions() []*Transaction {
 	return nil
 }
 
-type Budget struct {
+func (x *GetTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	LimitAmount   float64                `protobuf:"fixed64,4,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
-	Period        string                 `protobuf:"bytes,5,opt,name=period,proto3" json:"period,omitempty"` 
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` 
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Kind          string                 `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,9,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`                             
+	Splits        []*TransactionSplit    `protobuf:"bytes,11,rep,name=splits,proto3" json:"splits,omitempty"`                         
+	AccountId     int64                  `protobuf:"varint,12,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` 
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Budget) Reset() {
-	*x = Budget{}
-	mi := &file_ledger_proto_msgTypes[5]
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
+	mi := &file_ledger_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Budget) String() string {
+func (x *UpdateTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Budget) ProtoMessage() {}
+func (*UpdateTransactionRequest) ProtoMessage() {}
 
-func (x *Budget) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[5]
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,133 +692,117 @@ func (x *Budget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*Budget) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{5}
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Budget) GetId() int64 {
+func (x *UpdateTransactionRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Budget) GetUserId() int64 {
+func (x *UpdateTransactionRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *Budget) GetCategory() string {
+func (x *UpdateTransactionRequest) GetAmount() float64 {
 	if x != nil {
-		return x.Category
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *Budget) GetLimitAmount() float64 {
+func (x *UpdateTransactionRequest) GetCategory() string {
 	if x != nil {
-		return x.LimitAmount
+		return x.Category
 	}
-	return 0
+	return ""
 }
 
-func (x *Budget) GetPeriod() string {
+func (x *UpdateTransactionRequest) GetDescription() string {
 	if x != nil {
-		return x.Period
+		return x.Description
 	}
 	return ""
 }
 
-type SetBudgetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
-	LimitAmount   float64                `protobuf:"fixed64,3,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
-	Period        string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetBudgetRequest) Reset() {
-	*x = SetBudgetRequest{}
-	mi := &file_ledger_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SetBudgetRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateTransactionRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
 }
 
-func (*SetBudgetRequest) ProtoMessage() {}
-
-func (x *SetBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[6]
+func (x *UpdateTransactionRequest) GetKind() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Kind
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-func (*SetBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{6}
+func (x *UpdateTransactionRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *SetBudgetRequest) GetUserId() int64 {
+func (x *UpdateTransactionRequest) GetAmountDecimal() string {
 	if x != nil {
-		return x.UserId
+		return x.AmountDecimal
 	}
-	return 0
+	return ""
 }
 
-func (x *SetBudgetRequest) GetCategory() string {
+func (x *UpdateTransactionRequest) GetTags() []string {
 	if x != nil {
-		return x.Category
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *SetBudgetRequest) GetLimitAmount() float64 {
+func (x *UpdateTransactionRequest) GetSplits() []*TransactionSplit {
 	if x != nil {
-		return x.LimitAmount
+		return x.Splits
 	}
-	return 0
+	return nil
 }
 
-func (x *SetBudgetRequest) GetPeriod() string {
+func (x *UpdateTransactionRequest) GetAccountId() int64 {
 	if x != nil {
-		return x.Period
+		return x.AccountId
 	}
-	return ""
+	return 0
 }
 
-type SetBudgetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateTransactionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Transaction    *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	BudgetExceeded bool                   `protobuf:"varint,2,opt,name=budget_exceeded,json=budgetExceeded,proto3" json:"budget_exceeded,omitempty"`
+	BudgetWarning  string                 `protobuf:"bytes,3,opt,name=budget_warning,json=budgetWarning,proto3" json:"budget_warning,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SetBudgetResponse) Reset() {
-	*x = SetBudgetResponse{}
+func (x *UpdateTransactionResponse) Reset() {
+	*x = UpdateTransactionResponse{}
 	mi := &file_ledger_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetBudgetResponse) String() string {
+func (x *UpdateTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBudgetResponse) ProtoMessage() {}
+func (*UpdateTransactionResponse) ProtoMessage() {}
 
-func (x *SetBudgetResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ledger_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,38 +814,53 @@ func (x *SetBudgetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*SetBudgetResponse) Descriptor() ([]byte, []int) {
+func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_ledger_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SetBudgetResponse) GetBudget() *Budget {
+func (x *UpdateTransactionResponse) GetTransaction() *Transaction {
 	if x != nil {
-		return x.Budget
+		return x.Transaction
 	}
 	return nil
 }
 
-type GetBudgetsRequest struct {
+func (x *UpdateTransactionResponse) GetBudgetExceeded() bool {
+	if x != nil {
+		return x.BudgetExceeded
+	}
+	return false
+}
+
+func (x *UpdateTransactionResponse) GetBudgetWarning() string {
+	if x != nil {
+		return x.BudgetWarning
+	}
+	return ""
+}
+
+type DeleteTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBudgetsRequest) Reset() {
-	*x = GetBudgetsRequest{}
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
 	mi := &file_ledger_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBudgetsRequest) String() string {
+func (x *DeleteTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBudgetsRequest) ProtoMessage() {}
+func (*DeleteTransactionRequest) ProtoMessage() {}
 
-func (x *GetBudgetsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ledger_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,38 +872,44 @@ func (x *GetBudgetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*GetBudgetsRequest) Descriptor() ([]byte, []int) {
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_ledger_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetBudgetsRequest) GetUserId() int64 {
+func (x *DeleteTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteTransactionRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type GetBudgetsResponse struct {
+type DeleteTransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBudgetsResponse) Reset() {
-	*x = GetBudgetsResponse{}
+func (x *DeleteTransactionResponse) Reset() {
+	*x = DeleteTransactionResponse{}
 	mi := &file_ledger_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBudgetsResponse) String() string {
+func (x *DeleteTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBudgetsResponse) ProtoMessage() {}
+func (*DeleteTransactionResponse) ProtoMessage() {}
 
-func (x *GetBudgetsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ledger_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -610,41 +921,43 @@ func (x *GetBudgetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*GetBudgetsResponse) Descriptor() ([]byte, []int) {
+func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_ledger_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetBudgetsResponse) GetBudgets() []*Budget {
-	if x != nil {
-		return x.Budgets
-	}
-	return nil
-}
-
-type CategorySummary struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Category         string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Total            float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
-	BudgetLimit      float64                `protobuf:"fixed64,3,opt,name=budget_limit,json=budgetLimit,proto3" json:"budget_limit,omitempty"`                
-	BudgetPercentage float64                `protobuf:"fixed64,4,opt,name=budget_percentage,json=budgetPercentage,proto3" json:"budget_percentage,omitempty"` 
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type Budget struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId             int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category           string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	LimitAmount        float64                `protobuf:"fixed64,4,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
+	Period             string                 `protobuf:"bytes,5,opt,name=period,proto3" json:"period,omitempty"` 
+	Currency           string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	LimitAmountDecimal string                 `protobuf:"bytes,7,opt,name=limit_amount_decimal,json=limitAmountDecimal,proto3" json:"limit_amount_decimal,omitempty"`
+	StartDay           int32                  `protobuf:"varint,8,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`                                    
+	Rollover           bool                   `protobuf:"varint,9,opt,name=rollover,proto3" json:"rollover,omitempty"`                                                    
+	Enforcement        string                 `protobuf:"bytes,10,opt,name=enforcement,proto3" json:"enforcement,omitempty"`                                              
+	WarningThresholds  []int32                `protobuf:"varint,11,rep,packed,name=warning_thresholds,json=warningThresholds,proto3" json:"warning_thresholds,omitempty"` 
+	ValidFrom          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`                                 
+	ValidTo            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`                                       
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CategorySummary) Reset() {
-	*x = CategorySummary{}
+func (x *Budget) Reset() {
+	*x = Budget{}
 	mi := &file_ledger_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategorySummary) String() string {
+func (x *Budget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategorySummary) ProtoMessage() {}
+func (*Budget) ProtoMessage() {}
 
-func (x *CategorySummary) ProtoReflect() protoreflect.Message {
+func (x *Budget) ProtoReflect() protoreflect.Message {
 	mi := &file_ledger_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -656,62 +969,7525 @@ func (x *CategorySummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*CategorySummary) Descriptor() ([]byte, []int) {
+func (*Budget) Descriptor() ([]byte, []int) {
 	return file_ledger_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CategorySummary) GetCategory() string {
+func (x *Budget) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Budget) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Budget) GetCategory() string {
 	if x != nil {
 		return x.Category
 	}
 	return ""
 }
 
-func (x *CategorySummary) GetTotal() float64 {
+func (x *Budget) GetLimitAmount() float64 {
 	if x != nil {
-		return x.Total
+		return x.LimitAmount
 	}
 	return 0
 }
 
-func (x *CategorySummary) GetBudgetLimit() float64 {
+func (x *Budget) GetPeriod() string {
 	if x != nil {
-		return x.BudgetLimit
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Budget) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Budget) GetLimitAmountDecimal() string {
+	if x != nil {
+		return x.LimitAmountDecimal
+	}
+	return ""
+}
+
+func (x *Budget) GetStartDay() int32 {
+	if x != nil {
+		return x.StartDay
 	}
 	return 0
 }
 
-func (x *CategorySummary) GetBudgetPercentage() float64 {
+func (x *Budget) GetRollover() bool {
 	if x != nil {
-		return x.BudgetPercentage
+		return x.Rollover
+	}
+	return false
+}
+
+func (x *Budget) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+func (x *Budget) GetWarningThresholds() []int32 {
+	if x != nil {
+		return x.WarningThresholds
+	}
+	return nil
+}
+
+func (x *Budget) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Budget) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type SetBudgetRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category           string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	LimitAmount        float64                `protobuf:"fixed64,3,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
+	Period             string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Currency           string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	LimitAmountDecimal string                 `protobuf:"bytes,6,opt,name=limit_amount_decimal,json=limitAmountDecimal,proto3" json:"limit_amount_decimal,omitempty"` 
+	StartDay           int32                  `protobuf:"varint,7,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	Rollover           bool                   `protobuf:"varint,8,opt,name=rollover,proto3" json:"rollover,omitempty"`
+	Enforcement        string                 `protobuf:"bytes,9,opt,name=enforcement,proto3" json:"enforcement,omitempty"`
+	WarningThresholds  []int32                `protobuf:"varint,10,rep,packed,name=warning_thresholds,json=warningThresholds,proto3" json:"warning_thresholds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetBudgetRequest) Reset() {
+	*x = SetBudgetRequest{}
+	mi := &file_ledger_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetRequest) ProtoMessage() {}
+
+func (x *SetBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*SetBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetBudgetRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-type GetReportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+func (x *SetBudgetRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetLimitAmount() float64 {
+	if x != nil {
+		return x.LimitAmount
+	}
+	return 0
+}
+
+func (x *SetBudgetRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetLimitAmountDecimal() string {
+	if x != nil {
+		return x.LimitAmountDecimal
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetStartDay() int32 {
+	if x != nil {
+		return x.StartDay
+	}
+	return 0
+}
+
+func (x *SetBudgetRequest) GetRollover() bool {
+	if x != nil {
+		return x.Rollover
+	}
+	return false
+}
+
+func (x *SetBudgetRequest) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetWarningThresholds() []int32 {
+	if x != nil {
+		return x.WarningThresholds
+	}
+	return nil
+}
+
+type SetBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetResponse) Reset() {
+	*x = SetBudgetResponse{}
+	mi := &file_ledger_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetResponse) ProtoMessage() {}
+
+func (x *SetBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*SetBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetBudgetResponse) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type GetBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetsRequest) Reset() {
+	*x = GetBudgetsRequest{}
+	mi := &file_ledger_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetsRequest) ProtoMessage() {}
+
+func (x *GetBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetBudgetsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetsResponse) Reset() {
+	*x = GetBudgetsResponse{}
+	mi := &file_ledger_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetsResponse) ProtoMessage() {}
+
+func (x *GetBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type GetBudgetHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetHistoryRequest) Reset() {
+	*x = GetBudgetHistoryRequest{}
+	mi := &file_ledger_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetHistoryRequest) ProtoMessage() {}
+
+func (x *GetBudgetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetBudgetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBudgetHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetBudgetHistoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type GetBudgetHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*Budget              `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetHistoryResponse) Reset() {
+	*x = GetBudgetHistoryResponse{}
+	mi := &file_ledger_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetHistoryResponse) ProtoMessage() {}
+
+func (x *GetBudgetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetBudgetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBudgetHistoryResponse) GetVersions() []*Budget {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_ledger_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteBudgetRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteBudgetRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type DeleteBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetResponse) Reset() {
+	*x = DeleteBudgetResponse{}
+	mi := &file_ledger_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{18}
+}
+
+type BudgetStatus struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Budget           *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` 
+	PeriodFrom       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty"`
+	PeriodTo         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty"`
+	Limit            float64                `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"` 
+	Spent            float64                `protobuf:"fixed64,6,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining        float64                `protobuf:"fixed64,7,opt,name=remaining,proto3" json:"remaining,omitempty"` 
+	Percentage       float64                `protobuf:"fixed64,8,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Projected        float64                `protobuf:"fixed64,9,opt,name=projected,proto3" json:"projected,omitempty"` 
+	LimitDecimal     string                 `protobuf:"bytes,10,opt,name=limit_decimal,json=limitDecimal,proto3" json:"limit_decimal,omitempty"`
+	SpentDecimal     string                 `protobuf:"bytes,11,opt,name=spent_decimal,json=spentDecimal,proto3" json:"spent_decimal,omitempty"`
+	RemainingDecimal string                 `protobuf:"bytes,12,opt,name=remaining_decimal,json=remainingDecimal,proto3" json:"remaining_decimal,omitempty"`
+	ProjectedDecimal string                 `protobuf:"bytes,13,opt,name=projected_decimal,json=projectedDecimal,proto3" json:"projected_decimal,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BudgetStatus) Reset() {
+	*x = BudgetStatus{}
+	mi := &file_ledger_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetStatus) ProtoMessage() {}
+
+func (x *BudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*BudgetStatus) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BudgetStatus) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetPeriodFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetPeriodTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodTo
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetProjected() float64 {
+	if x != nil {
+		return x.Projected
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetLimitDecimal() string {
+	if x != nil {
+		return x.LimitDecimal
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetSpentDecimal() string {
+	if x != nil {
+		return x.SpentDecimal
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetRemainingDecimal() string {
+	if x != nil {
+		return x.RemainingDecimal
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetProjectedDecimal() string {
+	if x != nil {
+		return x.ProjectedDecimal
+	}
+	return ""
+}
+
+type GetBudgetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusRequest) Reset() {
+	*x = GetBudgetStatusRequest{}
+	mi := &file_ledger_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusRequest) ProtoMessage() {}
+
+func (x *GetBudgetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetBudgetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetBudgetStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetBudgetStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*BudgetStatus        `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusResponse) Reset() {
+	*x = GetBudgetStatusResponse{}
+	mi := &file_ledger_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusResponse) ProtoMessage() {}
+
+func (x *GetBudgetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetBudgetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetBudgetStatusResponse) GetStatuses() []*BudgetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type CurrencyTotal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Currency         string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount           float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`       
+	Converted        float64                `protobuf:"fixed64,3,opt,name=converted,proto3" json:"converted,omitempty"` 
+	AmountDecimal    string                 `protobuf:"bytes,4,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	ConvertedDecimal string                 `protobuf:"bytes,5,opt,name=converted_decimal,json=convertedDecimal,proto3" json:"converted_decimal,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CurrencyTotal) Reset() {
+	*x = CurrencyTotal{}
+	mi := &file_ledger_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyTotal) ProtoMessage() {}
+
+func (x *CurrencyTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CurrencyTotal) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CurrencyTotal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyTotal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CurrencyTotal) GetConverted() float64 {
+	if x != nil {
+		return x.Converted
+	}
+	return 0
+}
+
+func (x *CurrencyTotal) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *CurrencyTotal) GetConvertedDecimal() string {
+	if x != nil {
+		return x.ConvertedDecimal
+	}
+	return ""
+}
+
+type CategorySummary struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Category           string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Total              float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`                                               
+	BudgetLimit        float64                `protobuf:"fixed64,3,opt,name=budget_limit,json=budgetLimit,proto3" json:"budget_limit,omitempty"`                
+	BudgetPercentage   float64                `protobuf:"fixed64,4,opt,name=budget_percentage,json=budgetPercentage,proto3" json:"budget_percentage,omitempty"` 
+	Currencies         []*CurrencyTotal       `protobuf:"bytes,5,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	TotalDecimal       string                 `protobuf:"bytes,6,opt,name=total_decimal,json=totalDecimal,proto3" json:"total_decimal,omitempty"`
+	BudgetLimitDecimal string                 `protobuf:"bytes,7,opt,name=budget_limit_decimal,json=budgetLimitDecimal,proto3" json:"budget_limit_decimal,omitempty"`
+	Parent             string                 `protobuf:"bytes,8,opt,name=parent,proto3" json:"parent,omitempty"`                                
+	RollupTotal        float64                `protobuf:"fixed64,9,opt,name=rollup_total,json=rollupTotal,proto3" json:"rollup_total,omitempty"` 
+	RollupTotalDecimal string                 `protobuf:"bytes,10,opt,name=rollup_total_decimal,json=rollupTotalDecimal,proto3" json:"rollup_total_decimal,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CategorySummary) Reset() {
+	*x = CategorySummary{}
+	mi := &file_ledger_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorySummary) ProtoMessage() {}
+
+func (x *CategorySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CategorySummary) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CategorySummary) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategorySummary) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CategorySummary) GetBudgetLimit() float64 {
+	if x != nil {
+		return x.BudgetLimit
+	}
+	return 0
+}
+
+func (x *CategorySummary) GetBudgetPercentage() float64 {
+	if x != nil {
+		return x.BudgetPercentage
+	}
+	return 0
+}
+
+func (x *CategorySummary) GetCurrencies() []*CurrencyTotal {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *CategorySummary) GetTotalDecimal() string {
+	if x != nil {
+		return x.TotalDecimal
+	}
+	return ""
+}
+
+func (x *CategorySummary) GetBudgetLimitDecimal() string {
+	if x != nil {
+		return x.BudgetLimitDecimal
+	}
+	return ""
+}
+
+func (x *CategorySummary) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CategorySummary) GetRollupTotal() float64 {
+	if x != nil {
+		return x.RollupTotal
+	}
+	return 0
+}
+
+func (x *CategorySummary) GetRollupTotalDecimal() string {
+	if x != nil {
+		return x.RollupTotalDecimal
+	}
+	return ""
+}
+
+type GetReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportRequest) Reset() {
+	*x = GetReportRequest{}
+	mi := &file_ledger_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportRequest) ProtoMessage() {}
+
+func (x *GetReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetReportRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetReportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetReportResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Categories           []*CategorySummary     `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	TotalExpenses        float64                `protobuf:"fixed64,2,opt,name=total_expenses,json=totalExpenses,proto3" json:"total_expenses,omitempty"`
+	From                 *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	TotalIncome          float64                `protobuf:"fixed64,5,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
+	NetBalance           float64                `protobuf:"fixed64,6,opt,name=net_balance,json=netBalance,proto3" json:"net_balance,omitempty"`     
+	SavingsRate          float64                `protobuf:"fixed64,7,opt,name=savings_rate,json=savingsRate,proto3" json:"savings_rate,omitempty"`  
+	BaseCurrency         string                 `protobuf:"bytes,8,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"` 
+	TotalExpensesDecimal string                 `protobuf:"bytes,9,opt,name=total_expenses_decimal,json=totalExpensesDecimal,proto3" json:"total_expenses_decimal,omitempty"`
+	TotalIncomeDecimal   string                 `protobuf:"bytes,10,opt,name=total_income_decimal,json=totalIncomeDecimal,proto3" json:"total_income_decimal,omitempty"`
+	NetBalanceDecimal    string                 `protobuf:"bytes,11,opt,name=net_balance_decimal,json=netBalanceDecimal,proto3" json:"net_balance_decimal,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetReportResponse) Reset() {
+	*x = GetReportResponse{}
+	mi := &file_ledger_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportResponse) ProtoMessage() {}
+
+func (x *GetReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetReportResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetReportResponse) GetCategories() []*CategorySummary {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetReportResponse) GetTotalExpenses() float64 {
+	if x != nil {
+		return x.TotalExpenses
+	}
+	return 0
+}
+
+func (x *GetReportResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetReportResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetReportResponse) GetTotalIncome() float64 {
+	if x != nil {
+		return x.TotalIncome
+	}
+	return 0
+}
+
+func (x *GetReportResponse) GetNetBalance() float64 {
+	if x != nil {
+		return x.NetBalance
+	}
+	return 0
+}
+
+func (x *GetReportResponse) GetSavingsRate() float64 {
+	if x != nil {
+		return x.SavingsRate
+	}
+	return 0
+}
+
+func (x *GetReportResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *GetReportResponse) GetTotalExpensesDecimal() string {
+	if x != nil {
+		return x.TotalExpensesDecimal
+	}
+	return ""
+}
+
+func (x *GetReportResponse) GetTotalIncomeDecimal() string {
+	if x != nil {
+		return x.TotalIncomeDecimal
+	}
+	return ""
+}
+
+func (x *GetReportResponse) GetNetBalanceDecimal() string {
+	if x != nil {
+		return x.NetBalanceDecimal
+	}
+	return ""
+}
+
+type TagSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Tag              string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Total            float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"` 
+	TransactionCount int64                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Currencies       []*CurrencyTotal       `protobuf:"bytes,4,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	TotalDecimal     string                 `protobuf:"bytes,5,opt,name=total_decimal,json=totalDecimal,proto3" json:"total_decimal,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TagSummary) Reset() {
+	*x = TagSummary{}
+	mi := &file_ledger_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSummary) ProtoMessage() {}
+
+func (x *TagSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*TagSummary) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TagSummary) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagSummary) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TagSummary) GetTransactionCount() int64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *TagSummary) GetCurrencies() []*CurrencyTotal {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *TagSummary) GetTotalDecimal() string {
+	if x != nil {
+		return x.TotalDecimal
+	}
+	return ""
+}
+
+type GetTagReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagReportRequest) Reset() {
+	*x = GetTagReportRequest{}
+	mi := &file_ledger_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagReportRequest) ProtoMessage() {}
+
+func (x *GetTagReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetTagReportRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTagReportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTagReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTagReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTagReportRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetTagReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagSummary          `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` 
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagReportResponse) Reset() {
+	*x = GetTagReportResponse{}
+	mi := &file_ledger_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagReportResponse) ProtoMessage() {}
+
+func (x *GetTagReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetTagReportResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTagReportResponse) GetTags() []*TagSummary {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetTagReportResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTagReportResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTagReportResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type PeriodChange struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Current         float64                `protobuf:"fixed64,1,opt,name=current,proto3" json:"current,omitempty"`
+	Previous        float64                `protobuf:"fixed64,2,opt,name=previous,proto3" json:"previous,omitempty"`                             
+	Delta           float64                `protobuf:"fixed64,3,opt,name=delta,proto3" json:"delta,omitempty"`                                   
+	DeltaPercent    float64                `protobuf:"fixed64,4,opt,name=delta_percent,json=deltaPercent,proto3" json:"delta_percent,omitempty"` 
+	CurrentDecimal  string                 `protobuf:"bytes,5,opt,name=current_decimal,json=currentDecimal,proto3" json:"current_decimal,omitempty"`
+	PreviousDecimal string                 `protobuf:"bytes,6,opt,name=previous_decimal,json=previousDecimal,proto3" json:"previous_decimal,omitempty"`
+	DeltaDecimal    string                 `protobuf:"bytes,7,opt,name=delta_decimal,json=deltaDecimal,proto3" json:"delta_decimal,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PeriodChange) Reset() {
+	*x = PeriodChange{}
+	mi := &file_ledger_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodChange) ProtoMessage() {}
+
+func (x *PeriodChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*PeriodChange) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PeriodChange) GetCurrent() float64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *PeriodChange) GetPrevious() float64 {
+	if x != nil {
+		return x.Previous
+	}
+	return 0
+}
+
+func (x *PeriodChange) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *PeriodChange) GetDeltaPercent() float64 {
+	if x != nil {
+		return x.DeltaPercent
+	}
+	return 0
+}
+
+func (x *PeriodChange) GetCurrentDecimal() string {
+	if x != nil {
+		return x.CurrentDecimal
+	}
+	return ""
+}
+
+func (x *PeriodChange) GetPreviousDecimal() string {
+	if x != nil {
+		return x.PreviousDecimal
+	}
+	return ""
+}
+
+func (x *PeriodChange) GetDeltaDecimal() string {
+	if x != nil {
+		return x.DeltaDecimal
+	}
+	return ""
+}
+
+type CategoryComparison struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Parent        string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"` 
+	Change        *PeriodChange          `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"` 
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryComparison) Reset() {
+	*x = CategoryComparison{}
+	mi := &file_ledger_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryComparison) ProtoMessage() {}
+
+func (x *CategoryComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CategoryComparison) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CategoryComparison) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryComparison) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CategoryComparison) GetChange() *PeriodChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *CategoryComparison) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CompareReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Baseline      string                 `protobuf:"bytes,4,opt,name=baseline,proto3" json:"baseline,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareReportsRequest) Reset() {
+	*x = CompareReportsRequest{}
+	mi := &file_ledger_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareReportsRequest) ProtoMessage() {}
+
+func (x *CompareReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CompareReportsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CompareReportsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompareReportsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CompareReportsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *CompareReportsRequest) GetBaseline() string {
+	if x != nil {
+		return x.Baseline
+	}
+	return ""
+}
+
+type CompareReportsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Categories            []*CategoryComparison  `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` 
+	From                  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Baseline              string                 `protobuf:"bytes,4,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	BaselineFrom          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=baseline_from,json=baselineFrom,proto3" json:"baseline_from,omitempty"`
+	BaselineTo            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=baseline_to,json=baselineTo,proto3" json:"baseline_to,omitempty"`
+	BaseCurrency          string                 `protobuf:"bytes,7,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	Expenses              *PeriodChange          `protobuf:"bytes,8,opt,name=expenses,proto3" json:"expenses,omitempty"`
+	Income                *PeriodChange          `protobuf:"bytes,9,opt,name=income,proto3" json:"income,omitempty"`
+	NetBalance            *PeriodChange          `protobuf:"bytes,10,opt,name=net_balance,json=netBalance,proto3" json:"net_balance,omitempty"`
+	NewCategories         []string               `protobuf:"bytes,11,rep,name=new_categories,json=newCategories,proto3" json:"new_categories,omitempty"`                         
+	DisappearedCategories []string               `protobuf:"bytes,12,rep,name=disappeared_categories,json=disappearedCategories,proto3" json:"disappeared_categories,omitempty"` 
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CompareReportsResponse) Reset() {
+	*x = CompareReportsResponse{}
+	mi := &file_ledger_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareReportsResponse) ProtoMessage() {}
+
+func (x *CompareReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CompareReportsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CompareReportsResponse) GetCategories() []*CategoryComparison {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetBaseline() string {
+	if x != nil {
+		return x.Baseline
+	}
+	return ""
+}
+
+func (x *CompareReportsResponse) GetBaselineFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaselineFrom
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetBaselineTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaselineTo
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *CompareReportsResponse) GetExpenses() *PeriodChange {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetIncome() *PeriodChange {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetNetBalance() *PeriodChange {
+	if x != nil {
+		return x.NetBalance
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetNewCategories() []string {
+	if x != nil {
+		return x.NewCategories
+	}
+	return nil
+}
+
+func (x *CompareReportsResponse) GetDisappearedCategories() []string {
+	if x != nil {
+		return x.DisappearedCategories
+	}
+	return nil
+}
+
+type TrendBucket struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Period               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                                      
+	Total                float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`                                      
+	RunningTotal         float64                `protobuf:"fixed64,3,opt,name=running_total,json=runningTotal,proto3" json:"running_total,omitempty"`    
+	MovingAverage        float64                `protobuf:"fixed64,4,opt,name=moving_average,json=movingAverage,proto3" json:"moving_average,omitempty"` 
+	TotalDecimal         string                 `protobuf:"bytes,5,opt,name=total_decimal,json=totalDecimal,proto3" json:"total_decimal,omitempty"`
+	RunningTotalDecimal  string                 `protobuf:"bytes,6,opt,name=running_total_decimal,json=runningTotalDecimal,proto3" json:"running_total_decimal,omitempty"`
+	MovingAverageDecimal string                 `protobuf:"bytes,7,opt,name=moving_average_decimal,json=movingAverageDecimal,proto3" json:"moving_average_decimal,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TrendBucket) Reset() {
+	*x = TrendBucket{}
+	mi := &file_ledger_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendBucket) ProtoMessage() {}
+
+func (x *TrendBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*TrendBucket) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TrendBucket) GetPeriod() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *TrendBucket) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TrendBucket) GetRunningTotal() float64 {
+	if x != nil {
+		return x.RunningTotal
+	}
+	return 0
+}
+
+func (x *TrendBucket) GetMovingAverage() float64 {
+	if x != nil {
+		return x.MovingAverage
+	}
+	return 0
+}
+
+func (x *TrendBucket) GetTotalDecimal() string {
+	if x != nil {
+		return x.TotalDecimal
+	}
+	return ""
+}
+
+func (x *TrendBucket) GetRunningTotalDecimal() string {
+	if x != nil {
+		return x.RunningTotalDecimal
+	}
+	return ""
+}
+
+func (x *TrendBucket) GetMovingAverageDecimal() string {
+	if x != nil {
+		return x.MovingAverageDecimal
+	}
+	return ""
+}
+
+type TrendSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Total         float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
+	TotalDecimal  string                 `protobuf:"bytes,3,opt,name=total_decimal,json=totalDecimal,proto3" json:"total_decimal,omitempty"`
+	Buckets       []*TrendBucket         `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendSeries) Reset() {
+	*x = TrendSeries{}
+	mi := &file_ledger_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendSeries) ProtoMessage() {}
+
+func (x *TrendSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*TrendSeries) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TrendSeries) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TrendSeries) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TrendSeries) GetTotalDecimal() string {
+	if x != nil {
+		return x.TotalDecimal
+	}
+	return ""
+}
+
+func (x *TrendSeries) GetBuckets() []*TrendBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type GetTrendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Granularity   string                 `protobuf:"bytes,4,opt,name=granularity,proto3" json:"granularity,omitempty"`                  
+	ByCategory    bool                   `protobuf:"varint,5,opt,name=by_category,json=byCategory,proto3" json:"by_category,omitempty"` 
+	Window        int32                  `protobuf:"varint,6,opt,name=window,proto3" json:"window,omitempty"`                           
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendsRequest) Reset() {
+	*x = GetTrendsRequest{}
+	mi := &file_ledger_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendsRequest) ProtoMessage() {}
+
+func (x *GetTrendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetTrendsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTrendsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTrendsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTrendsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTrendsRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetTrendsRequest) GetByCategory() bool {
+	if x != nil {
+		return x.ByCategory
+	}
+	return false
+}
+
+func (x *GetTrendsRequest) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+type GetTrendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buckets       []*TrendBucket         `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`       
+	Categories    []*TrendSeries         `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"` 
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Granularity   string                 `protobuf:"bytes,5,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Window        int32                  `protobuf:"varint,6,opt,name=window,proto3" json:"window,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,7,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	Total         float64                `protobuf:"fixed64,8,opt,name=total,proto3" json:"total,omitempty"`
+	TotalDecimal  string                 `protobuf:"bytes,9,opt,name=total_decimal,json=totalDecimal,proto3" json:"total_decimal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendsResponse) Reset() {
+	*x = GetTrendsResponse{}
+	mi := &file_ledger_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendsResponse) ProtoMessage() {}
+
+func (x *GetTrendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetTrendsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTrendsResponse) GetBuckets() []*TrendBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetTrendsResponse) GetCategories() []*TrendSeries {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetTrendsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTrendsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTrendsResponse) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetTrendsResponse) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *GetTrendsResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *GetTrendsResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetTrendsResponse) GetTotalDecimal() string {
+	if x != nil {
+		return x.TotalDecimal
+	}
+	return ""
+}
+
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_ledger_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExchangeRate) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_ledger_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetSettingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsResponse) Reset() {
+	*x = GetSettingsResponse{}
+	mi := &file_ledger_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsResponse) ProtoMessage() {}
+
+func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSettingsResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type SetBaseCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBaseCurrencyRequest) Reset() {
+	*x = SetBaseCurrencyRequest{}
+	mi := &file_ledger_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBaseCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBaseCurrencyRequest) ProtoMessage() {}
+
+func (x *SetBaseCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*SetBaseCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetBaseCurrencyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetBaseCurrencyRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type SetBaseCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBaseCurrencyResponse) Reset() {
+	*x = SetBaseCurrencyResponse{}
+	mi := &file_ledger_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBaseCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBaseCurrencyResponse) ProtoMessage() {}
+
+func (x *SetBaseCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*SetBaseCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetBaseCurrencyResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type SetExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRatesRequest) Reset() {
+	*x = SetExchangeRatesRequest{}
+	mi := &file_ledger_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesRequest) ProtoMessage() {}
+
+func (x *SetExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*SetExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetExchangeRatesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetExchangeRatesRequest) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type SetExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedCount    int32                  `protobuf:"varint,1,opt,name=saved_count,json=savedCount,proto3" json:"saved_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRatesResponse) Reset() {
+	*x = SetExchangeRatesResponse{}
+	mi := &file_ledger_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesResponse) ProtoMessage() {}
+
+func (x *SetExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*SetExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetExchangeRatesResponse) GetSavedCount() int32 {
+	if x != nil {
+		return x.SavedCount
+	}
+	return 0
+}
+
+type ImportExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CsvData       []byte                 `protobuf:"bytes,2,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesRequest) Reset() {
+	*x = ImportExchangeRatesRequest{}
+	mi := &file_ledger_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ImportExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImportExchangeRatesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportExchangeRatesRequest) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+type ImportExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImportedCount int32                  `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesResponse) Reset() {
+	*x = ImportExchangeRatesResponse{}
+	mi := &file_ledger_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ImportExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportExchangeRatesResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportExchangeRatesResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportExchangeRatesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type GetExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExchangeRatesRequest) Reset() {
+	*x = GetExchangeRatesRequest{}
+	mi := &file_ledger_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeRatesRequest) ProtoMessage() {}
+
+func (x *GetExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetExchangeRatesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetExchangeRatesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetExchangeRatesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExchangeRatesResponse) Reset() {
+	*x = GetExchangeRatesResponse{}
+	mi := &file_ledger_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeRatesResponse) ProtoMessage() {}
+
+func (x *GetExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type RecurringRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,5,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Frequency     string                 `protobuf:"bytes,9,opt,name=frequency,proto3" json:"frequency,omitempty"` 
+	Interval      int32                  `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"` 
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       
+	NextDate      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_date,json=nextDate,proto3" json:"next_date,omitempty"`    
+	LastError     string                 `protobuf:"bytes,14,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` 
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringRule) Reset() {
+	*x = RecurringRule{}
+	mi := &file_ledger_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringRule) ProtoMessage() {}
+
+func (x *RecurringRule) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*RecurringRule) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RecurringRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *RecurringRule) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RecurringRule) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RecurringRule) GetNextDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDate
+	}
+	return nil
+}
+
+func (x *RecurringRule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *RecurringRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateRecurringRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,4,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Frequency     string                 `protobuf:"bytes,8,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Interval      int32                  `protobuf:"varint,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringRuleRequest) Reset() {
+	*x = CreateRecurringRuleRequest{}
+	mi := &file_ledger_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringRuleRequest) ProtoMessage() {}
+
+func (x *CreateRecurringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateRecurringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateRecurringRuleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateRecurringRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateRecurringRuleRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateRecurringRuleRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *CreateRecurringRuleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateRecurringRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateRecurringRuleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRecurringRuleRequest) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *CreateRecurringRuleRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *CreateRecurringRuleRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateRecurringRuleRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type CreateRecurringRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *RecurringRule         `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringRuleResponse) Reset() {
+	*x = CreateRecurringRuleResponse{}
+	mi := &file_ledger_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringRuleResponse) ProtoMessage() {}
+
+func (x *CreateRecurringRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateRecurringRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateRecurringRuleResponse) GetRule() *RecurringRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type GetRecurringRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecurringRulesRequest) Reset() {
+	*x = GetRecurringRulesRequest{}
+	mi := &file_ledger_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecurringRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecurringRulesRequest) ProtoMessage() {}
+
+func (x *GetRecurringRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetRecurringRulesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetRecurringRulesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetRecurringRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*RecurringRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecurringRulesResponse) Reset() {
+	*x = GetRecurringRulesResponse{}
+	mi := &file_ledger_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecurringRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecurringRulesResponse) ProtoMessage() {}
+
+func (x *GetRecurringRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetRecurringRulesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetRecurringRulesResponse) GetRules() []*RecurringRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type UpdateRecurringRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,5,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Frequency     string                 `protobuf:"bytes,9,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Interval      int32                  `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringRuleRequest) Reset() {
+	*x = UpdateRecurringRuleRequest{}
+	mi := &file_ledger_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringRuleRequest) ProtoMessage() {}
+
+func (x *UpdateRecurringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateRecurringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateRecurringRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRecurringRuleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateRecurringRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRuleRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateRecurringRuleRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRuleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRuleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRuleRequest) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *UpdateRecurringRuleRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *UpdateRecurringRuleRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *UpdateRecurringRuleRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type UpdateRecurringRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *RecurringRule         `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringRuleResponse) Reset() {
+	*x = UpdateRecurringRuleResponse{}
+	mi := &file_ledger_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringRuleResponse) ProtoMessage() {}
+
+func (x *UpdateRecurringRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateRecurringRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateRecurringRuleResponse) GetRule() *RecurringRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteRecurringRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringRuleRequest) Reset() {
+	*x = DeleteRecurringRuleRequest{}
+	mi := &file_ledger_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRecurringRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteRecurringRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteRecurringRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteRecurringRuleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteRecurringRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringRuleResponse) Reset() {
+	*x = DeleteRecurringRuleResponse{}
+	mi := &file_ledger_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringRuleResponse) ProtoMessage() {}
+
+func (x *DeleteRecurringRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteRecurringRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{56}
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` 
+	Archived      bool                   `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`                 
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_ledger_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Category) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_ledger_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateCategoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_ledger_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type GetCategoriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCategoriesRequest) Reset() {
+	*x = GetCategoriesRequest{}
+	mi := &file_ledger_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesRequest) ProtoMessage() {}
+
+func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetCategoriesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCategoriesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesResponse) Reset() {
+	*x = GetCategoriesResponse{}
+	mi := &file_ledger_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesResponse) ProtoMessage() {}
+
+func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` 
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Archived      bool                   `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_ledger_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_ledger_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_ledger_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_ledger_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{65}
+}
+
+type MergeCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SourceId      int64                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` 
+	TargetId      int64                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCategoriesRequest) Reset() {
+	*x = MergeCategoriesRequest{}
+	mi := &file_ledger_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCategoriesRequest) ProtoMessage() {}
+
+func (x *MergeCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*MergeCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MergeCategoriesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MergeCategoriesRequest) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *MergeCategoriesRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type MergeCategoriesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Category          *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	MovedTransactions int64                  `protobuf:"varint,2,opt,name=moved_transactions,json=movedTransactions,proto3" json:"moved_transactions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MergeCategoriesResponse) Reset() {
+	*x = MergeCategoriesResponse{}
+	mi := &file_ledger_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCategoriesResponse) ProtoMessage() {}
+
+func (x *MergeCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*MergeCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MergeCategoriesResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *MergeCategoriesResponse) GetMovedTransactions() int64 {
+	if x != nil {
+		return x.MovedTransactions
+	}
+	return 0
+}
+
+type Account struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                  string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` 
+	Currency              string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	OpeningBalance        float64                `protobuf:"fixed64,6,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	OpeningBalanceDecimal string                 `protobuf:"bytes,7,opt,name=opening_balance_decimal,json=openingBalanceDecimal,proto3" json:"opening_balance_decimal,omitempty"`
+	Balance               float64                `protobuf:"fixed64,8,opt,name=balance,proto3" json:"balance,omitempty"` 
+	BalanceDecimal        string                 `protobuf:"bytes,9,opt,name=balance_decimal,json=balanceDecimal,proto3" json:"balance_decimal,omitempty"`
+	Archived              bool                   `protobuf:"varint,10,opt,name=archived,proto3" json:"archived,omitempty"` 
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_ledger_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Account) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Account) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetOpeningBalance() float64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *Account) GetOpeningBalanceDecimal() string {
+	if x != nil {
+		return x.OpeningBalanceDecimal
+	}
+	return ""
+}
+
+func (x *Account) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Account) GetBalanceDecimal() string {
+	if x != nil {
+		return x.BalanceDecimal
+	}
+	return ""
+}
+
+func (x *Account) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                  string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Currency              string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` 
+	OpeningBalance        float64                `protobuf:"fixed64,5,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	OpeningBalanceDecimal string                 `protobuf:"bytes,6,opt,name=opening_balance_decimal,json=openingBalanceDecimal,proto3" json:"opening_balance_decimal,omitempty"` 
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_ledger_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateAccountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetOpeningBalance() float64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetOpeningBalanceDecimal() string {
+	if x != nil {
+		return x.OpeningBalanceDecimal
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_ledger_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type GetAccountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAccountsRequest) Reset() {
+	*x = GetAccountsRequest{}
+	mi := &file_ledger_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsRequest) ProtoMessage() {}
+
+func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetAccountsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAccountsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsResponse) Reset() {
+	*x = GetAccountsResponse{}
+	mi := &file_ledger_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsResponse) ProtoMessage() {}
+
+func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type UpdateAccountRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                  string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Currency              string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"` 
+	OpeningBalance        float64                `protobuf:"fixed64,6,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	OpeningBalanceDecimal string                 `protobuf:"bytes,7,opt,name=opening_balance_decimal,json=openingBalanceDecimal,proto3" json:"opening_balance_decimal,omitempty"`
+	Archived              bool                   `protobuf:"varint,8,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
+	mi := &file_ledger_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetOpeningBalance() float64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetOpeningBalanceDecimal() string {
+	if x != nil {
+		return x.OpeningBalanceDecimal
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type UpdateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountResponse) Reset() {
+	*x = UpdateAccountResponse{}
+	mi := &file_ledger_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountResponse) ProtoMessage() {}
+
+func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_ledger_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteAccountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_ledger_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{76}
+}
+
+type Transfer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromAccountId   int64                  `protobuf:"varint,3,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId     int64                  `protobuf:"varint,4,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount          float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"` 
+	AmountDecimal   string                 `protobuf:"bytes,6,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	ToAmount        float64                `protobuf:"fixed64,7,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"` 
+	ToAmountDecimal string                 `protobuf:"bytes,8,opt,name=to_amount_decimal,json=toAmountDecimal,proto3" json:"to_amount_decimal,omitempty"`
+	Description     string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=date,proto3" json:"date,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_ledger_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Transfer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transfer) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Transfer) GetFromAccountId() int64 {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return 0
+}
+
+func (x *Transfer) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *Transfer) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transfer) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *Transfer) GetToAmount() float64 {
+	if x != nil {
+		return x.ToAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetToAmountDecimal() string {
+	if x != nil {
+		return x.ToAmountDecimal
+	}
+	return ""
+}
+
+func (x *Transfer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transfer) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Transfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateTransferRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromAccountId   int64                  `protobuf:"varint,2,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId     int64                  `protobuf:"varint,3,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount          float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal   string                 `protobuf:"bytes,5,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	ToAmount        float64                `protobuf:"fixed64,6,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"` 
+	ToAmountDecimal string                 `protobuf:"bytes,7,opt,name=to_amount_decimal,json=toAmountDecimal,proto3" json:"to_amount_decimal,omitempty"`
+	Description     string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTransferRequest) Reset() {
+	*x = CreateTransferRequest{}
+	mi := &file_ledger_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferRequest) ProtoMessage() {}
+
+func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateTransferRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetFromAccountId() int64 {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetToAmount() float64 {
+	if x != nil {
+		return x.ToAmount
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetToAmountDecimal() string {
+	if x != nil {
+		return x.ToAmountDecimal
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type CreateTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Transactions  []*Transaction         `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransferResponse) Reset() {
+	*x = CreateTransferResponse{}
+	mi := &file_ledger_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferResponse) ProtoMessage() {}
+
+func (x *CreateTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *CreateTransferResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetAccountBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Interval      string                 `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalancesRequest) Reset() {
+	*x = GetAccountBalancesRequest{}
+	mi := &file_ledger_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalancesRequest) ProtoMessage() {}
+
+func (x *GetAccountBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetAccountBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetAccountBalancesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAccountBalancesRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetAccountBalancesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAccountBalancesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAccountBalancesRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+type BalancePoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Date           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Balance        float64                `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"` 
+	BalanceDecimal string                 `protobuf:"bytes,3,opt,name=balance_decimal,json=balanceDecimal,proto3" json:"balance_decimal,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BalancePoint) Reset() {
+	*x = BalancePoint{}
+	mi := &file_ledger_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalancePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalancePoint) ProtoMessage() {}
+
+func (x *BalancePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*BalancePoint) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BalancePoint) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *BalancePoint) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *BalancePoint) GetBalanceDecimal() string {
+	if x != nil {
+		return x.BalanceDecimal
+	}
+	return ""
+}
+
+type GetAccountBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Balances      []*BalancePoint        `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalancesResponse) Reset() {
+	*x = GetAccountBalancesResponse{}
+	mi := &file_ledger_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalancesResponse) ProtoMessage() {}
+
+func (x *GetAccountBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetAccountBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetAccountBalancesResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *GetAccountBalancesResponse) GetBalances() []*BalancePoint {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type CategoryRule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MatchType        string                 `protobuf:"bytes,3,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`   
+	Pattern          string                 `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`                        
+	MinAmount        float64                `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"` 
+	MinAmountDecimal string                 `protobuf:"bytes,6,opt,name=min_amount_decimal,json=minAmountDecimal,proto3" json:"min_amount_decimal,omitempty"`
+	MaxAmount        float64                `protobuf:"fixed64,7,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` 
+	MaxAmountDecimal string                 `protobuf:"bytes,8,opt,name=max_amount_decimal,json=maxAmountDecimal,proto3" json:"max_amount_decimal,omitempty"`
+	Category         string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	Tags             []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`          
+	Priority         int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"` 
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CategoryRule) Reset() {
+	*x = CategoryRule{}
+	mi := &file_ledger_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRule) ProtoMessage() {}
+
+func (x *CategoryRule) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CategoryRule) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CategoryRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetMinAmountDecimal() string {
+	if x != nil {
+		return x.MinAmountDecimal
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetMaxAmountDecimal() string {
+	if x != nil {
+		return x.MaxAmountDecimal
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CategoryRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateCategoryRuleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MatchType        string                 `protobuf:"bytes,2,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`
+	Pattern          string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MinAmount        float64                `protobuf:"fixed64,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MinAmountDecimal string                 `protobuf:"bytes,5,opt,name=min_amount_decimal,json=minAmountDecimal,proto3" json:"min_amount_decimal,omitempty"`
+	MaxAmount        float64                `protobuf:"fixed64,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	MaxAmountDecimal string                 `protobuf:"bytes,7,opt,name=max_amount_decimal,json=maxAmountDecimal,proto3" json:"max_amount_decimal,omitempty"`
+	Category         string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Tags             []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Priority         int32                  `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRuleRequest) Reset() {
+	*x = CreateCategoryRuleRequest{}
+	mi := &file_ledger_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRuleRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateCategoryRuleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetMinAmountDecimal() string {
+	if x != nil {
+		return x.MinAmountDecimal
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *CreateCategoryRuleRequest) GetMaxAmountDecimal() string {
+	if x != nil {
+		return x.MaxAmountDecimal
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateCategoryRuleRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type CreateCategoryRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *CategoryRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRuleResponse) Reset() {
+	*x = CreateCategoryRuleResponse{}
+	mi := &file_ledger_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRuleResponse) ProtoMessage() {}
+
+func (x *CreateCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateCategoryRuleResponse) GetRule() *CategoryRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type GetCategoryRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRulesRequest) Reset() {
+	*x = GetCategoryRulesRequest{}
+	mi := &file_ledger_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRulesRequest) ProtoMessage() {}
+
+func (x *GetCategoryRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetCategoryRulesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetCategoryRulesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetCategoryRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CategoryRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRulesResponse) Reset() {
+	*x = GetCategoryRulesResponse{}
+	mi := &file_ledger_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRulesResponse) ProtoMessage() {}
+
+func (x *GetCategoryRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetCategoryRulesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetCategoryRulesResponse) GetRules() []*CategoryRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type UpdateCategoryRuleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MatchType        string                 `protobuf:"bytes,3,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`
+	Pattern          string                 `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MinAmount        float64                `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MinAmountDecimal string                 `protobuf:"bytes,6,opt,name=min_amount_decimal,json=minAmountDecimal,proto3" json:"min_amount_decimal,omitempty"`
+	MaxAmount        float64                `protobuf:"fixed64,7,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	MaxAmountDecimal string                 `protobuf:"bytes,8,opt,name=max_amount_decimal,json=maxAmountDecimal,proto3" json:"max_amount_decimal,omitempty"`
+	Category         string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	Tags             []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	Priority         int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRuleRequest) Reset() {
+	*x = UpdateCategoryRuleRequest{}
+	mi := &file_ledger_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRuleRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateCategoryRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetMinAmountDecimal() string {
+	if x != nil {
+		return x.MinAmountDecimal
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRuleRequest) GetMaxAmountDecimal() string {
+	if x != nil {
+		return x.MaxAmountDecimal
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRuleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRuleRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type UpdateCategoryRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *CategoryRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRuleResponse) Reset() {
+	*x = UpdateCategoryRuleResponse{}
+	mi := &file_ledger_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRuleResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateCategoryRuleResponse) GetRule() *CategoryRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteCategoryRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRuleRequest) Reset() {
+	*x = DeleteCategoryRuleRequest{}
+	mi := &file_ledger_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRuleRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeleteCategoryRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRuleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteCategoryRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRuleResponse) Reset() {
+	*x = DeleteCategoryRuleResponse{}
+	mi := &file_ledger_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRuleResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{91}
+}
+
+type TestCategoryRuleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MatchType        string                 `protobuf:"bytes,2,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`
+	Pattern          string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MinAmount        float64                `protobuf:"fixed64,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MinAmountDecimal string                 `protobuf:"bytes,5,opt,name=min_amount_decimal,json=minAmountDecimal,proto3" json:"min_amount_decimal,omitempty"`
+	MaxAmount        float64                `protobuf:"fixed64,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	MaxAmountDecimal string                 `protobuf:"bytes,7,opt,name=max_amount_decimal,json=maxAmountDecimal,proto3" json:"max_amount_decimal,omitempty"`
+	Category         string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Tags             []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	From             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=from,proto3" json:"from,omitempty"` 
+	To               *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=to,proto3" json:"to,omitempty"`     
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TestCategoryRuleRequest) Reset() {
+	*x = TestCategoryRuleRequest{}
+	mi := &file_ledger_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCategoryRuleRequest) ProtoMessage() {}
+
+func (x *TestCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*TestCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *TestCategoryRuleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TestCategoryRuleRequest) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *TestCategoryRuleRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *TestCategoryRuleRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *TestCategoryRuleRequest) GetMinAmountDecimal() string {
+	if x != nil {
+		return x.MinAmountDecimal
+	}
+	return ""
+}
+
+func (x *TestCategoryRuleRequest) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *TestCategoryRuleRequest) GetMaxAmountDecimal() string {
+	if x != nil {
+		return x.MaxAmountDecimal
+	}
+	return ""
+}
+
+func (x *TestCategoryRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TestCategoryRuleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TestCategoryRuleRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TestCategoryRuleRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type TestCategoryRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchedCount  int32                  `protobuf:"varint,1,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"` 
+	ChangedCount  int32                  `protobuf:"varint,2,opt,name=changed_count,json=changedCount,proto3" json:"changed_count,omitempty"` 
+	Samples       []*Transaction         `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`                                
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestCategoryRuleResponse) Reset() {
+	*x = TestCategoryRuleResponse{}
+	mi := &file_ledger_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCategoryRuleResponse) ProtoMessage() {}
+
+func (x *TestCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*TestCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TestCategoryRuleResponse) GetMatchedCount() int32 {
+	if x != nil {
+		return x.MatchedCount
+	}
+	return 0
+}
+
+func (x *TestCategoryRuleResponse) GetChangedCount() int32 {
+	if x != nil {
+		return x.ChangedCount
+	}
+	return 0
+}
+
+func (x *TestCategoryRuleResponse) GetSamples() []*Transaction {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type ImportCSVRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CsvData       []byte                 `protobuf:"bytes,2,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	DuplicateMode string                 `protobuf:"bytes,3,opt,name=duplicate_mode,json=duplicateMode,proto3" json:"duplicate_mode,omitempty"` 
+	Preview       bool                   `protobuf:"varint,4,opt,name=preview,proto3" json:"preview,omitempty"`                                 
+	Atomic        bool                   `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`                                   
+	Profile       string                 `protobuf:"bytes,6,opt,name=profile,proto3" json:"profile,omitempty"`                                  
+	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`                                    
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCSVRequest) Reset() {
+	*x = ImportCSVRequest{}
+	mi := &file_ledger_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCSVRequest) ProtoMessage() {}
+
+func (x *ImportCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportCSVRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ImportCSVRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportCSVRequest) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+func (x *ImportCSVRequest) GetDuplicateMode() string {
+	if x != nil {
+		return x.DuplicateMode
+	}
+	return ""
+}
+
+func (x *ImportCSVRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *ImportCSVRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *ImportCSVRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ImportCSVRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ImportStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	DuplicateMode string                 `protobuf:"bytes,3,opt,name=duplicate_mode,json=duplicateMode,proto3" json:"duplicate_mode,omitempty"`
+	Preview       bool                   `protobuf:"varint,4,opt,name=preview,proto3" json:"preview,omitempty"`
+	Atomic        bool                   `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	Profile       string                 `protobuf:"bytes,6,opt,name=profile,proto3" json:"profile,omitempty"`
+	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStreamRequest) Reset() {
+	*x = ImportStreamRequest{}
+	mi := &file_ledger_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStreamRequest) ProtoMessage() {}
+
+func (x *ImportStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportStreamRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ImportStreamRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportStreamRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ImportStreamRequest) GetDuplicateMode() string {
+	if x != nil {
+		return x.DuplicateMode
+	}
+	return ""
+}
+
+func (x *ImportStreamRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *ImportStreamRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *ImportStreamRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ImportStreamRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ImportCSVResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ImportedCount  int32                  `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	SkippedCount   int32                  `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	Errors         []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	DuplicateCount int32                  `protobuf:"varint,4,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	Duplicates     []*ImportDuplicate     `protobuf:"bytes,5,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Rows           []*ImportRow           `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"` 
+	BudgetImpact   []*BudgetImpact        `protobuf:"bytes,7,rep,name=budget_impact,json=budgetImpact,proto3" json:"budget_impact,omitempty"`
+	RolledBack     bool                   `protobuf:"varint,8,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"` 
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportCSVResponse) Reset() {
+	*x = ImportCSVResponse{}
+	mi := &file_ledger_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCSVResponse) ProtoMessage() {}
+
+func (x *ImportCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportCSVResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ImportCSVResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportCSVResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportCSVResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportCSVResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *ImportCSVResponse) GetDuplicates() []*ImportDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+func (x *ImportCSVResponse) GetRows() []*ImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportCSVResponse) GetBudgetImpact() []*BudgetImpact {
+	if x != nil {
+		return x.BudgetImpact
+	}
+	return nil
+}
+
+func (x *ImportCSVResponse) GetRolledBack() bool {
+	if x != nil {
+		return x.RolledBack
+	}
+	return false
+}
+
+type ImportDuplicate struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Transaction           *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	ExistingTransactionId int64                  `protobuf:"varint,2,opt,name=existing_transaction_id,json=existingTransactionId,proto3" json:"existing_transaction_id,omitempty"`
+	Probable              bool                   `protobuf:"varint,3,opt,name=probable,proto3" json:"probable,omitempty"` 
+	Imported              bool                   `protobuf:"varint,4,opt,name=imported,proto3" json:"imported,omitempty"` 
+	Line                  int32                  `protobuf:"varint,5,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ImportDuplicate) Reset() {
+	*x = ImportDuplicate{}
+	mi := &file_ledger_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDuplicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDuplicate) ProtoMessage() {}
+
+func (x *ImportDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportDuplicate) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ImportDuplicate) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *ImportDuplicate) GetExistingTransactionId() int64 {
+	if x != nil {
+		return x.ExistingTransactionId
+	}
+	return 0
+}
+
+func (x *ImportDuplicate) GetProbable() bool {
+	if x != nil {
+		return x.Probable
+	}
+	return false
+}
+
+func (x *ImportDuplicate) GetImported() bool {
+	if x != nil {
+		return x.Imported
+	}
+	return false
+}
+
+func (x *ImportDuplicate) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+type ImportRow struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Line                  int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` 
+	Transaction           *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Status                string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` 
+	Error                 string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Warning               string                 `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	ExistingTransactionId int64                  `protobuf:"varint,6,opt,name=existing_transaction_id,json=existingTransactionId,proto3" json:"existing_transaction_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ImportRow) Reset() {
+	*x = ImportRow{}
+	mi := &file_ledger_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRow) ProtoMessage() {}
+
+func (x *ImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportRow) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ImportRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRow) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *ImportRow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportRow) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportRow) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+func (x *ImportRow) GetExistingTransactionId() int64 {
+	if x != nil {
+		return x.ExistingTransactionId
+	}
+	return 0
+}
+
+type BudgetImpact struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Category        string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Enforcement     string                 `protobuf:"bytes,2,opt,name=enforcement,proto3" json:"enforcement,omitempty"`
+	Currency        string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` 
+	PeriodFrom      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty"`
+	PeriodTo        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty"`
+	Limit           float64                `protobuf:"fixed64,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Spent           float64                `protobuf:"fixed64,7,opt,name=spent,proto3" json:"spent,omitempty"`       
+	Incoming        float64                `protobuf:"fixed64,8,opt,name=incoming,proto3" json:"incoming,omitempty"` 
+	Exceeded        bool                   `protobuf:"varint,9,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	LimitDecimal    string                 `protobuf:"bytes,10,opt,name=limit_decimal,json=limitDecimal,proto3" json:"limit_decimal,omitempty"`
+	SpentDecimal    string                 `protobuf:"bytes,11,opt,name=spent_decimal,json=spentDecimal,proto3" json:"spent_decimal,omitempty"`
+	IncomingDecimal string                 `protobuf:"bytes,12,opt,name=incoming_decimal,json=incomingDecimal,proto3" json:"incoming_decimal,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BudgetImpact) Reset() {
+	*x = BudgetImpact{}
+	mi := &file_ledger_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetImpact) ProtoMessage() {}
+
+func (x *BudgetImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*BudgetImpact) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *BudgetImpact) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BudgetImpact) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+func (x *BudgetImpact) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BudgetImpact) GetPeriodFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return nil
+}
+
+func (x *BudgetImpact) GetPeriodTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodTo
+	}
+	return nil
+}
+
+func (x *BudgetImpact) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *BudgetImpact) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *BudgetImpact) GetIncoming() float64 {
+	if x != nil {
+		return x.Incoming
+	}
+	return 0
+}
+
+func (x *BudgetImpact) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+func (x *BudgetImpact) GetLimitDecimal() string {
+	if x != nil {
+		return x.LimitDecimal
+	}
+	return ""
+}
+
+func (x *BudgetImpact) GetSpentDecimal() string {
+	if x != nil {
+		return x.SpentDecimal
+	}
+	return ""
+}
+
+func (x *BudgetImpact) GetIncomingDecimal() string {
+	if x != nil {
+		return x.IncomingDecimal
+	}
+	return ""
+}
+
+type ImportProfile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Delimiter      string                 `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`                                                                       
+	DecimalComma   bool                   `protobuf:"varint,5,opt,name=decimal_comma,json=decimalComma,proto3" json:"decimal_comma,omitempty"`                                            
+	DateLayouts    []string               `protobuf:"bytes,6,rep,name=date_layouts,json=dateLayouts,proto3" json:"date_layouts,omitempty"`                                                
+	Encoding       string                 `protobuf:"bytes,7,opt,name=encoding,proto3" json:"encoding,omitempty"`                                                                         
+	SignConvention string                 `protobuf:"bytes,8,opt,name=sign_convention,json=signConvention,proto3" json:"sign_convention,omitempty"`                                       
+	Columns        map[string]string      `protobuf:"bytes,9,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` 
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportProfile) Reset() {
+	*x = ImportProfile{}
+	mi := &file_ledger_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProfile) ProtoMessage() {}
+
+func (x *ImportProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportProfile) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ImportProfile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportProfile) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetDecimalComma() bool {
+	if x != nil {
+		return x.DecimalComma
+	}
+	return false
+}
+
+func (x *ImportProfile) GetDateLayouts() []string {
+	if x != nil {
+		return x.DateLayouts
+	}
+	return nil
+}
+
+func (x *ImportProfile) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetSignConvention() string {
+	if x != nil {
+		return x.SignConvention
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateImportProfileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Delimiter      string                 `protobuf:"bytes,3,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	DecimalComma   bool                   `protobuf:"varint,4,opt,name=decimal_comma,json=decimalComma,proto3" json:"decimal_comma,omitempty"`
+	DateLayouts    []string               `protobuf:"bytes,5,rep,name=date_layouts,json=dateLayouts,proto3" json:"date_layouts,omitempty"`
+	Encoding       string                 `protobuf:"bytes,6,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	SignConvention string                 `protobuf:"bytes,7,opt,name=sign_convention,json=signConvention,proto3" json:"sign_convention,omitempty"`
+	Columns        map[string]string      `protobuf:"bytes,8,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateImportProfileRequest) Reset() {
+	*x = CreateImportProfileRequest{}
+	mi := &file_ledger_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImportProfileRequest) ProtoMessage() {}
+
+func (x *CreateImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CreateImportProfileRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateImportProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateImportProfileRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *CreateImportProfileRequest) GetDecimalComma() bool {
+	if x != nil {
+		return x.DecimalComma
+	}
+	return false
+}
+
+func (x *CreateImportProfileRequest) GetDateLayouts() []string {
+	if x != nil {
+		return x.DateLayouts
+	}
+	return nil
+}
+
+func (x *CreateImportProfileRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *CreateImportProfileRequest) GetSignConvention() string {
+	if x != nil {
+		return x.SignConvention
+	}
+	return ""
+}
+
+func (x *CreateImportProfileRequest) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type CreateImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *ImportProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateImportProfileResponse) Reset() {
+	*x = CreateImportProfileResponse{}
+	mi := &file_ledger_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImportProfileResponse) ProtoMessage() {}
+
+func (x *CreateImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*CreateImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateImportProfileResponse) GetProfile() *ImportProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetImportProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportProfilesRequest) Reset() {
+	*x = GetImportProfilesRequest{}
+	mi := &file_ledger_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportProfilesRequest) ProtoMessage() {}
+
+func (x *GetImportProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetImportProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetImportProfilesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetImportProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*ImportProfile       `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportProfilesResponse) Reset() {
+	*x = GetImportProfilesResponse{}
+	mi := &file_ledger_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportProfilesResponse) ProtoMessage() {}
+
+func (x *GetImportProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*GetImportProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetImportProfilesResponse) GetProfiles() []*ImportProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type UpdateImportProfileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Delimiter      string                 `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	DecimalComma   bool                   `protobuf:"varint,5,opt,name=decimal_comma,json=decimalComma,proto3" json:"decimal_comma,omitempty"`
+	DateLayouts    []string               `protobuf:"bytes,6,rep,name=date_layouts,json=dateLayouts,proto3" json:"date_layouts,omitempty"`
+	Encoding       string                 `protobuf:"bytes,7,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	SignConvention string                 `protobuf:"bytes,8,opt,name=sign_convention,json=signConvention,proto3" json:"sign_convention,omitempty"`
+	Columns        map[string]string      `protobuf:"bytes,9,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateImportProfileRequest) Reset() {
+	*x = UpdateImportProfileRequest{}
+	mi := &file_ledger_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateImportProfileRequest) ProtoMessage() {}
+
+func (x *UpdateImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UpdateImportProfileRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateImportProfileRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateImportProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateImportProfileRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *UpdateImportProfileRequest) GetDecimalComma() bool {
+	if x != nil {
+		return x.DecimalComma
+	}
+	return false
+}
+
+func (x *UpdateImportProfileRequest) GetDateLayouts() []string {
+	if x != nil {
+		return x.DateLayouts
+	}
+	return nil
+}
+
+func (x *UpdateImportProfileRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *UpdateImportProfileRequest) GetSignConvention() string {
+	if x != nil {
+		return x.SignConvention
+	}
+	return ""
+}
+
+func (x *UpdateImportProfileRequest) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type UpdateImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *ImportProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateImportProfileResponse) Reset() {
+	*x = UpdateImportProfileResponse{}
+	mi := &file_ledger_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateImportProfileResponse) ProtoMessage() {}
+
+func (x *UpdateImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*UpdateImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UpdateImportProfileResponse) GetProfile() *ImportProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type DeleteImportProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportProfileRequest) Reset() {
+	*x = DeleteImportProfileRequest{}
+	mi := &file_ledger_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportProfileRequest) ProtoMessage() {}
+
+func (x *DeleteImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DeleteImportProfileRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteImportProfileRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportProfileResponse) Reset() {
+	*x = DeleteImportProfileResponse{}
+	mi := &file_ledger_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportProfileResponse) ProtoMessage() {}
+
+func (x *DeleteImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*DeleteImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{108}
+}
+
+type ExportCSVRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCSVRequest) Reset() {
+	*x = ExportCSVRequest{}
+	mi := &file_ledger_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCSVRequest) ProtoMessage() {}
+
+func (x *ExportCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ExportCSVRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ExportCSVRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportCSVRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportCSVRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ExportCSVResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CsvData       []byte                 `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	RowsCount     int32                  `protobuf:"varint,2,opt,name=rows_count,json=rowsCount,proto3" json:"rows_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCSVResponse) Reset() {
+	*x = ExportCSVResponse{}
+	mi := &file_ledger_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCSVResponse) ProtoMessage() {}
+
+func (x *ExportCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ExportCSVResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ExportCSVResponse) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+func (x *ExportCSVResponse) GetRowsCount() int32 {
+	if x != nil {
+		return x.RowsCount
+	}
+	return 0
+}
+
+type ExportTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`   
+	Columns       []string               `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"` 
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsRequest) Reset() {
+	*x = ExportTransactionsRequest{}
+	mi := &file_ledger_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsRequest) ProtoMessage() {}
+
+func (x *ExportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ExportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ExportTransactionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportTransactionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportTransactionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportTransactionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportTransactionsRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type ExportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	RowsCount     int32                  `protobuf:"varint,4,opt,name=rows_count,json=rowsCount,proto3" json:"rows_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsResponse) Reset() {
+	*x = ExportTransactionsResponse{}
+	mi := &file_ledger_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsResponse) ProtoMessage() {}
+
+func (x *ExportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ExportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ExportTransactionsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportTransactionsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportTransactionsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportTransactionsResponse) GetRowsCount() int32 {
+	if x != nil {
+		return x.RowsCount
+	}
+	return 0
+}
+
+type ExportStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` 
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`                          
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStreamResponse) Reset() {
+	*x = ExportStreamResponse{}
+	mi := &file_ledger_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStreamResponse) ProtoMessage() {}
+
+func (x *ExportStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ExportStreamResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ExportStreamResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportStreamResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportStreamResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type ImportJob struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` 
+	Format         string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Profile        string                 `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	DuplicateMode  string                 `protobuf:"bytes,6,opt,name=duplicate_mode,json=duplicateMode,proto3" json:"duplicate_mode,omitempty"`
+	Atomic         bool                   `protobuf:"varint,7,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	TotalRows      int32                  `protobuf:"varint,8,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"` 
+	ProcessedRows  int32                  `protobuf:"varint,9,opt,name=processed_rows,json=processedRows,proto3" json:"processed_rows,omitempty"`
+	ImportedCount  int32                  `protobuf:"varint,10,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	DuplicateCount int32                  `protobuf:"varint,11,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	FailedCount    int32                  `protobuf:"varint,12,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Error          string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"` 
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
+	mi := &file_ledger_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJob) ProtoMessage() {}
+
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportJob) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ImportJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportJob) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportJob) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportJob) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ImportJob) GetDuplicateMode() string {
+	if x != nil {
+		return x.DuplicateMode
+	}
+	return ""
+}
+
+func (x *ImportJob) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *ImportJob) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportJob) GetProcessedRows() int32 {
+	if x != nil {
+		return x.ProcessedRows
+	}
+	return 0
+}
+
+func (x *ImportJob) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportJob) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *ImportJob) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ImportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type ImportJobError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobError) Reset() {
+	*x = ImportJobError{}
+	mi := &file_ledger_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobError) ProtoMessage() {}
+
+func (x *ImportJobError) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*ImportJobError) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ImportJobError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportJobError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SubmitImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	DuplicateMode string                 `protobuf:"bytes,3,opt,name=duplicate_mode,json=duplicateMode,proto3" json:"duplicate_mode,omitempty"`
+	Atomic        bool                   `protobuf:"varint,4,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	Profile       string                 `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	Format        string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitImportRequest) Reset() {
+	*x = SubmitImportRequest{}
+	mi := &file_ledger_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitImportRequest) ProtoMessage() {}
+
+func (x *SubmitImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*SubmitImportRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SubmitImportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitImportRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SubmitImportRequest) GetDuplicateMode() string {
+	if x != nil {
+		return x.DuplicateMode
+	}
+	return ""
+}
+
+func (x *SubmitImportRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *SubmitImportRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *SubmitImportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type SubmitImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ImportJob             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitImportResponse) Reset() {
+	*x = SubmitImportResponse{}
+	mi := &file_ledger_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitImportResponse) ProtoMessage() {}
+
+func (x *SubmitImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (*SubmitImportResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SubmitImportResponse) GetJob() *ImportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReportRequest) Reset() {
-	*x = GetReportRequest{}
-	mi := &file_ledger_proto_msgTypes[11]
+func (x *GetImportRequest) Reset() {
+	*x = GetImportRequest{}
+	mi := &file_ledger_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReportRequest) String() string {
+func (x *GetImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReportRequest) ProtoMessage() {}
+func (*GetImportRequest) ProtoMessage() {}
 
-func (x *GetReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[11]
+func (x *GetImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,56 +8498,47 @@ func (x *GetReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*GetReportRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{11}
+func (*GetImportRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetReportRequest) GetUserId() int64 {
+func (x *GetImportRequest) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetReportRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *GetReportRequest) GetTo() *timestamppb.Timestamp {
+func (x *GetImportRequest) GetUserId() int64 {
 	if x != nil {
-		return x.To
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type GetReportResponse struct {
+type GetImportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*CategorySummary     `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	TotalExpenses float64                `protobuf:"fixed64,2,opt,name=total_expenses,json=totalExpenses,proto3" json:"total_expenses,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Job           *ImportJob             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Errors        []*ImportJobError      `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` 
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReportResponse) Reset() {
-	*x = GetReportResponse{}
-	mi := &file_ledger_proto_msgTypes[12]
+func (x *GetImportResponse) Reset() {
+	*x = GetImportResponse{}
+	mi := &file_ledger_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReportResponse) String() string {
+func (x *GetImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReportResponse) ProtoMessage() {}
+func (*GetImportResponse) ProtoMessage() {}
 
-func (x *GetReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[12]
+func (x *GetImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,61 +8549,47 @@ func (x *GetReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*GetReportResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *GetReportResponse) GetCategories() []*CategorySummary {
-	if x != nil {
-		return x.Categories
-	}
-	return nil
-}
-
-func (x *GetReportResponse) GetTotalExpenses() float64 {
-	if x != nil {
-		return x.TotalExpenses
-	}
-	return 0
+func (*GetImportResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetReportResponse) GetFrom() *timestamppb.Timestamp {
+func (x *GetImportResponse) GetJob() *ImportJob {
 	if x != nil {
-		return x.From
+		return x.Job
 	}
 	return nil
 }
 
-func (x *GetReportResponse) GetTo() *timestamppb.Timestamp {
+func (x *GetImportResponse) GetErrors() []*ImportJobError {
 	if x != nil {
-		return x.To
+		return x.Errors
 	}
 	return nil
 }
 
-type ImportCSVRequest struct {
+type CancelImportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CsvData       []byte                 `protobuf:"bytes,2,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportCSVRequest) Reset() {
-	*x = ImportCSVRequest{}
-	mi := &file_ledger_proto_msgTypes[13]
+func (x *CancelImportRequest) Reset() {
+	*x = CancelImportRequest{}
+	mi := &file_ledger_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportCSVRequest) String() string {
+func (x *CancelImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportCSVRequest) ProtoMessage() {}
+func (*CancelImportRequest) ProtoMessage() {}
 
-func (x *ImportCSVRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[13]
+func (x *CancelImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,48 +8600,46 @@ func (x *ImportCSVRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*ImportCSVRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{13}
+func (*CancelImportRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *ImportCSVRequest) GetUserId() int64 {
+func (x *CancelImportRequest) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ImportCSVRequest) GetCsvData() []byte {
+func (x *CancelImportRequest) GetUserId() int64 {
 	if x != nil {
-		return x.CsvData
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type ImportCSVResponse struct {
+type CancelImportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImportedCount int32                  `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
-	SkippedCount  int32                  `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
-	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Job           *ImportJob             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportCSVResponse) Reset() {
-	*x = ImportCSVResponse{}
-	mi := &file_ledger_proto_msgTypes[14]
+func (x *CancelImportResponse) Reset() {
+	*x = CancelImportResponse{}
+	mi := &file_ledger_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportCSVResponse) String() string {
+func (x *CancelImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportCSVResponse) ProtoMessage() {}
+func (*CancelImportResponse) ProtoMessage() {}
 
-func (x *ImportCSVResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[14]
+func (x *CancelImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,55 +8650,40 @@ func (x *ImportCSVResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*ImportCSVResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ImportCSVResponse) GetImportedCount() int32 {
-	if x != nil {
-		return x.ImportedCount
-	}
-	return 0
-}
-
-func (x *ImportCSVResponse) GetSkippedCount() int32 {
-	if x != nil {
-		return x.SkippedCount
-	}
-	return 0
+func (*CancelImportResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *ImportCSVResponse) GetErrors() []string {
+func (x *CancelImportResponse) GetJob() *ImportJob {
 	if x != nil {
-		return x.Errors
+		return x.Job
 	}
 	return nil
 }
 
-type ExportCSVRequest struct {
+type WatchImportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportCSVRequest) Reset() {
-	*x = ExportCSVRequest{}
-	mi := &file_ledger_proto_msgTypes[15]
+func (x *WatchImportRequest) Reset() {
+	*x = WatchImportRequest{}
+	mi := &file_ledger_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportCSVRequest) String() string {
+func (x *WatchImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportCSVRequest) ProtoMessage() {}
+func (*WatchImportRequest) ProtoMessage() {}
 
-func (x *ExportCSVRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[15]
+func (x *WatchImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,54 +8694,46 @@ func (x *ExportCSVRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*ExportCSVRequest) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{15}
+func (*WatchImportRequest) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *ExportCSVRequest) GetUserId() int64 {
+func (x *WatchImportRequest) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ExportCSVRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *ExportCSVRequest) GetTo() *timestamppb.Timestamp {
+func (x *WatchImportRequest) GetUserId() int64 {
 	if x != nil {
-		return x.To
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type ExportCSVResponse struct {
+type WatchImportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CsvData       []byte                 `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
-	RowsCount     int32                  `protobuf:"varint,2,opt,name=rows_count,json=rowsCount,proto3" json:"rows_count,omitempty"`
+	Job           *ImportJob             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportCSVResponse) Reset() {
-	*x = ExportCSVResponse{}
-	mi := &file_ledger_proto_msgTypes[16]
+func (x *WatchImportResponse) Reset() {
+	*x = WatchImportResponse{}
+	mi := &file_ledger_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportCSVResponse) String() string {
+func (x *WatchImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportCSVResponse) ProtoMessage() {}
+func (*WatchImportResponse) ProtoMessage() {}
 
-func (x *ExportCSVResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ledger_proto_msgTypes[16]
+func (x *WatchImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,29 +8744,22 @@ func (x *ExportCSVResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (*ExportCSVResponse) Descriptor() ([]byte, []int) {
-	return file_ledger_proto_rawDescGZIP(), []int{16}
+func (*WatchImportResponse) Descriptor() ([]byte, []int) {
+	return file_ledger_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *ExportCSVResponse) GetCsvData() []byte {
+func (x *WatchImportResponse) GetJob() *ImportJob {
 	if x != nil {
-		return x.CsvData
+		return x.Job
 	}
 	return nil
 }
 
-func (x *ExportCSVResponse) GetRowsCount() int32 {
-	if x != nil {
-		return x.RowsCount
-	}
-	return 0
-}
-
 var File_ledger_proto protoreflect.FileDescriptor
 
 const file_ledger_proto_rawDesc = "" +
 	"\n" +
-	"\fledger.proto\x12\tledger.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x01\n" +
+	"\fledger.proto\x12\tledger.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb8\x04\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
@@ -1047,64 +8768,693 @@ const file_ledger_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12.\n" +
 	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb6\x01\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04kind\x18\b \x01(\tR\x04kind\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12%\n" +
+	"\x0eamount_decimal\x18\n" +
+	" \x01(\tR\ramountDecimal\x12*\n" +
+	"\x11recurring_rule_id\x18\v \x01(\x03R\x0frecurringRuleId\x12\x1f\n" +
+	"\vover_budget\x18\f \x01(\bR\n" +
+	"overBudget\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x123\n" +
+	"\x06splits\x18\x0e \x03(\v2\x1b.ledger.v1.TransactionSplitR\x06splits\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x0f \x01(\x03R\taccountId\x12\x1f\n" +
+	"\vtransfer_id\x18\x10 \x01(\x03R\n" +
+	"transferId\x12\x12\n" +
+	"\x04rank\x18\x11 \x01(\x02R\x04rank\"\x81\x01\n" +
+	"\x10TransactionSplit\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x03 \x01(\tR\ramountDecimal\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\xf5\x02\n" +
 	"\x15AddTransactionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12.\n" +
-	"\x04date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xa2\x01\n" +
+	"\x04date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12%\n" +
+	"\x0eamount_decimal\x18\b \x01(\tR\ramountDecimal\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x123\n" +
+	"\x06splits\x18\n" +
+	" \x03(\v2\x1b.ledger.v1.TransactionSplitR\x06splits\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\v \x01(\x03R\taccountId\"\xa2\x01\n" +
 	"\x16AddTransactionResponse\x128\n" +
 	"\vtransaction\x18\x01 \x01(\v2\x16.ledger.v1.TransactionR\vtransaction\x12'\n" +
 	"\x0fbudget_exceeded\x18\x02 \x01(\bR\x0ebudgetExceeded\x12%\n" +
-	"\x0ebudget_warning\x18\x03 \x01(\tR\rbudgetWarning\"\xa9\x01\n" +
+	"\x0ebudget_warning\x18\x03 \x01(\tR\rbudgetWarning\"\x95\x04\n" +
 	"\x16GetTransactionsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
 	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
 	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1a\n" +
-	"\bcategory\x18\x04 \x01(\tR\bcategory\"U\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04sort\x18\a \x01(\tR\x04sort\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1b\n" +
+	"\ttag_match\x18\t \x01(\tR\btagMatch\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\n" +
+	" \x01(\x03R\taccountId\x12\x14\n" +
+	"\x05query\x18\v \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\f \x01(\x01R\tminAmount\x12,\n" +
+	"\x12min_amount_decimal\x18\r \x01(\tR\x10minAmountDecimal\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x0e \x01(\x01R\tmaxAmount\x12,\n" +
+	"\x12max_amount_decimal\x18\x0f \x01(\tR\x10maxAmountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x10 \x01(\tR\bcurrency\"}\n" +
 	"\x17GetTransactionsResponse\x12:\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x16.ledger.v1.TransactionR\ftransactions\"\x88\x01\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x16.ledger.v1.TransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x03\n" +
+	"\x18UpdateTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12.\n" +
+	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x12\n" +
+	"\x04kind\x18\a \x01(\tR\x04kind\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12%\n" +
+	"\x0eamount_decimal\x18\t \x01(\tR\ramountDecimal\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x123\n" +
+	"\x06splits\x18\v \x03(\v2\x1b.ledger.v1.TransactionSplitR\x06splits\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\f \x01(\x03R\taccountId\"\xa5\x01\n" +
+	"\x19UpdateTransactionResponse\x128\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x16.ledger.v1.TransactionR\vtransaction\x12'\n" +
+	"\x0fbudget_exceeded\x18\x02 \x01(\bR\x0ebudgetExceeded\x12%\n" +
+	"\x0ebudget_warning\x18\x03 \x01(\tR\rbudgetWarning\"C\n" +
+	"\x18DeleteTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x1b\n" +
+	"\x19DeleteTransactionResponse\"\xd2\x03\n" +
 	"\x06Budget\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x12!\n" +
 	"\flimit_amount\x18\x04 \x01(\x01R\vlimitAmount\x12\x16\n" +
-	"\x06period\x18\x05 \x01(\tR\x06period\"\x82\x01\n" +
+	"\x06period\x18\x05 \x01(\tR\x06period\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x120\n" +
+	"\x14limit_amount_decimal\x18\a \x01(\tR\x12limitAmountDecimal\x12\x1b\n" +
+	"\tstart_day\x18\b \x01(\x05R\bstartDay\x12\x1a\n" +
+	"\brollover\x18\t \x01(\bR\brollover\x12 \n" +
+	"\venforcement\x18\n" +
+	" \x01(\tR\venforcement\x12-\n" +
+	"\x12warning_thresholds\x18\v \x03(\x05R\x11warningThresholds\x129\n" +
+	"\n" +
+	"valid_from\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"\xda\x02\n" +
 	"\x10SetBudgetRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\x12!\n" +
 	"\flimit_amount\x18\x03 \x01(\x01R\vlimitAmount\x12\x16\n" +
-	"\x06period\x18\x04 \x01(\tR\x06period\">\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x120\n" +
+	"\x14limit_amount_decimal\x18\x06 \x01(\tR\x12limitAmountDecimal\x12\x1b\n" +
+	"\tstart_day\x18\a \x01(\x05R\bstartDay\x12\x1a\n" +
+	"\brollover\x18\b \x01(\bR\brollover\x12 \n" +
+	"\venforcement\x18\t \x01(\tR\venforcement\x12-\n" +
+	"\x12warning_thresholds\x18\n" +
+	" \x03(\x05R\x11warningThresholds\">\n" +
 	"\x11SetBudgetResponse\x12)\n" +
 	"\x06budget\x18\x01 \x01(\v2\x11.ledger.v1.BudgetR\x06budget\",\n" +
 	"\x11GetBudgetsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"A\n" +
 	"\x12GetBudgetsResponse\x12+\n" +
-	"\abudgets\x18\x01 \x03(\v2\x11.ledger.v1.BudgetR\abudgets\"\x93\x01\n" +
+	"\abudgets\x18\x01 \x03(\v2\x11.ledger.v1.BudgetR\abudgets\"N\n" +
+	"\x17GetBudgetHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"I\n" +
+	"\x18GetBudgetHistoryResponse\x12-\n" +
+	"\bversions\x18\x01 \x03(\v2\x11.ledger.v1.BudgetR\bversions\"J\n" +
+	"\x13DeleteBudgetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"\x16\n" +
+	"\x14DeleteBudgetResponse\"\xf7\x03\n" +
+	"\fBudgetStatus\x12)\n" +
+	"\x06budget\x18\x01 \x01(\v2\x11.ledger.v1.BudgetR\x06budget\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12;\n" +
+	"\vperiod_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"periodFrom\x127\n" +
+	"\tperiod_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bperiodTo\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x01R\x05limit\x12\x14\n" +
+	"\x05spent\x18\x06 \x01(\x01R\x05spent\x12\x1c\n" +
+	"\tremaining\x18\a \x01(\x01R\tremaining\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\b \x01(\x01R\n" +
+	"percentage\x12\x1c\n" +
+	"\tprojected\x18\t \x01(\x01R\tprojected\x12#\n" +
+	"\rlimit_decimal\x18\n" +
+	" \x01(\tR\flimitDecimal\x12#\n" +
+	"\rspent_decimal\x18\v \x01(\tR\fspentDecimal\x12+\n" +
+	"\x11remaining_decimal\x18\f \x01(\tR\x10remainingDecimal\x12+\n" +
+	"\x11projected_decimal\x18\r \x01(\tR\x10projectedDecimal\"1\n" +
+	"\x16GetBudgetStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"N\n" +
+	"\x17GetBudgetStatusResponse\x123\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x17.ledger.v1.BudgetStatusR\bstatuses\"\xb5\x01\n" +
+	"\rCurrencyTotal\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\tconverted\x18\x03 \x01(\x01R\tconverted\x12%\n" +
+	"\x0eamount_decimal\x18\x04 \x01(\tR\ramountDecimal\x12+\n" +
+	"\x11converted_decimal\x18\x05 \x01(\tR\x10convertedDecimal\"\x91\x03\n" +
 	"\x0fCategorySummary\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x01R\x05total\x12!\n" +
 	"\fbudget_limit\x18\x03 \x01(\x01R\vbudgetLimit\x12+\n" +
-	"\x11budget_percentage\x18\x04 \x01(\x01R\x10budgetPercentage\"\x87\x01\n" +
+	"\x11budget_percentage\x18\x04 \x01(\x01R\x10budgetPercentage\x128\n" +
+	"\n" +
+	"currencies\x18\x05 \x03(\v2\x18.ledger.v1.CurrencyTotalR\n" +
+	"currencies\x12#\n" +
+	"\rtotal_decimal\x18\x06 \x01(\tR\ftotalDecimal\x120\n" +
+	"\x14budget_limit_decimal\x18\a \x01(\tR\x12budgetLimitDecimal\x12\x16\n" +
+	"\x06parent\x18\b \x01(\tR\x06parent\x12!\n" +
+	"\frollup_total\x18\t \x01(\x01R\vrollupTotal\x120\n" +
+	"\x14rollup_total_decimal\x18\n" +
+	" \x01(\tR\x12rollupTotalDecimal\"\x87\x01\n" +
 	"\x10GetReportRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
 	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
-	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xd2\x01\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xf6\x03\n" +
 	"\x11GetReportResponse\x12:\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1a.ledger.v1.CategorySummaryR\n" +
 	"categories\x12%\n" +
 	"\x0etotal_expenses\x18\x02 \x01(\x01R\rtotalExpenses\x12.\n" +
 	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
-	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"F\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12!\n" +
+	"\ftotal_income\x18\x05 \x01(\x01R\vtotalIncome\x12\x1f\n" +
+	"\vnet_balance\x18\x06 \x01(\x01R\n" +
+	"netBalance\x12!\n" +
+	"\fsavings_rate\x18\a \x01(\x01R\vsavingsRate\x12#\n" +
+	"\rbase_currency\x18\b \x01(\tR\fbaseCurrency\x124\n" +
+	"\x16total_expenses_decimal\x18\t \x01(\tR\x14totalExpensesDecimal\x120\n" +
+	"\x14total_income_decimal\x18\n" +
+	" \x01(\tR\x12totalIncomeDecimal\x12.\n" +
+	"\x13net_balance_decimal\x18\v \x01(\tR\x11netBalanceDecimal\"\xc0\x01\n" +
+	"\n" +
+	"TagSummary\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x01R\x05total\x12+\n" +
+	"\x11transaction_count\x18\x03 \x01(\x03R\x10transactionCount\x128\n" +
+	"\n" +
+	"currencies\x18\x04 \x03(\v2\x18.ledger.v1.CurrencyTotalR\n" +
+	"currencies\x12#\n" +
+	"\rtotal_decimal\x18\x05 \x01(\tR\ftotalDecimal\"\x9e\x01\n" +
+	"\x13GetTagReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\"\xc2\x01\n" +
+	"\x14GetTagReportResponse\x12)\n" +
+	"\x04tags\x18\x01 \x03(\v2\x15.ledger.v1.TagSummaryR\x04tags\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12#\n" +
+	"\rbase_currency\x18\x04 \x01(\tR\fbaseCurrency\"\xf8\x01\n" +
+	"\fPeriodChange\x12\x18\n" +
+	"\acurrent\x18\x01 \x01(\x01R\acurrent\x12\x1a\n" +
+	"\bprevious\x18\x02 \x01(\x01R\bprevious\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x01R\x05delta\x12#\n" +
+	"\rdelta_percent\x18\x04 \x01(\x01R\fdeltaPercent\x12'\n" +
+	"\x0fcurrent_decimal\x18\x05 \x01(\tR\x0ecurrentDecimal\x12)\n" +
+	"\x10previous_decimal\x18\x06 \x01(\tR\x0fpreviousDecimal\x12#\n" +
+	"\rdelta_decimal\x18\a \x01(\tR\fdeltaDecimal\"\x91\x01\n" +
+	"\x12CategoryComparison\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\x12/\n" +
+	"\x06change\x18\x03 \x01(\v2\x17.ledger.v1.PeriodChangeR\x06change\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\xa8\x01\n" +
+	"\x15CompareReportsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1a\n" +
+	"\bbaseline\x18\x04 \x01(\tR\bbaseline\"\xf0\x04\n" +
+	"\x16CompareReportsResponse\x12=\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1d.ledger.v1.CategoryComparisonR\n" +
+	"categories\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1a\n" +
+	"\bbaseline\x18\x04 \x01(\tR\bbaseline\x12?\n" +
+	"\rbaseline_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fbaselineFrom\x12;\n" +
+	"\vbaseline_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"baselineTo\x12#\n" +
+	"\rbase_currency\x18\a \x01(\tR\fbaseCurrency\x123\n" +
+	"\bexpenses\x18\b \x01(\v2\x17.ledger.v1.PeriodChangeR\bexpenses\x12/\n" +
+	"\x06income\x18\t \x01(\v2\x17.ledger.v1.PeriodChangeR\x06income\x128\n" +
+	"\vnet_balance\x18\n" +
+	" \x01(\v2\x17.ledger.v1.PeriodChangeR\n" +
+	"netBalance\x12%\n" +
+	"\x0enew_categories\x18\v \x03(\tR\rnewCategories\x125\n" +
+	"\x16disappeared_categories\x18\f \x03(\tR\x15disappearedCategories\"\xb2\x02\n" +
+	"\vTrendBucket\x122\n" +
+	"\x06period\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06period\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x01R\x05total\x12#\n" +
+	"\rrunning_total\x18\x03 \x01(\x01R\frunningTotal\x12%\n" +
+	"\x0emoving_average\x18\x04 \x01(\x01R\rmovingAverage\x12#\n" +
+	"\rtotal_decimal\x18\x05 \x01(\tR\ftotalDecimal\x122\n" +
+	"\x15running_total_decimal\x18\x06 \x01(\tR\x13runningTotalDecimal\x124\n" +
+	"\x16moving_average_decimal\x18\a \x01(\tR\x14movingAverageDecimal\"\x96\x01\n" +
+	"\vTrendSeries\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x01R\x05total\x12#\n" +
+	"\rtotal_decimal\x18\x03 \x01(\tR\ftotalDecimal\x120\n" +
+	"\abuckets\x18\x04 \x03(\v2\x16.ledger.v1.TrendBucketR\abuckets\"\xe2\x01\n" +
+	"\x10GetTrendsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12 \n" +
+	"\vgranularity\x18\x04 \x01(\tR\vgranularity\x12\x1f\n" +
+	"\vby_category\x18\x05 \x01(\bR\n" +
+	"byCategory\x12\x16\n" +
+	"\x06window\x18\x06 \x01(\x05R\x06window\"\xf3\x02\n" +
+	"\x11GetTrendsResponse\x120\n" +
+	"\abuckets\x18\x01 \x03(\v2\x16.ledger.v1.TrendBucketR\abuckets\x126\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x16.ledger.v1.TrendSeriesR\n" +
+	"categories\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12 \n" +
+	"\vgranularity\x18\x05 \x01(\tR\vgranularity\x12\x16\n" +
+	"\x06window\x18\x06 \x01(\x05R\x06window\x12#\n" +
+	"\rbase_currency\x18\a \x01(\tR\fbaseCurrency\x12\x14\n" +
+	"\x05total\x18\b \x01(\x01R\x05total\x12#\n" +
+	"\rtotal_decimal\x18\t \x01(\tR\ftotalDecimal\"\x9e\x01\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\"-\n" +
+	"\x12GetSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\":\n" +
+	"\x13GetSettingsResponse\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\"V\n" +
+	"\x16SetBaseCurrencyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rbase_currency\x18\x02 \x01(\tR\fbaseCurrency\">\n" +
+	"\x17SetBaseCurrencyResponse\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\"a\n" +
+	"\x17SetExchangeRatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12-\n" +
+	"\x05rates\x18\x02 \x03(\v2\x17.ledger.v1.ExchangeRateR\x05rates\";\n" +
+	"\x18SetExchangeRatesResponse\x12\x1f\n" +
+	"\vsaved_count\x18\x01 \x01(\x05R\n" +
+	"savedCount\"P\n" +
+	"\x1aImportExchangeRatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bcsv_data\x18\x02 \x01(\fR\acsvData\"\x81\x01\n" +
+	"\x1bImportExchangeRatesResponse\x12%\n" +
+	"\x0eimported_count\x18\x01 \x01(\x05R\rimportedCount\x12#\n" +
+	"\rskipped_count\x18\x02 \x01(\x05R\fskippedCount\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\"\x8e\x01\n" +
+	"\x17GetExchangeRatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"I\n" +
+	"\x18GetExchangeRatesResponse\x12-\n" +
+	"\x05rates\x18\x01 \x03(\v2\x17.ledger.v1.ExchangeRateR\x05rates\"\xa4\x04\n" +
+	"\rRecurringRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x05 \x01(\tR\ramountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1c\n" +
+	"\tfrequency\x18\t \x01(\tR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\n" +
+	" \x01(\x05R\binterval\x129\n" +
+	"\n" +
+	"start_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x127\n" +
+	"\tnext_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\bnextDate\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x0e \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8e\x03\n" +
+	"\x1aCreateRecurringRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x04 \x01(\tR\ramountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1c\n" +
+	"\tfrequency\x18\b \x01(\tR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\t \x01(\x05R\binterval\x129\n" +
+	"\n" +
+	"start_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"K\n" +
+	"\x1bCreateRecurringRuleResponse\x12,\n" +
+	"\x04rule\x18\x01 \x01(\v2\x18.ledger.v1.RecurringRuleR\x04rule\"3\n" +
+	"\x18GetRecurringRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"K\n" +
+	"\x19GetRecurringRulesResponse\x12.\n" +
+	"\x05rules\x18\x01 \x03(\v2\x18.ledger.v1.RecurringRuleR\x05rules\"\x9e\x03\n" +
+	"\x1aUpdateRecurringRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x05 \x01(\tR\ramountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1c\n" +
+	"\tfrequency\x18\t \x01(\tR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\n" +
+	" \x01(\x05R\binterval\x129\n" +
+	"\n" +
+	"start_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"K\n" +
+	"\x1bUpdateRecurringRuleResponse\x12,\n" +
+	"\x04rule\x18\x01 \x01(\v2\x18.ledger.v1.RecurringRuleR\x04rule\"E\n" +
+	"\x1aDeleteRecurringRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x1d\n" +
+	"\x1bDeleteRecurringRuleResponse\"\xbb\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12\x1a\n" +
+	"\barchived\x18\x05 \x01(\bR\barchived\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\x15CreateCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\"I\n" +
+	"\x16CreateCategoryResponse\x12/\n" +
+	"\bcategory\x18\x01 \x01(\v2\x13.ledger.v1.CategoryR\bcategory\"Z\n" +
+	"\x14GetCategoriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"L\n" +
+	"\x15GetCategoriesResponse\x123\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x13.ledger.v1.CategoryR\n" +
+	"categories\"\x8d\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12\x1a\n" +
+	"\barchived\x18\x05 \x01(\bR\barchived\"I\n" +
+	"\x16UpdateCategoryResponse\x12/\n" +
+	"\bcategory\x18\x01 \x01(\v2\x13.ledger.v1.CategoryR\bcategory\"@\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x18\n" +
+	"\x16DeleteCategoryResponse\"k\n" +
+	"\x16MergeCategoriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x03R\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\"y\n" +
+	"\x17MergeCategoriesResponse\x12/\n" +
+	"\bcategory\x18\x01 \x01(\v2\x13.ledger.v1.CategoryR\bcategory\x12-\n" +
+	"\x12moved_transactions\x18\x02 \x01(\x03R\x11movedTransactions\"\xf1\x02\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fopening_balance\x18\x06 \x01(\x01R\x0eopeningBalance\x126\n" +
+	"\x17opening_balance_decimal\x18\a \x01(\tR\x15openingBalanceDecimal\x12\x18\n" +
+	"\abalance\x18\b \x01(\x01R\abalance\x12'\n" +
+	"\x0fbalance_decimal\x18\t \x01(\tR\x0ebalanceDecimal\x12\x1a\n" +
+	"\barchived\x18\n" +
+	" \x01(\bR\barchived\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd4\x01\n" +
+	"\x14CreateAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fopening_balance\x18\x05 \x01(\x01R\x0eopeningBalance\x126\n" +
+	"\x17opening_balance_decimal\x18\x06 \x01(\tR\x15openingBalanceDecimal\"E\n" +
+	"\x15CreateAccountResponse\x12,\n" +
+	"\aaccount\x18\x01 \x01(\v2\x12.ledger.v1.AccountR\aaccount\"X\n" +
+	"\x12GetAccountsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"E\n" +
+	"\x13GetAccountsResponse\x12.\n" +
+	"\baccounts\x18\x01 \x03(\v2\x12.ledger.v1.AccountR\baccounts\"\x80\x02\n" +
+	"\x14UpdateAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fopening_balance\x18\x06 \x01(\x01R\x0eopeningBalance\x126\n" +
+	"\x17opening_balance_decimal\x18\a \x01(\tR\x15openingBalanceDecimal\x12\x1a\n" +
+	"\barchived\x18\b \x01(\bR\barchived\"E\n" +
+	"\x15UpdateAccountResponse\x12,\n" +
+	"\aaccount\x18\x01 \x01(\v2\x12.ledger.v1.AccountR\aaccount\"?\n" +
+	"\x14DeleteAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x17\n" +
+	"\x15DeleteAccountResponse\"\x94\x03\n" +
+	"\bTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12&\n" +
+	"\x0ffrom_account_id\x18\x03 \x01(\x03R\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x04 \x01(\x03R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x06 \x01(\tR\ramountDecimal\x12\x1b\n" +
+	"\tto_amount\x18\a \x01(\x01R\btoAmount\x12*\n" +
+	"\x11to_amount_decimal\x18\b \x01(\tR\x0ftoAmountDecimal\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12.\n" +
+	"\x04date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd6\x02\n" +
+	"\x15CreateTransferRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0ffrom_account_id\x18\x02 \x01(\x03R\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x03 \x01(\x03R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x05 \x01(\tR\ramountDecimal\x12\x1b\n" +
+	"\tto_amount\x18\x06 \x01(\x01R\btoAmount\x12*\n" +
+	"\x11to_amount_decimal\x18\a \x01(\tR\x0ftoAmountDecimal\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12.\n" +
+	"\x04date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\x85\x01\n" +
+	"\x16CreateTransferResponse\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.ledger.v1.TransferR\btransfer\x12:\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x16.ledger.v1.TransactionR\ftransactions\"\xcb\x01\n" +
+	"\x19GetAccountBalancesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\tR\binterval\"\x81\x01\n" +
+	"\fBalancePoint\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x01R\abalance\x12'\n" +
+	"\x0fbalance_decimal\x18\x03 \x01(\tR\x0ebalanceDecimal\"\x7f\n" +
+	"\x1aGetAccountBalancesResponse\x12,\n" +
+	"\aaccount\x18\x01 \x01(\v2\x12.ledger.v1.AccountR\aaccount\x123\n" +
+	"\bbalances\x18\x02 \x03(\v2\x17.ledger.v1.BalancePointR\bbalances\"\x91\x03\n" +
+	"\fCategoryRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"match_type\x18\x03 \x01(\tR\tmatchType\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\x01R\tminAmount\x12,\n" +
+	"\x12min_amount_decimal\x18\x06 \x01(\tR\x10minAmountDecimal\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\a \x01(\x01R\tmaxAmount\x12,\n" +
+	"\x12max_amount_decimal\x18\b \x01(\tR\x10maxAmountDecimal\x12\x1a\n" +
+	"\bcategory\x18\t \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd3\x02\n" +
+	"\x19CreateCategoryRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"match_type\x18\x02 \x01(\tR\tmatchType\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x04 \x01(\x01R\tminAmount\x12,\n" +
+	"\x12min_amount_decimal\x18\x05 \x01(\tR\x10minAmountDecimal\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x06 \x01(\x01R\tmaxAmount\x12,\n" +
+	"\x12max_amount_decimal\x18\a \x01(\tR\x10maxAmountDecimal\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1a\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x05R\bpriority\"I\n" +
+	"\x1aCreateCategoryRuleResponse\x12+\n" +
+	"\x04rule\x18\x01 \x01(\v2\x17.ledger.v1.CategoryRuleR\x04rule\"2\n" +
+	"\x17GetCategoryRulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"I\n" +
+	"\x18GetCategoryRulesResponse\x12-\n" +
+	"\x05rules\x18\x01 \x03(\v2\x17.ledger.v1.CategoryRuleR\x05rules\"\xe3\x02\n" +
+	"\x19UpdateCategoryRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"match_type\x18\x03 \x01(\tR\tmatchType\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\x01R\tminAmount\x12,\n" +
+	"\x12min_amount_decimal\x18\x06 \x01(\tR\x10minAmountDecimal\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\a \x01(\x01R\tmaxAmount\x12,\n" +
+	"\x12max_amount_decimal\x18\b \x01(\tR\x10maxAmountDecimal\x12\x1a\n" +
+	"\bcategory\x18\t \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\"I\n" +
+	"\x1aUpdateCategoryRuleResponse\x12+\n" +
+	"\x04rule\x18\x01 \x01(\v2\x17.ledger.v1.CategoryRuleR\x04rule\"D\n" +
+	"\x19DeleteCategoryRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x1c\n" +
+	"\x1aDeleteCategoryRuleResponse\"\x91\x03\n" +
+	"\x17TestCategoryRuleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"match_type\x18\x02 \x01(\tR\tmatchType\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x04 \x01(\x01R\tminAmount\x12,\n" +
+	"\x12min_amount_decimal\x18\x05 \x01(\tR\x10minAmountDecimal\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x06 \x01(\x01R\tmaxAmount\x12,\n" +
+	"\x12max_amount_decimal\x18\a \x01(\tR\x10maxAmountDecimal\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12.\n" +
+	"\x04from\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x96\x01\n" +
+	"\x18TestCategoryRuleResponse\x12#\n" +
+	"\rmatched_count\x18\x01 \x01(\x05R\fmatchedCount\x12#\n" +
+	"\rchanged_count\x18\x02 \x01(\x05R\fchangedCount\x120\n" +
+	"\asamples\x18\x03 \x03(\v2\x16.ledger.v1.TransactionR\asamples\"\xd1\x01\n" +
 	"\x10ImportCSVRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
-	"\bcsv_data\x18\x02 \x01(\fR\acsvData\"w\n" +
+	"\bcsv_data\x18\x02 \x01(\fR\acsvData\x12%\n" +
+	"\x0eduplicate_mode\x18\x03 \x01(\tR\rduplicateMode\x12\x18\n" +
+	"\apreview\x18\x04 \x01(\bR\apreview\x12\x16\n" +
+	"\x06atomic\x18\x05 \x01(\bR\x06atomic\x12\x18\n" +
+	"\aprofile\x18\x06 \x01(\tR\aprofile\x12\x16\n" +
+	"\x06format\x18\a \x01(\tR\x06format\"\xcf\x01\n" +
+	"\x13ImportStreamRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\x12%\n" +
+	"\x0eduplicate_mode\x18\x03 \x01(\tR\rduplicateMode\x12\x18\n" +
+	"\apreview\x18\x04 \x01(\bR\apreview\x12\x16\n" +
+	"\x06atomic\x18\x05 \x01(\bR\x06atomic\x12\x18\n" +
+	"\aprofile\x18\x06 \x01(\tR\aprofile\x12\x16\n" +
+	"\x06format\x18\a \x01(\tR\x06format\"\xe5\x02\n" +
 	"\x11ImportCSVResponse\x12%\n" +
 	"\x0eimported_count\x18\x01 \x01(\x05R\rimportedCount\x12#\n" +
 	"\rskipped_count\x18\x02 \x01(\x05R\fskippedCount\x12\x16\n" +
-	"\x06errors\x18\x03 \x03(\tR\x06errors\"\x87\x01\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\x12'\n" +
+	"\x0fduplicate_count\x18\x04 \x01(\x05R\x0eduplicateCount\x12:\n" +
+	"\n" +
+	"duplicates\x18\x05 \x03(\v2\x1a.ledger.v1.ImportDuplicateR\n" +
+	"duplicates\x12(\n" +
+	"\x04rows\x18\x06 \x03(\v2\x14.ledger.v1.ImportRowR\x04rows\x12<\n" +
+	"\rbudget_impact\x18\a \x03(\v2\x17.ledger.v1.BudgetImpactR\fbudgetImpact\x12\x1f\n" +
+	"\vrolled_back\x18\b \x01(\bR\n" +
+	"rolledBack\"\xcf\x01\n" +
+	"\x0fImportDuplicate\x128\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x16.ledger.v1.TransactionR\vtransaction\x126\n" +
+	"\x17existing_transaction_id\x18\x02 \x01(\x03R\x15existingTransactionId\x12\x1a\n" +
+	"\bprobable\x18\x03 \x01(\bR\bprobable\x12\x1a\n" +
+	"\bimported\x18\x04 \x01(\bR\bimported\x12\x12\n" +
+	"\x04line\x18\x05 \x01(\x05R\x04line\"\xd9\x01\n" +
+	"\tImportRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x128\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x16.ledger.v1.TransactionR\vtransaction\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x18\n" +
+	"\awarning\x18\x05 \x01(\tR\awarning\x126\n" +
+	"\x17existing_transaction_id\x18\x06 \x01(\x03R\x15existingTransactionId\"\xb7\x03\n" +
+	"\fBudgetImpact\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12 \n" +
+	"\venforcement\x18\x02 \x01(\tR\venforcement\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12;\n" +
+	"\vperiod_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"periodFrom\x127\n" +
+	"\tperiod_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bperiodTo\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x01R\x05limit\x12\x14\n" +
+	"\x05spent\x18\a \x01(\x01R\x05spent\x12\x1a\n" +
+	"\bincoming\x18\b \x01(\x01R\bincoming\x12\x1a\n" +
+	"\bexceeded\x18\t \x01(\bR\bexceeded\x12#\n" +
+	"\rlimit_decimal\x18\n" +
+	" \x01(\tR\flimitDecimal\x12#\n" +
+	"\rspent_decimal\x18\v \x01(\tR\fspentDecimal\x12)\n" +
+	"\x10incoming_decimal\x18\f \x01(\tR\x0fincomingDecimal\"\xaf\x03\n" +
+	"\rImportProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tdelimiter\x18\x04 \x01(\tR\tdelimiter\x12#\n" +
+	"\rdecimal_comma\x18\x05 \x01(\bR\fdecimalComma\x12!\n" +
+	"\fdate_layouts\x18\x06 \x03(\tR\vdateLayouts\x12\x1a\n" +
+	"\bencoding\x18\a \x01(\tR\bencoding\x12'\n" +
+	"\x0fsign_convention\x18\b \x01(\tR\x0esignConvention\x12?\n" +
+	"\acolumns\x18\t \x03(\v2%.ledger.v1.ImportProfile.ColumnsEntryR\acolumns\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a:\n" +
+	"\fColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfe\x02\n" +
+	"\x1aCreateImportProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tdelimiter\x18\x03 \x01(\tR\tdelimiter\x12#\n" +
+	"\rdecimal_comma\x18\x04 \x01(\bR\fdecimalComma\x12!\n" +
+	"\fdate_layouts\x18\x05 \x03(\tR\vdateLayouts\x12\x1a\n" +
+	"\bencoding\x18\x06 \x01(\tR\bencoding\x12'\n" +
+	"\x0fsign_convention\x18\a \x01(\tR\x0esignConvention\x12L\n" +
+	"\acolumns\x18\b \x03(\v22.ledger.v1.CreateImportProfileRequest.ColumnsEntryR\acolumns\x1a:\n" +
+	"\fColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Q\n" +
+	"\x1bCreateImportProfileResponse\x122\n" +
+	"\aprofile\x18\x01 \x01(\v2\x18.ledger.v1.ImportProfileR\aprofile\"3\n" +
+	"\x18GetImportProfilesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"Q\n" +
+	"\x19GetImportProfilesResponse\x124\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x18.ledger.v1.ImportProfileR\bprofiles\"\x8e\x03\n" +
+	"\x1aUpdateImportProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tdelimiter\x18\x04 \x01(\tR\tdelimiter\x12#\n" +
+	"\rdecimal_comma\x18\x05 \x01(\bR\fdecimalComma\x12!\n" +
+	"\fdate_layouts\x18\x06 \x03(\tR\vdateLayouts\x12\x1a\n" +
+	"\bencoding\x18\a \x01(\tR\bencoding\x12'\n" +
+	"\x0fsign_convention\x18\b \x01(\tR\x0esignConvention\x12L\n" +
+	"\acolumns\x18\t \x03(\v22.ledger.v1.UpdateImportProfileRequest.ColumnsEntryR\acolumns\x1a:\n" +
+	"\fColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Q\n" +
+	"\x1bUpdateImportProfileResponse\x122\n" +
+	"\aprofile\x18\x01 \x01(\v2\x18.ledger.v1.ImportProfileR\aprofile\"E\n" +
+	"\x1aDeleteImportProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x1d\n" +
+	"\x1bDeleteImportProfileResponse\"\x87\x01\n" +
 	"\x10ExportCSVRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
 	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
@@ -1112,16 +9462,128 @@ const file_ledger_proto_rawDesc = "" +
 	"\x11ExportCSVResponse\x12\x19\n" +
 	"\bcsv_data\x18\x01 \x01(\fR\acsvData\x12\x1d\n" +
 	"\n" +
-	"rows_count\x18\x02 \x01(\x05R\trowsCount2\xab\x04\n" +
+	"rows_count\x18\x02 \x01(\x05R\trowsCount\"\xc2\x01\n" +
+	"\x19ExportTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12\x18\n" +
+	"\acolumns\x18\x05 \x03(\tR\acolumns\"\x8e\x01\n" +
+	"\x1aExportTransactionsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"rows_count\x18\x04 \x01(\x05R\trowsCount\"k\n" +
+	"\x14ExportStreamResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"\xfa\x04\n" +
+	"\tImportJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12\x18\n" +
+	"\aprofile\x18\x05 \x01(\tR\aprofile\x12%\n" +
+	"\x0eduplicate_mode\x18\x06 \x01(\tR\rduplicateMode\x12\x16\n" +
+	"\x06atomic\x18\a \x01(\bR\x06atomic\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\b \x01(\x05R\ttotalRows\x12%\n" +
+	"\x0eprocessed_rows\x18\t \x01(\x05R\rprocessedRows\x12%\n" +
+	"\x0eimported_count\x18\n" +
+	" \x01(\x05R\rimportedCount\x12'\n" +
+	"\x0fduplicate_count\x18\v \x01(\x05R\x0eduplicateCount\x12!\n" +
+	"\ffailed_count\x18\f \x01(\x05R\vfailedCount\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\":\n" +
+	"\x0eImportJobError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xb3\x01\n" +
+	"\x13SubmitImportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12%\n" +
+	"\x0eduplicate_mode\x18\x03 \x01(\tR\rduplicateMode\x12\x16\n" +
+	"\x06atomic\x18\x04 \x01(\bR\x06atomic\x12\x18\n" +
+	"\aprofile\x18\x05 \x01(\tR\aprofile\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\">\n" +
+	"\x14SubmitImportResponse\x12&\n" +
+	"\x03job\x18\x01 \x01(\v2\x14.ledger.v1.ImportJobR\x03job\";\n" +
+	"\x10GetImportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"n\n" +
+	"\x11GetImportResponse\x12&\n" +
+	"\x03job\x18\x01 \x01(\v2\x14.ledger.v1.ImportJobR\x03job\x121\n" +
+	"\x06errors\x18\x02 \x03(\v2\x19.ledger.v1.ImportJobErrorR\x06errors\">\n" +
+	"\x13CancelImportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\">\n" +
+	"\x14CancelImportResponse\x12&\n" +
+	"\x03job\x18\x01 \x01(\v2\x14.ledger.v1.ImportJobR\x03job\"=\n" +
+	"\x12WatchImportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"=\n" +
+	"\x13WatchImportResponse\x12&\n" +
+	"\x03job\x18\x01 \x01(\v2\x14.ledger.v1.ImportJobR\x03job2\xaf#\n" +
 	"\rLedgerService\x12U\n" +
 	"\x0eAddTransaction\x12 .ledger.v1.AddTransactionRequest\x1a!.ledger.v1.AddTransactionResponse\x12X\n" +
-	"\x0fGetTransactions\x12!.ledger.v1.GetTransactionsRequest\x1a\".ledger.v1.GetTransactionsResponse\x12F\n" +
+	"\x0fGetTransactions\x12!.ledger.v1.GetTransactionsRequest\x1a\".ledger.v1.GetTransactionsResponse\x12^\n" +
+	"\x11UpdateTransaction\x12#.ledger.v1.UpdateTransactionRequest\x1a$.ledger.v1.UpdateTransactionResponse\x12^\n" +
+	"\x11DeleteTransaction\x12#.ledger.v1.DeleteTransactionRequest\x1a$.ledger.v1.DeleteTransactionResponse\x12F\n" +
 	"\tSetBudget\x12\x1b.ledger.v1.SetBudgetRequest\x1a\x1c.ledger.v1.SetBudgetResponse\x12I\n" +
 	"\n" +
-	"GetBudgets\x12\x1c.ledger.v1.GetBudgetsRequest\x1a\x1d.ledger.v1.GetBudgetsResponse\x12F\n" +
-	"\tGetReport\x12\x1b.ledger.v1.GetReportRequest\x1a\x1c.ledger.v1.GetReportResponse\x12F\n" +
-	"\tImportCSV\x12\x1b.ledger.v1.ImportCSVRequest\x1a\x1c.ledger.v1.ImportCSVResponse\x12F\n" +
-	"\tExportCSV\x12\x1b.ledger.v1.ExportCSVRequest\x1a\x1c.ledger.v1.ExportCSVResponseB8Z6github.com/mikhailmogilnikov/go/final/pkg/pb/ledger/v1b\x06proto3"
+	"GetBudgets\x12\x1c.ledger.v1.GetBudgetsRequest\x1a\x1d.ledger.v1.GetBudgetsResponse\x12[\n" +
+	"\x10GetBudgetHistory\x12\".ledger.v1.GetBudgetHistoryRequest\x1a#.ledger.v1.GetBudgetHistoryResponse\x12O\n" +
+	"\fDeleteBudget\x12\x1e.ledger.v1.DeleteBudgetRequest\x1a\x1f.ledger.v1.DeleteBudgetResponse\x12X\n" +
+	"\x0fGetBudgetStatus\x12!.ledger.v1.GetBudgetStatusRequest\x1a\".ledger.v1.GetBudgetStatusResponse\x12F\n" +
+	"\tGetReport\x12\x1b.ledger.v1.GetReportRequest\x1a\x1c.ledger.v1.GetReportResponse\x12O\n" +
+	"\fGetTagReport\x12\x1e.ledger.v1.GetTagReportRequest\x1a\x1f.ledger.v1.GetTagReportResponse\x12U\n" +
+	"\x0eCompareReports\x12 .ledger.v1.CompareReportsRequest\x1a!.ledger.v1.CompareReportsResponse\x12F\n" +
+	"\tGetTrends\x12\x1b.ledger.v1.GetTrendsRequest\x1a\x1c.ledger.v1.GetTrendsResponse\x12L\n" +
+	"\vGetSettings\x12\x1d.ledger.v1.GetSettingsRequest\x1a\x1e.ledger.v1.GetSettingsResponse\x12X\n" +
+	"\x0fSetBaseCurrency\x12!.ledger.v1.SetBaseCurrencyRequest\x1a\".ledger.v1.SetBaseCurrencyResponse\x12[\n" +
+	"\x10SetExchangeRates\x12\".ledger.v1.SetExchangeRatesRequest\x1a#.ledger.v1.SetExchangeRatesResponse\x12d\n" +
+	"\x13ImportExchangeRates\x12%.ledger.v1.ImportExchangeRatesRequest\x1a&.ledger.v1.ImportExchangeRatesResponse\x12[\n" +
+	"\x10GetExchangeRates\x12\".ledger.v1.GetExchangeRatesRequest\x1a#.ledger.v1.GetExchangeRatesResponse\x12d\n" +
+	"\x13CreateRecurringRule\x12%.ledger.v1.CreateRecurringRuleRequest\x1a&.ledger.v1.CreateRecurringRuleResponse\x12^\n" +
+	"\x11GetRecurringRules\x12#.ledger.v1.GetRecurringRulesRequest\x1a$.ledger.v1.GetRecurringRulesResponse\x12d\n" +
+	"\x13UpdateRecurringRule\x12%.ledger.v1.UpdateRecurringRuleRequest\x1a&.ledger.v1.UpdateRecurringRuleResponse\x12d\n" +
+	"\x13DeleteRecurringRule\x12%.ledger.v1.DeleteRecurringRuleRequest\x1a&.ledger.v1.DeleteRecurringRuleResponse\x12U\n" +
+	"\x0eCreateCategory\x12 .ledger.v1.CreateCategoryRequest\x1a!.ledger.v1.CreateCategoryResponse\x12R\n" +
+	"\rGetCategories\x12\x1f.ledger.v1.GetCategoriesRequest\x1a .ledger.v1.GetCategoriesResponse\x12U\n" +
+	"\x0eUpdateCategory\x12 .ledger.v1.UpdateCategoryRequest\x1a!.ledger.v1.UpdateCategoryResponse\x12U\n" +
+	"\x0eDeleteCategory\x12 .ledger.v1.DeleteCategoryRequest\x1a!.ledger.v1.DeleteCategoryResponse\x12X\n" +
+	"\x0fMergeCategories\x12!.ledger.v1.MergeCategoriesRequest\x1a\".ledger.v1.MergeCategoriesResponse\x12R\n" +
+	"\rCreateAccount\x12\x1f.ledger.v1.CreateAccountRequest\x1a .ledger.v1.CreateAccountResponse\x12L\n" +
+	"\vGetAccounts\x12\x1d.ledger.v1.GetAccountsRequest\x1a\x1e.ledger.v1.GetAccountsResponse\x12R\n" +
+	"\rUpdateAccount\x12\x1f.ledger.v1.UpdateAccountRequest\x1a .ledger.v1.UpdateAccountResponse\x12R\n" +
+	"\rDeleteAccount\x12\x1f.ledger.v1.DeleteAccountRequest\x1a .ledger.v1.DeleteAccountResponse\x12U\n" +
+	"\x0eCreateTransfer\x12 .ledger.v1.CreateTransferRequest\x1a!.ledger.v1.CreateTransferResponse\x12a\n" +
+	"\x12GetAccountBalances\x12$.ledger.v1.GetAccountBalancesRequest\x1a%.ledger.v1.GetAccountBalancesResponse\x12a\n" +
+	"\x12CreateCategoryRule\x12$.ledger.v1.CreateCategoryRuleRequest\x1a%.ledger.v1.CreateCategoryRuleResponse\x12[\n" +
+	"\x10GetCategoryRules\x12\".ledger.v1.GetCategoryRulesRequest\x1a#.ledger.v1.GetCategoryRulesResponse\x12a\n" +
+	"\x12UpdateCategoryRule\x12$.ledger.v1.UpdateCategoryRuleRequest\x1a%.ledger.v1.UpdateCategoryRuleResponse\x12a\n" +
+	"\x12DeleteCategoryRule\x12$.ledger.v1.DeleteCategoryRuleRequest\x1a%.ledger.v1.DeleteCategoryRuleResponse\x12[\n" +
+	"\x10TestCategoryRule\x12\".ledger.v1.TestCategoryRuleRequest\x1a#.ledger.v1.TestCategoryRuleResponse\x12F\n" +
+	"\tImportCSV\x12\x1b.ledger.v1.ImportCSVRequest\x1a\x1c.ledger.v1.ImportCSVResponse\x12d\n" +
+	"\x13CreateImportProfile\x12%.ledger.v1.CreateImportProfileRequest\x1a&.ledger.v1.CreateImportProfileResponse\x12^\n" +
+	"\x11GetImportProfiles\x12#.ledger.v1.GetImportProfilesRequest\x1a$.ledger.v1.GetImportProfilesResponse\x12d\n" +
+	"\x13UpdateImportProfile\x12%.ledger.v1.UpdateImportProfileRequest\x1a&.ledger.v1.UpdateImportProfileResponse\x12d\n" +
+	"\x13DeleteImportProfile\x12%.ledger.v1.DeleteImportProfileRequest\x1a&.ledger.v1.DeleteImportProfileResponse\x12F\n" +
+	"\tExportCSV\x12\x1b.ledger.v1.ExportCSVRequest\x1a\x1c.ledger.v1.ExportCSVResponse\x12a\n" +
+	"\x12ExportTransactions\x12$.ledger.v1.ExportTransactionsRequest\x1a%.ledger.v1.ExportTransactionsResponse\x12N\n" +
+	"\fImportStream\x12\x1e.ledger.v1.ImportStreamRequest\x1a\x1c.ledger.v1.ImportCSVResponse(\x01\x12W\n" +
+	"\fExportStream\x12$.ledger.v1.ExportTransactionsRequest\x1a\x1f.ledger.v1.ExportStreamResponse0\x01\x12O\n" +
+	"\fSubmitImport\x12\x1e.ledger.v1.SubmitImportRequest\x1a\x1f.ledger.v1.SubmitImportResponse\x12F\n" +
+	"\tGetImport\x12\x1b.ledger.v1.GetImportRequest\x1a\x1c.ledger.v1.GetImportResponse\x12O\n" +
+	"\fCancelImport\x12\x1e.ledger.v1.CancelImportRequest\x1a\x1f.ledger.v1.CancelImportResponse\x12N\n" +
+	"\vWatchImport\x12\x1d.ledger.v1.WatchImportRequest\x1a\x1e.ledger.v1.WatchImportResponse0\x01B8Z6github.com/mikhailmogilnikov/go/final/pkg/pb/ledger/v1b\x06proto3"
 
 var (
 	file_ledger_proto_rawDescOnce sync.Once
@@ -1135,63 +9597,366 @@ func file_ledger_proto_rawDescGZIP() []byte {
 	return file_ledger_proto_rawDescData
 }
 
-var file_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 127)
 var file_ledger_proto_goTypes = []any{
-	(*Transaction)(nil),             
-	(*AddTransactionRequest)(nil),   
-	(*AddTransactionResponse)(nil),  
-	(*GetTransactionsRequest)(nil),  
-	(*GetTransactionsResponse)(nil), 
-	(*Budget)(nil),                  
-	(*SetBudgetRequest)(nil),        
-	(*SetBudgetResponse)(nil),       
-	(*GetBudgetsRequest)(nil),       
-	(*GetBudgetsResponse)(nil),      
-	(*CategorySummary)(nil),         
-	(*GetReportRequest)(nil),        
-	(*GetReportResponse)(nil),       
-	(*ImportCSVRequest)(nil),        
-	(*ImportCSVResponse)(nil),       
-	(*ExportCSVRequest)(nil),        
-	(*ExportCSVResponse)(nil),       
-	(*timestamppb.Timestamp)(nil),   
+	(*Transaction)(nil),                 
+	(*TransactionSplit)(nil),            
+	(*AddTransactionRequest)(nil),       
+	(*AddTransactionResponse)(nil),      
+	(*GetTransactionsRequest)(nil),      
+	(*GetTransactionsResponse)(nil),     
+	(*UpdateTransactionRequest)(nil),    
+	(*UpdateTransactionResponse)(nil),   
+	(*DeleteTransactionRequest)(nil),    
+	(*DeleteTransactionResponse)(nil),   
+	(*Budget)(nil),                      
+	(*SetBudgetRequest)(nil),            
+	(*SetBudgetResponse)(nil),           
+	(*GetBudgetsRequest)(nil),           
+	(*GetBudgetsResponse)(nil),          
+	(*GetBudgetHistoryRequest)(nil),     
+	(*GetBudgetHistoryResponse)(nil),    
+	(*DeleteBudgetRequest)(nil),         
+	(*DeleteBudgetResponse)(nil),        
+	(*BudgetStatus)(nil),                
+	(*GetBudgetStatusRequest)(nil),      
+	(*GetBudgetStatusResponse)(nil),     
+	(*CurrencyTotal)(nil),               
+	(*CategorySummary)(nil),             
+	(*GetReportRequest)(nil),            
+	(*GetReportResponse)(nil),           
+	(*TagSummary)(nil),                  
+	(*GetTagReportRequest)(nil),         
+	(*GetTagReportResponse)(nil),        
+	(*PeriodChange)(nil),                
+	(*CategoryComparison)(nil),          
+	(*CompareReportsRequest)(nil),       
+	(*CompareReportsResponse)(nil),      
+	(*TrendBucket)(nil),                 
+	(*TrendSeries)(nil),                 
+	(*GetTrendsRequest)(nil),            
+	(*GetTrendsResponse)(nil),           
+	(*ExchangeRate)(nil),                
+	(*GetSettingsRequest)(nil),          
+	(*GetSettingsResponse)(nil),         
+	(*SetBaseCurrencyRequest)(nil),      
+	(*SetBaseCurrencyResponse)(nil),     
+	(*SetExchangeRatesRequest)(nil),     
+	(*SetExchangeRatesResponse)(nil),    
+	(*ImportExchangeRatesRequest)(nil),  
+	(*ImportExchangeRatesResponse)(nil), 
+	(*GetExchangeRatesRequest)(nil),     
+	(*GetExchangeRatesResponse)(nil),    
+	(*RecurringRule)(nil),               
+	(*CreateRecurringRuleRequest)(nil),  
+	(*CreateRecurringRuleResponse)(nil), 
+	(*GetRecurringRulesRequest)(nil),    
+	(*GetRecurringRulesResponse)(nil),   
+	(*UpdateRecurringRuleRequest)(nil),  
+	(*UpdateRecurringRuleResponse)(nil), 
+	(*DeleteRecurringRuleRequest)(nil),  
+	(*DeleteRecurringRuleResponse)(nil), 
+	(*Category)(nil),                    
+	(*CreateCategoryRequest)(nil),       
+	(*CreateCategoryResponse)(nil),      
+	(*GetCategoriesRequest)(nil),        
+	(*GetCategoriesResponse)(nil),       
+	(*UpdateCategoryRequest)(nil),       
+	(*UpdateCategoryResponse)(nil),      
+	(*DeleteCategoryRequest)(nil),       
+	(*DeleteCategoryResponse)(nil),      
+	(*MergeCategoriesRequest)(nil),      
+	(*MergeCategoriesResponse)(nil),     
+	(*Account)(nil),                     
+	(*CreateAccountRequest)(nil),        
+	(*CreateAccountResponse)(nil),       
+	(*GetAccountsRequest)(nil),          
+	(*GetAccountsResponse)(nil),         
+	(*UpdateAccountRequest)(nil),        
+	(*UpdateAccountResponse)(nil),       
+	(*DeleteAccountRequest)(nil),        
+	(*DeleteAccountResponse)(nil),       
+	(*Transfer)(nil),                    
+	(*CreateTransferRequest)(nil),       
+	(*CreateTransferResponse)(nil),      
+	(*GetAccountBalancesRequest)(nil),   
+	(*BalancePoint)(nil),                
+	(*GetAccountBalancesResponse)(nil),  
+	(*CategoryRule)(nil),                
+	(*CreateCategoryRuleRequest)(nil),   
+	(*CreateCategoryRuleResponse)(nil),  
+	(*GetCategoryRulesRequest)(nil),     
+	(*GetCategoryRulesResponse)(nil),    
+	(*UpdateCategoryRuleRequest)(nil),   
+	(*UpdateCategoryRuleResponse)(nil),  
+	(*DeleteCategoryRuleRequest)(nil),   
+	(*DeleteCategoryRuleResponse)(nil),  
+	(*TestCategoryRuleRequest)(nil),     
+	(*TestCategoryRuleResponse)(nil),    
+	(*ImportCSVRequest)(nil),            
+	(*ImportStreamRequest)(nil),         
+	(*ImportCSVResponse)(nil),           
+	(*ImportDuplicate)(nil),             
+	(*ImportRow)(nil),                   
+	(*BudgetImpact)(nil),                
+	(*ImportProfile)(nil),               
+	(*CreateImportProfileRequest)(nil),  
+	(*CreateImportProfileResponse)(nil), 
+	(*GetImportProfilesRequest)(nil),    
+	(*GetImportProfilesResponse)(nil),   
+	(*UpdateImportProfileRequest)(nil),  
+	(*UpdateImportProfileResponse)(nil), 
+	(*DeleteImportProfileRequest)(nil),  
+	(*DeleteImportProfileResponse)(nil), 
+	(*ExportCSVRequest)(nil),            
+	(*ExportCSVResponse)(nil),           
+	(*ExportTransactionsRequest)(nil),   
+	(*ExportTransactionsResponse)(nil),  
+	(*ExportStreamResponse)(nil),        
+	(*ImportJob)(nil),                   
+	(*ImportJobError)(nil),              
+	(*SubmitImportRequest)(nil),         
+	(*SubmitImportResponse)(nil),        
+	(*GetImportRequest)(nil),            
+	(*GetImportResponse)(nil),           
+	(*CancelImportRequest)(nil),         
+	(*CancelImportResponse)(nil),        
+	(*WatchImportRequest)(nil),          
+	(*WatchImportResponse)(nil),         
+	nil,                                 
+	nil,                                 
+	nil,                                 
+	(*timestamppb.Timestamp)(nil),       
 }
 var file_ledger_proto_depIdxs = []int32{
-	17, 
-	17, 
-	17, 
-	0,  
-	17, 
-	17, 
-	0,  
-	5,  
-	5,  
-	17, 
-	17, 
-	10, 
-	17, 
-	17, 
-	17, 
-	17, 
-	1,  
-	3,  
-	6,  
-	8,  
-	11, 
-	13, 
-	15, 
-	2,  
-	4,  
-	7,  
-	9,  
-	12, 
-	14, 
-	16, 
-	23, 
-	16, 
-	16, 
-	16, 
-	0,  
+	127, 
+	127, 
+	1,   
+	127, 
+	1,   
+	0,   
+	127, 
+	127, 
+	0,   
+	127, 
+	1,   
+	0,   
+	127, 
+	127, 
+	10,  
+	10,  
+	10,  
+	10,  
+	127, 
+	127, 
+	19,  
+	22,  
+	127, 
+	127, 
+	23,  
+	127, 
+	127, 
+	22,  
+	127, 
+	127, 
+	26,  
+	127, 
+	127, 
+	29,  
+	127, 
+	127, 
+	30,  
+	127, 
+	127, 
+	127, 
+	127, 
+	29,  
+	29,  
+	29,  
+	127, 
+	33,  
+	127, 
+	127, 
+	33,  
+	34,  
+	127, 
+	127, 
+	127, 
+	37,  
+	127, 
+	127, 
+	37,  
+	127, 
+	127, 
+	127, 
+	127, 
+	127, 
+	127, 
+	48,  
+	48,  
+	127, 
+	127, 
+	48,  
+	127, 
+	57,  
+	57,  
+	57,  
+	57,  
+	127, 
+	68,  
+	68,  
+	68,  
+	127, 
+	127, 
+	127, 
+	77,  
+	0,   
+	127, 
+	127, 
+	127, 
+	68,  
+	81,  
+	127, 
+	83,  
+	83,  
+	83,  
+	127, 
+	127, 
+	0,   
+	97,  
+	98,  
+	99,  
+	0,   
+	0,   
+	127, 
+	127, 
+	124, 
+	127, 
+	125, 
+	100, 
+	100, 
+	126, 
+	100, 
+	127, 
+	127, 
+	127, 
+	127, 
+	127, 
+	127, 
+	127, 
+	127, 
+	114, 
+	114, 
+	115, 
+	114, 
+	114, 
+	2,   
+	4,   
+	6,   
+	8,   
+	11,  
+	13,  
+	15,  
+	17,  
+	20,  
+	24,  
+	27,  
+	31,  
+	35,  
+	38,  
+	40,  
+	42,  
+	44,  
+	46,  
+	49,  
+	51,  
+	53,  
+	55,  
+	58,  
+	60,  
+	62,  
+	64,  
+	66,  
+	69,  
+	71,  
+	73,  
+	75,  
+	78,  
+	80,  
+	84,  
+	86,  
+	88,  
+	90,  
+	92,  
+	94,  
+	101, 
+	103, 
+	105, 
+	107, 
+	109, 
+	111, 
+	95,  
+	111, 
+	116, 
+	118, 
+	120, 
+	122, 
+	3,   
+	5,   
+	7,   
+	9,   
+	12,  
+	14,  
+	16,  
+	18,  
+	21,  
+	25,  
+	28,  
+	32,  
+	36,  
+	39,  
+	41,  
+	43,  
+	45,  
+	47,  
+	50,  
+	52,  
+	54,  
+	56,  
+	59,  
+	61,  
+	63,  
+	65,  
+	67,  
+	70,  
+	72,  
+	74,  
+	76,  
+	79,  
+	82,  
+	85,  
+	87,  
+	89,  
+	91,  
+	93,  
+	96,  
+	102, 
+	104, 
+	106, 
+	108, 
+	110, 
+	112, 
+	96,  
+	113, 
+	117, 
+	119, 
+	121, 
+	123, 
+	172, 
+	121, 
+	121, 
+	121, 
+	0,   
 }
 
 func init() { file_ledger_proto_init() }
@@ -1205,7 +9970,7 @@ func file_ledger_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ledger_proto_rawDesc), len(file_ledger_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   127,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
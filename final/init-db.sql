@@ -17,25 +17,217 @@ CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
 CREATE TABLE IF NOT EXISTS transactions (
     id SERIAL PRIMARY KEY,
     user_id BIGINT NOT NULL,
+    kind TEXT NOT NULL DEFAULT 'expense' CHECK (kind IN ('expense', 'income', 'transfer')),
     amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    currency TEXT NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$'),
     category TEXT NOT NULL,
     description TEXT,
     date DATE NOT NULL,
+    over_budget BOOLEAN NOT NULL DEFAULT FALSE,
     created_at TIMESTAMP DEFAULT NOW()
 );
 CREATE INDEX IF NOT EXISTS idx_transactions_user_id ON transactions(user_id);
 CREATE INDEX IF NOT EXISTS idx_transactions_user_category ON transactions(user_id, category);
 CREATE INDEX IF NOT EXISTS idx_transactions_user_date ON transactions(user_id, date);
+CREATE INDEX IF NOT EXISTS idx_transactions_user_date_id ON transactions(user_id, date, id);
+CREATE INDEX IF NOT EXISTS idx_transactions_user_kind_date ON transactions(user_id, kind, date);
 
 CREATE TABLE IF NOT EXISTS budgets (
     id SERIAL PRIMARY KEY,
     user_id BIGINT NOT NULL,
     category TEXT NOT NULL,
     limit_amount NUMERIC(14,2) NOT NULL CHECK (limit_amount > 0),
-    period TEXT NOT NULL DEFAULT 'monthly',
-    UNIQUE(user_id, category)
+    currency TEXT NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$'),
+    period TEXT NOT NULL DEFAULT 'monthly' CHECK (period IN ('daily', 'weekly', 'monthly', 'quarterly', 'yearly')),
+    start_day INT NOT NULL DEFAULT 1 CHECK (start_day BETWEEN 1 AND 31),
+    rollover BOOLEAN NOT NULL DEFAULT FALSE,
+    rollover_from DATE,
+    enforcement TEXT NOT NULL DEFAULT 'hard' CHECK (enforcement IN ('hard', 'soft', 'warn')),
+    warning_thresholds INT[] NOT NULL DEFAULT '{80}',
+    valid_from DATE NOT NULL,
+    valid_to DATE CHECK (valid_to IS NULL OR valid_to > valid_from),
+    UNIQUE(user_id, category, valid_from)
 );
 CREATE INDEX IF NOT EXISTS idx_budgets_user_id ON budgets(user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_budgets_current ON budgets(user_id, category) WHERE valid_to IS NULL;
+
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id BIGINT PRIMARY KEY,
+    base_currency TEXT NOT NULL DEFAULT 'RUB' CHECK (base_currency ~ '^[A-Z]{3}$'),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    user_id BIGINT NOT NULL,
+    base_currency TEXT NOT NULL CHECK (base_currency ~ '^[A-Z]{3}$'),
+    quote_currency TEXT NOT NULL CHECK (quote_currency ~ '^[A-Z]{3}$'),
+    date DATE NOT NULL,
+    rate NUMERIC(20,8) NOT NULL CHECK (rate > 0),
+    PRIMARY KEY (user_id, base_currency, quote_currency, date)
+);
+
+CREATE TABLE IF NOT EXISTS recurring_rules (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    kind TEXT NOT NULL DEFAULT 'expense' CHECK (kind IN ('expense', 'income', 'transfer')),
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    currency TEXT NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    category TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+    interval_count INT NOT NULL DEFAULT 1 CHECK (interval_count > 0),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    next_date DATE,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_recurring_rules_user_id ON recurring_rules(user_id);
+CREATE INDEX IF NOT EXISTS idx_recurring_rules_next_date ON recurring_rules(next_date) WHERE next_date IS NOT NULL;
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS recurring_rule_id INT REFERENCES recurring_rules(id) ON DELETE SET NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_recurring_occurrence
+    ON transactions(recurring_rule_id, date) WHERE recurring_rule_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS categories (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (name <> ''),
+    parent_id INT REFERENCES categories(id) ON DELETE SET NULL,
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (parent_id IS NULL OR parent_id <> id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_user_name ON categories(user_id, lower(name));
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories(parent_id);
+
 
 
 
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (name <> '' AND name = lower(name)),
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS transaction_tags (
+    transaction_id INT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    tag_id INT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (transaction_id, tag_id)
+);
+CREATE INDEX IF NOT EXISTS idx_transaction_tags_tag_id ON transaction_tags(tag_id);
+
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id SERIAL PRIMARY KEY,
+    transaction_id INT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    category TEXT NOT NULL,
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    note TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_transaction_id ON transaction_splits(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_category ON transaction_splits(category);
+
+CREATE TABLE IF NOT EXISTS accounts (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (name <> ''),
+    type TEXT NOT NULL DEFAULT 'cash' CHECK (type IN ('cash', 'debit', 'credit', 'savings')),
+    currency TEXT NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    opening_balance NUMERIC(14,2) NOT NULL DEFAULT 0,
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_user_name ON accounts(user_id, lower(name));
+
+CREATE TABLE IF NOT EXISTS transfers (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    from_account_id INT NOT NULL REFERENCES accounts(id),
+    to_account_id INT NOT NULL REFERENCES accounts(id),
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    to_amount NUMERIC(14,2) NOT NULL CHECK (to_amount > 0),
+    description TEXT NOT NULL DEFAULT '',
+    date DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (from_account_id <> to_account_id)
+);
+CREATE INDEX IF NOT EXISTS idx_transfers_user_id ON transfers(user_id);
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS account_id INT REFERENCES accounts(id),
+    ADD COLUMN IF NOT EXISTS transfer_id INT REFERENCES transfers(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_transactions_account_date ON transactions(account_id, date) WHERE account_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_transfer_id ON transactions(transfer_id) WHERE transfer_id IS NOT NULL;
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS search tsvector GENERATED ALWAYS AS (
+        to_tsvector('russian', coalesce(description, '') || ' ' || category) ||
+        to_tsvector('english', coalesce(description, '') || ' ' || category)
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_transactions_search ON transactions USING GIN (search);
+CREATE INDEX IF NOT EXISTS idx_transactions_user_amount ON transactions(user_id, amount);
+
+CREATE TABLE IF NOT EXISTS category_rules (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    match_type TEXT NOT NULL DEFAULT 'contains' CHECK (match_type IN ('contains', 'regex')),
+    pattern TEXT NOT NULL DEFAULT '',
+    min_amount NUMERIC(14,2),
+    max_amount NUMERIC(14,2),
+    category TEXT NOT NULL CHECK (category <> ''),
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    priority INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_category_rules_user_priority ON category_rules(user_id, priority, id);
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fingerprint TEXT;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_fingerprint
+    ON transactions(user_id, fingerprint) WHERE fingerprint IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS import_profiles (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (name <> ''),
+    delimiter TEXT NOT NULL DEFAULT ',',
+    decimal_comma BOOLEAN NOT NULL DEFAULT FALSE,
+    date_layouts TEXT[] NOT NULL DEFAULT '{2006-01-02}',
+    encoding TEXT NOT NULL DEFAULT 'utf-8' CHECK (encoding IN ('utf-8', 'windows-1251', 'koi8-r')),
+    sign_convention TEXT NOT NULL DEFAULT 'positive' CHECK (sign_convention IN ('positive', 'negative_expense', 'negative_income')),
+    columns JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_import_profiles_user_name ON import_profiles(user_id, lower(name));
+
+CREATE TABLE IF NOT EXISTS import_jobs (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'queued' CHECK (status IN ('queued', 'running', 'completed', 'failed', 'canceled')),
+    format TEXT NOT NULL DEFAULT 'csv',
+    profile TEXT NOT NULL DEFAULT '',
+    duplicate_mode TEXT NOT NULL DEFAULT 'skip',
+    atomic BOOLEAN NOT NULL DEFAULT FALSE,
+    data BYTEA,
+    total_rows INTEGER NOT NULL DEFAULT 0,
+    processed_rows INTEGER NOT NULL DEFAULT 0,
+    imported_rows INTEGER NOT NULL DEFAULT 0,
+    duplicate_rows INTEGER NOT NULL DEFAULT 0,
+    failed_rows INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_import_jobs_queued ON import_jobs(id) WHERE status = 'queued';
+CREATE INDEX IF NOT EXISTS idx_import_jobs_running ON import_jobs(updated_at) WHERE status = 'running';
+
+CREATE TABLE IF NOT EXISTS import_job_errors (
+    job_id INTEGER NOT NULL REFERENCES import_jobs(id) ON DELETE CASCADE,
+    line INTEGER NOT NULL,
+    message TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_import_job_errors_job ON import_job_errors(job_id, line);
@@ -18,12 +18,16 @@ import (
 	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
 	"github.com/mikhailmogilnikov/go/final/ledger/internal/repository/pg"
 	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/worker"
 )
 
 func main() {
 	_ = godotenv.Load()
 
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -64,11 +68,26 @@ func main() {
 		defer redisCache.Close()
 	}
 
-	txRepo := pg.NewTransactionRepository(pool)
-	budgetRepo := pg.NewBudgetRepository(pool)
-	ledgerService := service.NewLedgerService(txRepo, budgetRepo, redisCache)
+	ledgerService := service.NewLedgerService(service.Repositories{
+		Transactions:   pg.NewTransactionRepository(pool),
+		Budgets:        pg.NewBudgetRepository(pool),
+		ExchangeRates:  pg.NewExchangeRateRepository(pool),
+		Settings:       pg.NewUserSettingsRepository(pool),
+		RecurringRules: pg.NewRecurringRuleRepository(pool),
+		Categories:     pg.NewCategoryRepository(pool),
+		Accounts:       pg.NewAccountRepository(pool),
+		CategoryRules:  pg.NewCategoryRuleRepository(pool),
+		ImportProfiles: pg.NewImportProfileRepository(pool),
+		ImportJobs:     pg.NewImportJobRepository(pool),
+	}, redisCache)
 	ledgerServer := grpcserver.NewLedgerServer(ledgerService)
 
+	recurringWorker := worker.NewRecurringWorker(ledgerService, cfg.RecurringInterval)
+	go recurringWorker.Run(ctx)
+
+	importWorker := worker.NewImportWorker(ledgerService, cfg.ImportWorkers, cfg.ImportPollInterval)
+	go importWorker.Run(ctx)
+
 	grpcAddr := ":" + cfg.GRPCPort
 	lis, err := net.Listen("tcp", grpcAddr)
 	if err != nil {
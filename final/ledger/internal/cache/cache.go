@@ -12,11 +12,6 @@ import (
 	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
 )
 
-type ReportCache struct {
-	Categories    []domain.CategorySummary
-	TotalExpenses float64
-}
-
 type Cache struct {
 	client         *redis.Client
 	reportTTL      time.Duration
@@ -75,7 +70,7 @@ func (c *Cache) Close() error {
 	return c.client.Close()
 }
 
-func (c *Cache) GetReport(ctx context.Context, userID int64, from, to time.Time) (*ReportCache, error) {
+func (c *Cache) GetReport(ctx context.Context, userID int64, from, to time.Time) (*domain.Report, error) {
 	key := c.reportKey(userID, from, to)
 	data, err := c.client.Get(ctx, key).Bytes()
 	if err != nil {
@@ -86,7 +81,7 @@ func (c *Cache) GetReport(ctx context.Context, userID int64, from, to time.Time)
 		return nil, err
 	}
 
-	var report ReportCache
+	var report domain.Report
 	if err := json.Unmarshal(data, &report); err != nil {
 		return nil, err
 	}
@@ -94,7 +89,7 @@ func (c *Cache) GetReport(ctx context.Context, userID int64, from, to time.Time)
 	return &report, nil
 }
 
-func (c *Cache) SetReport(ctx context.Context, userID int64, from, to time.Time, report *ReportCache) error {
+func (c *Cache) SetReport(ctx context.Context, userID int64, from, to time.Time, report *domain.Report) error {
 	key := c.reportKey(userID, from, to)
 	data, err := json.Marshal(report)
 	if err != nil {
@@ -105,7 +100,10 @@ func (c *Cache) SetReport(ctx context.Context, userID int64, from, to time.Time,
 }
 
 func (c *Cache) InvalidateReports(ctx context.Context, userID int64) {
-	pattern := c.reportSummaryPattern(userID)
+	c.unlinkPattern(ctx, "report", c.reportSummaryPattern(userID))
+}
+
+func (c *Cache) unlinkPattern(ctx context.Context, name, pattern string) {
 	iter := c.client.Scan(ctx, 0, pattern, 200).Iterator()
 
 	const batchSize = 200
@@ -116,9 +114,9 @@ func (c *Cache) InvalidateReports(ctx context.Context, userID int64) {
 			return
 		}
 		if err := c.client.Unlink(ctx, keys...).Err(); err != nil {
-			log.Printf("[CACHE UNLINK ERROR] report keys=%d err=%v", len(keys), err)
+			log.Printf("[CACHE UNLINK ERROR] %s keys=%d err=%v", name, len(keys), err)
 		} else {
-			log.Printf("[CACHE UNLINK] report keys=%d", len(keys))
+			log.Printf("[CACHE UNLINK] %s keys=%d", name, len(keys))
 		}
 		keys = keys[:0]
 	}
@@ -131,7 +129,7 @@ func (c *Cache) InvalidateReports(ctx context.Context, userID int64) {
 	}
 	flush()
 	if err := iter.Err(); err != nil {
-		log.Printf("[CACHE SCAN ERROR] report pattern=%s err=%v", pattern, err)
+		log.Printf("[CACHE SCAN ERROR] %s pattern=%s err=%v", name, pattern, err)
 	}
 }
 
@@ -172,15 +170,49 @@ func (c *Cache) InvalidateBudgets(ctx context.Context, userID int64) {
 	key := c.budgetsKey(userID)
 	if err := c.client.Unlink(ctx, key).Err(); err != nil {
 		log.Printf("[CACHE UNLINK ERROR] budgets key=%s err=%v", key, err)
-		return
+	} else {
+		log.Printf("[CACHE UNLINK] budgets key=%s", key)
 	}
-	log.Printf("[CACHE UNLINK] budgets key=%s", key)
+	c.unlinkPattern(ctx, "budget status", c.budgetStatusPattern(userID))
 }
 
 func (c *Cache) budgetsKey(userID int64) string {
 	return fmt.Sprintf("%s:%d", c.budgetsPrefix(), userID)
 }
 
+func (c *Cache) GetBudgetStatus(ctx context.Context, userID int64, date time.Time) ([]domain.BudgetStatus, error) {
+	key := c.budgetStatusKey(userID, date)
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			log.Printf("[CACHE MISS] budget status key=%s", key)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var statuses []domain.BudgetStatus
+	if err := json.Unmarshal(data, &statuses); err != nil {
+		return nil, err
+	}
+	log.Printf("[CACHE HIT] budget status key=%s", key)
+	return statuses, nil
+}
+
+func (c *Cache) SetBudgetStatus(ctx context.Context, userID int64, date time.Time, statuses []domain.BudgetStatus) error {
+	key := c.budgetStatusKey(userID, date)
+	data, err := json.Marshal(statuses)
+	if err != nil {
+		return err
+	}
+	log.Printf("[CACHE SET] budget status key=%s ttl=%v", key, c.budgetsListTTL)
+	return c.client.Set(ctx, key, data, c.budgetsListTTL).Err()
+}
+
+func (c *Cache) budgetStatusKey(userID int64, date time.Time) string {
+	return fmt.Sprintf("%s:%d:%s", c.budgetStatusPrefix(), userID, date.Format("2006-01-02"))
+}
+
 func (c *Cache) reportSummaryPrefix() string {
 	return "report:summary"
 }
@@ -192,3 +224,11 @@ func (c *Cache) reportSummaryPattern(userID int64) string {
 func (c *Cache) budgetsPrefix() string {
 	return "budgets:all"
 }
+
+func (c *Cache) budgetStatusPrefix() string {
+	return "budgets:status"
+}
+
+func (c *Cache) budgetStatusPattern(userID int64) string {
+	return fmt.Sprintf("%s:%d:*", c.budgetStatusPrefix(), userID)
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Config)
+		wantErr bool
+	}{
+		{name: "defaults", modify: func(c *Config) {}},
+		{name: "zero recurring interval", modify: func(c *Config) { c.RecurringInterval = 0 }, wantErr: true},
+		{name: "negative recurring interval", modify: func(c *Config) { c.RecurringInterval = -time.Minute }, wantErr: true},
+		{name: "no import workers", modify: func(c *Config) { c.ImportWorkers = 0 }, wantErr: true},
+		{name: "zero import poll interval", modify: func(c *Config) { c.ImportPollInterval = 0 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Load()
+			tt.modify(cfg)
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad_RecurringInterval(t *testing.T) {
+	t.Setenv("RECURRING_INTERVAL", "0s")
+	if err := Load().Validate(); err == nil {
+		t.Error("Validate() error = nil for RECURRING_INTERVAL=0s")
+	}
+}
+
+func TestLoad_ImportWorkers(t *testing.T) {
+	t.Setenv("IMPORT_WORKERS", "-1")
+	if err := Load().Validate(); err == nil {
+		t.Error("Validate() error = nil for IMPORT_WORKERS=-1")
+	}
+}
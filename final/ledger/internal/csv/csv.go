@@ -1,108 +1,217 @@
 package csv
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
 	"time"
 
+	"golang.org/x/text/encoding/charmap"
+
 	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
 )
 
-func ParseCSV(data []byte, userID int64) ([]domain.Transaction, []string, error) {
+type Reader struct {
+	reader    *csv.Reader
+	userID    int64
+	profile   *domain.ImportProfile
+	rules     []domain.CategoryRule
+	positions map[string]int
+	required  int
+	line      int
+}
+
+func NewReader(r io.Reader, userID int64, profile *domain.ImportProfile, rules []domain.CategoryRule) *Reader {
+	if profile == nil {
+		profile = domain.DefaultImportProfile()
+	}
+
+	reader := csv.NewReader(decode(r, profile.Encoding))
+	reader.Comma = profile.Comma()
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	return &Reader{reader: reader, userID: userID, profile: profile, rules: rules}
+}
+
+func ParseCSV(data []byte, userID int64, profile *domain.ImportProfile, rules []domain.CategoryRule) ([]domain.ImportRow, error) {
+	return NewReader(bytes.NewReader(data), userID, profile, rules).ReadAll()
+}
+
+func (r *Reader) ReadAll() ([]domain.ImportRow, error) {
+	var rows []domain.ImportRow
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, *row)
+	}
+}
+
+func (r *Reader) Read() (*domain.ImportRow, error) {
+	record, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.positions == nil {
+		positions, header, err := r.profile.ColumnPositions(record)
+		if err != nil {
+			return nil, err
+		}
+		r.positions = positions
+		r.required = positions[domain.ColumnAmount]
+		if index, ok := positions[domain.ColumnCategory]; ok && index > r.required {
+			r.required = index
+		}
+		if header {
+			if record, err = r.next(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return r.parseRecord(record), nil
+}
+
+func (r *Reader) next() ([]string, error) {
+	record, err := r.reader.Read()
+	if err == io.EOF {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	r.line++
+	return record, nil
+}
+
+func (r *Reader) parseRecord(record []string) *domain.ImportRow {
+	profile, positions := r.profile, r.positions
+	row := &domain.ImportRow{Line: r.line, Status: domain.RowReady}
+	cell := func(column string) string {
+		index, ok := positions[column]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	if len(record) <= r.required {
+		row.Fail(errors.New("not enough columns"))
+		return row
+	}
+
+	amount, signKind, err := profile.ParseAmount(record[positions[domain.ColumnAmount]])
+	if err != nil {
+		row.Fail(fmt.Errorf("invalid amount '%s'", cell(domain.ColumnAmount)))
+		return row
+	}
+
+	date := time.Now()
+	if value := cell(domain.ColumnDate); value == "" {
+		row.DateError = "missing date"
+	} else if parsed, err := profile.ParseDate(value); err != nil {
+		row.DateError = fmt.Sprintf("invalid date '%s'", value)
+		row.Warning = row.DateError + ", using today"
+	} else {
+		date = parsed
+	}
+
+	kind := domain.KindExpense
+	if value := cell(domain.ColumnKind); value != "" {
+		kind = strings.ToLower(value)
+	} else if signKind != "" {
+		kind = signKind
+	}
+
+	row.Transaction = domain.Transaction{
+		UserID:      r.userID,
+		Kind:        kind,
+		Amount:      amount,
+		Currency:    cell(domain.ColumnCurrency),
+		Category:    cell(domain.ColumnCategory),
+		Description: cell(domain.ColumnDescription),
+		Date:        date,
+		Tags:        domain.ParseTags(cell(domain.ColumnTags)),
+	}
+
+	row.Categorize(r.rules)
+	return row
+}
+
+func decode(r io.Reader, encoding string) io.Reader {
+	switch encoding {
+	case domain.EncodingWindows1251:
+		return charmap.Windows1251.NewDecoder().Reader(r)
+	case domain.EncodingKOI8R:
+		return charmap.KOI8R.NewDecoder().Reader(r)
+	}
+	buffered := bufio.NewReader(r)
+	if bom, err := buffered.Peek(3); err == nil && bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		_, _ = buffered.Discard(3)
+	}
+	return buffered
+}
+
+func ParseRatesCSV(data []byte, userID int64) ([]domain.ExchangeRate, []string, error) {
 	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
 	records, err := reader.ReadAll()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to parse CSV: %w", err)
 	}
 
-	var transactions []domain.Transaction
+	var rates []domain.ExchangeRate
 	var errors []string
 
 	for i, record := range records {
-		if i == 0 && (record[0] == "amount" || record[0] == "Amount" || record[0] == "сумма" || record[0] == "Сумма") {
+		if i == 0 && (record[0] == "date" || record[0] == "Date" || record[0] == "дата" || record[0] == "Дата") {
 			continue
 		}
 
-		if len(record) < 2 {
+		if len(record) < 4 {
 			errors = append(errors, fmt.Sprintf("row %d: not enough columns", i+1))
 			continue
 		}
 
-		amount, err := strconv.ParseFloat(record[0], 64)
+		date, err := time.Parse("2006-01-02", record[0])
 		if err != nil {
-			errors = append(errors, fmt.Sprintf("row %d: invalid amount '%s'", i+1, record[0]))
+			errors = append(errors, fmt.Sprintf("row %d: invalid date '%s'", i+1, record[0]))
 			continue
 		}
 
-		category := record[1]
-		if category == "" {
-			errors = append(errors, fmt.Sprintf("row %d: empty category", i+1))
+		value, err := strconv.ParseFloat(record[3], 64)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("row %d: invalid rate '%s'", i+1, record[3]))
 			continue
 		}
 
-		description := ""
-		if len(record) > 2 {
-			description = record[2]
+		rate := domain.ExchangeRate{
+			UserID:        userID,
+			BaseCurrency:  record[1],
+			QuoteCurrency: record[2],
+			Date:          date,
+			Rate:          value,
 		}
 
-		date := time.Now()
-		if len(record) > 3 && record[3] != "" {
-			parsed, err := time.Parse("2006-01-02", record[3])
-			if err != nil {
-				errors = append(errors, fmt.Sprintf("row %d: invalid date '%s', using today", i+1, record[3]))
-			} else {
-				date = parsed
-			}
-		}
-
-		tx := domain.Transaction{
-			UserID:      userID,
-			Amount:      amount,
-			Category:    category,
-			Description: description,
-			Date:        date,
-		}
-
-		if err := tx.Validate(); err != nil {
+		if err := rate.Validate(); err != nil {
 			errors = append(errors, fmt.Sprintf("row %d: %v", i+1, err))
 			continue
 		}
 
-		transactions = append(transactions, tx)
+		rates = append(rates, rate)
 	}
 
-	return transactions, errors, nil
+	return rates, errors, nil
 }
 
-func GenerateCSV(transactions []domain.Transaction) ([]byte, error) {
-	var buf bytes.Buffer
-	writer := csv.NewWriter(&buf)
-
-	if err := writer.Write([]string{"amount", "category", "description", "date"}); err != nil {
-		return nil, err
-	}
-
-	for _, tx := range transactions {
-		record := []string{
-			fmt.Sprintf("%.2f", tx.Amount),
-			tx.Category,
-			tx.Description,
-			tx.Date.Format("2006-01-02"),
-		}
-		if err := writer.Write(record); err != nil {
-			return nil, err
-		}
-	}
-
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-
-
@@ -0,0 +1,148 @@
+package csv
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func TestParseCSV_Profile(t *testing.T) {
+	profile := &domain.ImportProfile{
+		UserID:         1,
+		Name:           "Сбербанк",
+		Delimiter:      ";",
+		DecimalComma:   true,
+		DateLayouts:    []string{"02.01.2006"},
+		SignConvention: domain.SignNegativeExpense,
+		Columns: map[string]string{
+			"date":        "Дата операции",
+			"description": "Описание",
+			"amount":      "Сумма",
+			"category":    "4",
+		},
+	}
+	if err := profile.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	data := "Дата операции;Описание;Сумма;Категория\n" +
+		"14.03.2025;Кофе Хауз;-1 350,50;cafe\n" +
+		"15.03.2025;Зарплата;\"120 000,00\";salary\n" +
+		"16.03.2025;Аптека;-12,3,4;health\n"
+
+	rows, err := ParseCSV([]byte(data), 1, profile, nil)
+	if err != nil {
+		t.Fatalf("ParseCSV() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("len(rows) = %d, want 3", len(rows))
+	}
+
+	want := []domain.Transaction{
+		{UserID: 1, Kind: domain.KindExpense, Amount: 135050, Category: "cafe", Description: "Кофе Хауз", Date: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{UserID: 1, Kind: domain.KindIncome, Amount: 12000000, Category: "salary", Description: "Зарплата", Date: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, tx := range want {
+		if rows[i].Status != domain.RowReady {
+			t.Fatalf("rows[%d].Status = %q (%s), want ready", i, rows[i].Status, rows[i].Error)
+		}
+		if !reflect.DeepEqual(rows[i].Transaction, tx) {
+			t.Errorf("rows[%d].Transaction = %+v, want %+v", i, rows[i].Transaction, tx)
+		}
+	}
+	if rows[2].Status != domain.RowFailed || rows[2].Line != 4 {
+		t.Errorf("rows[2] = line %d %s, want line 4 failed", rows[2].Line, rows[2].Status)
+	}
+}
+
+func TestParseCSV_Encoding(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding string
+		charmap  *charmap.Charmap
+	}{
+		{name: "utf-8", encoding: domain.EncodingUTF8},
+		{name: "windows-1251", encoding: domain.EncodingWindows1251, charmap: charmap.Windows1251},
+		{name: "koi8-r", encoding: domain.EncodingKOI8R, charmap: charmap.KOI8R},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte("\ufeffсумма,категория,описание\n350.00,Кафе,Кофе Хауз\n")
+			if tt.charmap != nil {
+				encoded, err := tt.charmap.NewEncoder().Bytes(data[3:])
+				if err != nil {
+					t.Fatalf("Encode() error = %v", err)
+				}
+				data = encoded
+			}
+			profile := &domain.ImportProfile{UserID: 1, Name: "test", Encoding: tt.encoding}
+			if err := profile.Validate(); err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			rows, err := ParseCSV(data, 1, profile, nil)
+			if err != nil {
+				t.Fatalf("ParseCSV() error = %v", err)
+			}
+			if len(rows) != 1 || rows[0].Status != domain.RowReady {
+				t.Fatalf("rows = %+v, want one ready row", rows)
+			}
+			if tx := rows[0].Transaction; tx.Category != "Кафе" || tx.Description != "Кофе Хауз" {
+				t.Errorf("Category, Description = %q, %q, want %q, %q", tx.Category, tx.Description, "Кафе", "Кофе Хауз")
+			}
+		})
+	}
+}
+
+func TestParseCSV_Header(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantLines  []int
+		wantStatus []string
+	}{
+		{
+			name:       "header skipped",
+			data:       "amount,category\n350.00,cafe\n",
+			wantLines:  []int{2},
+			wantStatus: []string{domain.RowReady},
+		},
+		{
+			name:       "no header",
+			data:       "350.00,cafe\n120.00,food\n",
+			wantLines:  []int{1, 2},
+			wantStatus: []string{domain.RowReady, domain.RowReady},
+		},
+		{
+			name:       "malformed first row reported",
+			data:       "35O.00,cafe\n120.00,food\n",
+			wantLines:  []int{1, 2},
+			wantStatus: []string{domain.RowFailed, domain.RowReady},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseCSV([]byte(tt.data), 1, nil, nil)
+			if err != nil {
+				t.Fatalf("ParseCSV() error = %v", err)
+			}
+			if len(rows) != len(tt.wantLines) {
+				t.Fatalf("len(rows) = %d, want %d", len(rows), len(tt.wantLines))
+			}
+			for i, row := range rows {
+				if row.Line != tt.wantLines[i] || row.Status != tt.wantStatus[i] {
+					t.Errorf("rows[%d] = line %d %s (%s), want line %d %s", i, row.Line, row.Status, row.Error, tt.wantLines[i], tt.wantStatus[i])
+				}
+			}
+			if rows[0].Status == domain.RowFailed && rows[0].Error != "invalid amount '35O.00'" {
+				t.Errorf("rows[0].Error = %q", rows[0].Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	AccountCash    = "cash"
+	AccountDebit   = "debit"
+	AccountCredit  = "credit"
+	AccountSavings = "savings"
+
+	IntervalDay   = "day"
+	IntervalWeek  = "week"
+	IntervalMonth = "month"
+
+	TransferCategory = "Transfer"
+
+	maxAccountNameLength = 100
+	maxBalancePoints     = 1000
+)
+
+var ErrDuplicateAccount = errors.New("account with this name already exists")
+
+type Account struct {
+	ID             int64
+	UserID         int64
+	Name           string
+	Type           string
+	Currency       string
+	OpeningBalance Money
+	Balance        Money
+	Archived       bool
+	CreatedAt      time.Time
+}
+
+func (a *Account) Validate() error {
+	a.Name = NormalizeCategoryName(a.Name)
+	if a.Name == "" {
+		return errors.New("name is required")
+	}
+	if utf8.RuneCountInString(a.Name) > maxAccountNameLength {
+		return errors.New("name must be at most 100 characters")
+	}
+	if a.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	switch a.Type {
+	case "":
+		a.Type = AccountCash
+	case AccountCash, AccountDebit, AccountCredit, AccountSavings:
+	default:
+		return errors.New("type must be cash, debit, credit or savings")
+	}
+	if a.Currency != "" {
+		currency, err := NormalizeCurrency(a.Currency)
+		if err != nil {
+			return err
+		}
+		a.Currency = currency
+	}
+	return nil
+}
+
+type Transfer struct {
+	ID            int64
+	UserID        int64
+	FromAccountID int64
+	ToAccountID   int64
+	Amount        Money
+	ToAmount      Money
+	Description   string
+	Date          time.Time
+	CreatedAt     time.Time
+}
+
+func (t *Transfer) Validate() error {
+	if t.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	if t.FromAccountID <= 0 || t.ToAccountID <= 0 {
+		return errors.New("from_account_id and to_account_id are required")
+	}
+	if t.FromAccountID == t.ToAccountID {
+		return errors.New("cannot transfer to the same account")
+	}
+	if t.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+	if t.ToAmount < 0 {
+		return errors.New("to_amount must not be negative")
+	}
+	return nil
+}
+
+type BalancePoint struct {
+	Date    time.Time
+	Balance Money
+}
+
+func BalanceDates(from, to time.Time, interval string) ([]time.Time, error) {
+	from = truncateDay(from)
+	to = truncateDay(to)
+	if to.Before(from) {
+		return nil, errors.New("from must not be after to")
+	}
+
+	var step func(i int) time.Time
+	switch interval {
+	case IntervalDay:
+		step = func(i int) time.Time { return from.AddDate(0, 0, i) }
+	case IntervalWeek:
+		step = func(i int) time.Time { return from.AddDate(0, 0, 7*i) }
+	case "", IntervalMonth:
+		step = func(i int) time.Time { return from.AddDate(0, i, 0) }
+	default:
+		return nil, errors.New("interval must be day, week or month")
+	}
+
+	var dates []time.Time
+	for i := 0; step(i).Before(to); i++ {
+		if len(dates) == maxBalancePoints-1 {
+			return nil, errors.New("too many balance points, use a longer interval")
+		}
+		dates = append(dates, step(i))
+	}
+	return append(dates, to), nil
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccount_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		account  Account
+		wantErr  bool
+		wantType string
+	}{
+		{
+			name:     "defaults to cash",
+			account:  Account{UserID: 1, Name: " Wallet "},
+			wantType: AccountCash,
+		},
+		{
+			name:     "savings in dollars",
+			account:  Account{UserID: 1, Name: "Savings", Type: AccountSavings, Currency: "usd"},
+			wantType: AccountSavings,
+		},
+		{
+			name:    "missing name",
+			account: Account{UserID: 1},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			account: Account{UserID: 1, Name: "Broker", Type: "brokerage"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid currency",
+			account: Account{UserID: 1, Name: "Wallet", Currency: "rubles"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.account.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.account.Type != tt.wantType {
+				t.Errorf("Type = %q, want %q", tt.account.Type, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestTransfer_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer Transfer
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			transfer: Transfer{UserID: 1, FromAccountID: 1, ToAccountID: 2, Amount: 500000},
+		},
+		{
+			name:     "with converted amount",
+			transfer: Transfer{UserID: 1, FromAccountID: 1, ToAccountID: 2, Amount: 1000000, ToAmount: 10000},
+		},
+		{
+			name:     "same account",
+			transfer: Transfer{UserID: 1, FromAccountID: 1, ToAccountID: 1, Amount: 500000},
+			wantErr:  true,
+		},
+		{
+			name:     "missing destination",
+			transfer: Transfer{UserID: 1, FromAccountID: 1, Amount: 500000},
+			wantErr:  true,
+		},
+		{
+			name:     "zero amount",
+			transfer: Transfer{UserID: 1, FromAccountID: 1, ToAccountID: 2},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.transfer.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBalanceDates(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to time.Time
+		interval string
+		want     []time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "monthly ends with to",
+			from:     date(2024, 1, 1),
+			to:       date(2024, 3, 15),
+			interval: IntervalMonth,
+			want:     []time.Time{date(2024, 1, 1), date(2024, 2, 1), date(2024, 3, 1), date(2024, 3, 15)},
+		},
+		{
+			name:     "weekly",
+			from:     date(2024, 12, 2),
+			to:       date(2024, 12, 16),
+			interval: IntervalWeek,
+			want:     []time.Time{date(2024, 12, 2), date(2024, 12, 9), date(2024, 12, 16)},
+		},
+		{
+			name: "single day",
+			from: date(2024, 12, 2),
+			to:   date(2024, 12, 2),
+			want: []time.Time{date(2024, 12, 2)},
+		},
+		{
+			name:    "reversed range",
+			from:    date(2024, 12, 2),
+			to:      date(2024, 12, 1),
+			wantErr: true,
+		},
+		{
+			name:     "unknown interval",
+			from:     date(2024, 12, 1),
+			to:       date(2024, 12, 31),
+			interval: "hour",
+			wantErr:  true,
+		},
+		{
+			name:     "too many points",
+			from:     date(2000, 1, 1),
+			to:       date(2024, 12, 31),
+			interval: IntervalDay,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BalanceDates(tt.from, tt.to, tt.interval)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BalanceDates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("BalanceDates() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("BalanceDates()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
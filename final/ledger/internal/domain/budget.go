@@ -1,13 +1,79 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	PeriodDaily     = "daily"
+	PeriodWeekly    = "weekly"
+	PeriodMonthly   = "monthly"
+	PeriodQuarterly = "quarterly"
+	PeriodYearly    = "yearly"
+)
+
+const (
+	EnforcementHard = "hard"
+	EnforcementSoft = "soft"
+	EnforcementWarn = "warn"
+)
+
+const maxWarningThreshold = 1000
+
+var DefaultWarningThresholds = []int{80}
 
 type Budget struct {
-	ID          int64
-	UserID      int64
-	Category    string
-	LimitAmount float64
-	Period      string
+	ID           int64
+	UserID       int64
+	Category     string
+	LimitAmount  Money
+	Currency     string
+	Period       string
+	StartDay     int
+	Rollover     bool
+	RolloverFrom *time.Time
+	Enforcement  string
+	Thresholds   []int
+	ValidFrom    time.Time
+	ValidTo      *time.Time
+}
+
+type BudgetStatus struct {
+	Budget     Budget
+	Currency   string
+	PeriodFrom time.Time
+	PeriodTo   time.Time
+	Limit      Money
+	Spent      Money
+	Remaining  Money
+	Percentage float64
+	Projected  Money
+}
+
+func NewBudgetStatus(budget Budget, currency string, from, to time.Time, limit, spent Money, now time.Time) BudgetStatus {
+	return BudgetStatus{
+		Budget:     budget,
+		Currency:   currency,
+		PeriodFrom: from,
+		PeriodTo:   to,
+		Limit:      limit,
+		Spent:      spent,
+		Remaining:  limit - spent,
+		Percentage: spent.Percent(limit),
+		Projected:  ProjectedSpend(spent, from, to, now),
+	}
+}
+
+func ProjectedSpend(spent Money, from, to, now time.Time) Money {
+	totalDays := int64(math.Round(to.Add(time.Second).Sub(from).Hours() / 24))
+	elapsedDays := int64(now.Sub(from).Hours()/24) + 1
+	if now.Before(from) || elapsedDays >= totalDays || totalDays <= 0 {
+		return spent
+	}
+	return Money(int64(spent) * totalDays / elapsedDays)
 }
 
 func (b *Budget) Validate() error {
@@ -20,11 +86,186 @@ func (b *Budget) Validate() error {
 	if b.UserID <= 0 {
 		return errors.New("user_id is required")
 	}
-	if b.Period != "monthly" && b.Period != "weekly" {
-		b.Period = "monthly"
+	if b.Period == "" {
+		b.Period = PeriodMonthly
+	}
+	if !ValidPeriod(b.Period) {
+		return errors.New("period must be daily, weekly, monthly, quarterly or yearly")
+	}
+	if b.StartDay == 0 {
+		b.StartDay = 1
+	}
+	switch {
+	case b.Period == PeriodDaily && b.StartDay != 1:
+		return errors.New("start_day is not supported for daily budgets")
+	case b.Period == PeriodWeekly && (b.StartDay < 1 || b.StartDay > 7):
+		return errors.New("start_day must be between 1 (Monday) and 7 (Sunday) for weekly budgets")
+	case b.StartDay < 1 || b.StartDay > 31:
+		return errors.New("start_day must be between 1 and 31")
+	}
+	if b.Enforcement == "" {
+		b.Enforcement = EnforcementHard
+	}
+	if !ValidEnforcement(b.Enforcement) {
+		return errors.New("enforcement must be hard, soft or warn")
+	}
+	if err := b.normalizeThresholds(); err != nil {
+		return err
+	}
+	if b.Currency != "" {
+		currency, err := NormalizeCurrency(b.Currency)
+		if err != nil {
+			return err
+		}
+		b.Currency = currency
+	}
+	return nil
+}
+
+func ValidEnforcement(enforcement string) bool {
+	switch enforcement {
+	case EnforcementHard, EnforcementSoft, EnforcementWarn:
+		return true
+	}
+	return false
+}
+
+func (b *Budget) ReachedThreshold(percentage float64) int {
+	thresholds := b.Thresholds
+	if len(thresholds) == 0 {
+		thresholds = DefaultWarningThresholds
+	}
+	reached := 0
+	for _, t := range thresholds {
+		if percentage >= float64(t) {
+			reached = t
+		}
+	}
+	return reached
+}
+
+func (b *Budget) normalizeThresholds() error {
+	if len(b.Thresholds) == 0 {
+		b.Thresholds = append([]int(nil), DefaultWarningThresholds...)
+		return nil
+	}
+	thresholds := append([]int(nil), b.Thresholds...)
+	sort.Ints(thresholds)
+	unique := make([]int, 0, len(thresholds))
+	for i, t := range thresholds {
+		if t <= 0 || t > maxWarningThreshold {
+			return errors.New("warning thresholds must be between 1 and 1000 percent")
+		}
+		if i == 0 || t != thresholds[i-1] {
+			unique = append(unique, t)
+		}
+	}
+	b.Thresholds = unique
+	return nil
+}
+
+func (b *Budget) InForce(date time.Time) bool {
+	if date.Before(b.ValidFrom) {
+		return false
+	}
+	return b.ValidTo == nil || date.Before(*b.ValidTo)
+}
+
+func BudgetVersionAt(versions []Budget, date time.Time) *Budget {
+	for i := range versions {
+		if versions[i].InForce(date) {
+			return &versions[i]
+		}
 	}
 	return nil
 }
 
+func NextBudgetVersion(versions []Budget, date time.Time) *Budget {
+	var next *Budget
+	for i := range versions {
+		if versions[i].ValidFrom.After(date) && (next == nil || versions[i].ValidFrom.Before(next.ValidFrom)) {
+			next = &versions[i]
+		}
+	}
+	return next
+}
+
+func ValidPeriod(period string) bool {
+	switch period {
+	case PeriodDaily, PeriodWeekly, PeriodMonthly, PeriodQuarterly, PeriodYearly:
+		return true
+	}
+	return false
+}
+
+func (b *Budget) PeriodFor(date time.Time) (time.Time, time.Time) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+	var from, next time.Time
+	switch b.Period {
+	case PeriodDaily:
+		from = day
+		next = from.AddDate(0, 0, 1)
+	case PeriodWeekly:
+		startDay := b.startDay()
+		weekday := int(day.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		from = day.AddDate(0, 0, -((weekday - startDay + 7) % 7))
+		next = from.AddDate(0, 0, 7)
+	default:
+		months := b.periodMonths()
+		firstMonth := (int(day.Month())-1)/months*months + 1
+		from = b.monthStart(day.Year(), time.Month(firstMonth), day.Location())
+		if day.Before(from) {
+			from = b.monthStart(day.Year(), time.Month(firstMonth-months), day.Location())
+		}
+		next = b.monthStart(from.Year(), from.Month()+time.Month(months), day.Location())
+	}
+
+	to := next.AddDate(0, 0, -1)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 23, 59, 59, 0, to.Location())
+	return from, to
+}
+
+func (b *Budget) NextPeriod(date time.Time) (time.Time, time.Time) {
+	_, to := b.PeriodFor(date)
+	return b.PeriodFor(to.Add(time.Second))
+}
 
+func (b *Budget) CountPeriods(from, to time.Time) int {
+	count := 0
+	for start, _ := b.PeriodFor(from); !start.After(to); start, _ = b.NextPeriod(start) {
+		count++
+	}
+	return count
+}
 
+func (b *Budget) startDay() int {
+	if b.StartDay < 1 {
+		return 1
+	}
+	return b.StartDay
+}
+
+func (b *Budget) periodMonths() int {
+	switch b.Period {
+	case PeriodQuarterly:
+		return 3
+	case PeriodYearly:
+		return 12
+	default:
+		return 1
+	}
+}
+
+func (b *Budget) monthStart(year int, month time.Month, loc *time.Location) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := b.startDay()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
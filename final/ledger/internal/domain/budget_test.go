@@ -1,6 +1,9 @@
 package domain
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestBudget_Validate(t *testing.T) {
 	tests := []struct {
@@ -13,7 +16,7 @@ func TestBudget_Validate(t *testing.T) {
 			budget: Budget{
 				UserID:      1,
 				Category:    "food",
-				LimitAmount: 15000,
+				LimitAmount: 1500000,
 				Period:      "monthly",
 			},
 			wantErr: false,
@@ -23,7 +26,7 @@ func TestBudget_Validate(t *testing.T) {
 			budget: Budget{
 				UserID:      1,
 				Category:    "transport",
-				LimitAmount: 5000,
+				LimitAmount: 500000,
 				Period:      "weekly",
 			},
 			wantErr: false,
@@ -33,11 +36,75 @@ func TestBudget_Validate(t *testing.T) {
 			budget: Budget{
 				UserID:      1,
 				Category:    "food",
-				LimitAmount: 10000,
+				LimitAmount: 1000000,
 				Period:      "",
 			},
 			wantErr: false, 
 		},
+		{
+			name: "unknown period",
+			budget: Budget{
+				UserID:      1,
+				Category:    "food",
+				LimitAmount: 1000000,
+				Period:      "fortnightly",
+			},
+			wantErr: true,
+		},
+		{
+			name: "salary-day month",
+			budget: Budget{
+				UserID:      1,
+				Category:    "food",
+				LimitAmount: 1000000,
+				Period:      "monthly",
+				StartDay:    25,
+				Rollover:    true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "weekly start day out of range",
+			budget: Budget{
+				UserID:      1,
+				Category:    "food",
+				LimitAmount: 1000000,
+				Period:      "weekly",
+				StartDay:    8,
+			},
+			wantErr: true,
+		},
+		{
+			name: "soft budget with thresholds",
+			budget: Budget{
+				UserID:      1,
+				Category:    "food",
+				LimitAmount: 1000000,
+				Enforcement: "soft",
+				Thresholds:  []int{100, 50, 80, 80},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown enforcement",
+			budget: Budget{
+				UserID:      1,
+				Category:    "food",
+				LimitAmount: 1000000,
+				Enforcement: "strict",
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero threshold",
+			budget: Budget{
+				UserID:      1,
+				Category:    "food",
+				LimitAmount: 1000000,
+				Thresholds:  []int{0, 80},
+			},
+			wantErr: true,
+		},
 		{
 			name: "zero limit",
 			budget: Budget{
@@ -51,7 +118,7 @@ func TestBudget_Validate(t *testing.T) {
 			budget: Budget{
 				UserID:      1,
 				Category:    "food",
-				LimitAmount: -100,
+				LimitAmount: -10000,
 			},
 			wantErr: true,
 		},
@@ -59,7 +126,7 @@ func TestBudget_Validate(t *testing.T) {
 			name: "empty category",
 			budget: Budget{
 				UserID:      1,
-				LimitAmount: 10000,
+				LimitAmount: 1000000,
 			},
 			wantErr: true,
 		},
@@ -67,7 +134,7 @@ func TestBudget_Validate(t *testing.T) {
 			name: "zero user_id",
 			budget: Budget{
 				Category:    "food",
-				LimitAmount: 10000,
+				LimitAmount: 1000000,
 			},
 			wantErr: true,
 		},
@@ -83,5 +150,186 @@ func TestBudget_Validate(t *testing.T) {
 	}
 }
 
+func TestBudget_PeriodFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		budget   Budget
+		date     time.Time
+		wantFrom time.Time
+		wantTo   time.Time
+	}{
+		{
+			name:     "daily",
+			budget:   Budget{Period: PeriodDaily},
+			date:     date(2024, 12, 15),
+			wantFrom: date(2024, 12, 15),
+			wantTo:   date(2024, 12, 15),
+		},
+		{
+			name:     "weekly from monday",
+			budget:   Budget{Period: PeriodWeekly},
+			date:     date(2024, 12, 15),
+			wantFrom: date(2024, 12, 9),
+			wantTo:   date(2024, 12, 15),
+		},
+		{
+			name:     "weekly from saturday",
+			budget:   Budget{Period: PeriodWeekly, StartDay: 6},
+			date:     date(2024, 12, 13),
+			wantFrom: date(2024, 12, 7),
+			wantTo:   date(2024, 12, 13),
+		},
+		{
+			name:     "calendar month",
+			budget:   Budget{Period: PeriodMonthly},
+			date:     date(2024, 2, 10),
+			wantFrom: date(2024, 2, 1),
+			wantTo:   date(2024, 2, 29),
+		},
+		{
+			name:     "salary-day month before start day",
+			budget:   Budget{Period: PeriodMonthly, StartDay: 25},
+			date:     date(2025, 1, 10),
+			wantFrom: date(2024, 12, 25),
+			wantTo:   date(2025, 1, 24),
+		},
+		{
+			name:     "start day clamped to short month",
+			budget:   Budget{Period: PeriodMonthly, StartDay: 31},
+			date:     date(2024, 3, 5),
+			wantFrom: date(2024, 2, 29),
+			wantTo:   date(2024, 3, 30),
+		},
+		{
+			name:     "quarter",
+			budget:   Budget{Period: PeriodQuarterly},
+			date:     date(2024, 5, 20),
+			wantFrom: date(2024, 4, 1),
+			wantTo:   date(2024, 6, 30),
+		},
+		{
+			name:     "year",
+			budget:   Budget{Period: PeriodYearly},
+			date:     date(2024, 5, 20),
+			wantFrom: date(2024, 1, 1),
+			wantTo:   date(2024, 12, 31),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := tt.budget.PeriodFor(tt.date)
+			wantTo := tt.wantTo.Add(24*time.Hour - time.Second)
+			if !from.Equal(tt.wantFrom) || !to.Equal(wantTo) {
+				t.Errorf("PeriodFor() = %s..%s, want %s..%s", from, to, tt.wantFrom, wantTo)
+			}
+		})
+	}
+}
+
+func TestBudget_CountPeriods(t *testing.T) {
+	budget := Budget{Period: PeriodMonthly, StartDay: 25}
+	if got := budget.CountPeriods(date(2024, 10, 30), date(2024, 12, 31)); got != 3 {
+		t.Errorf("CountPeriods() = %d, want 3", got)
+	}
+	if got := budget.CountPeriods(date(2024, 12, 30), date(2024, 12, 31)); got != 1 {
+		t.Errorf("CountPeriods() within one period = %d, want 1", got)
+	}
+}
+
+func TestBudget_ReachedThreshold(t *testing.T) {
+	budget := Budget{UserID: 1, Category: "food", LimitAmount: 1000000, Thresholds: []int{100, 50, 80, 80}}
+	if err := budget.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if len(budget.Thresholds) != 3 || budget.Thresholds[0] != 50 || budget.Thresholds[2] != 100 {
+		t.Errorf("Validate() thresholds = %v, want [50 80 100]", budget.Thresholds)
+	}
+	if budget.Enforcement != EnforcementHard {
+		t.Errorf("Validate() enforcement = %q, want %q", budget.Enforcement, EnforcementHard)
+	}
+
+	tests := []struct {
+		percentage float64
+		want       int
+	}{
+		{percentage: 10, want: 0},
+		{percentage: 50, want: 50},
+		{percentage: 99.9, want: 80},
+		{percentage: 140, want: 100},
+	}
+
+	for _, tt := range tests {
+		if got := budget.ReachedThreshold(tt.percentage); got != tt.want {
+			t.Errorf("ReachedThreshold(%v) = %d, want %d", tt.percentage, got, tt.want)
+		}
+	}
+}
+
+func TestBudgetVersionAt(t *testing.T) {
+	janEnd := date(2025, 2, 1)
+	aprEnd := date(2025, 4, 1)
+	versions := []Budget{
+		{ID: 1, LimitAmount: 1000000, ValidFrom: date(2025, 1, 1), ValidTo: &janEnd},
+		{ID: 2, LimitAmount: 1500000, ValidFrom: date(2025, 3, 1), ValidTo: &aprEnd},
+		{ID: 3, LimitAmount: 2000000, ValidFrom: date(2025, 4, 1)},
+	}
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		wantID   int64
+		wantNext int64
+	}{
+		{name: "before first version", date: date(2024, 12, 31), wantID: 0, wantNext: 1},
+		{name: "first day of version", date: date(2025, 1, 1), wantID: 1, wantNext: 2},
+		{name: "valid_to is exclusive", date: date(2025, 2, 1), wantID: 0, wantNext: 2},
+		{name: "gap between versions", date: date(2025, 2, 15), wantID: 0, wantNext: 2},
+		{name: "last day of closed version", date: date(2025, 3, 31).Add(23 * time.Hour), wantID: 2, wantNext: 3},
+		{name: "open-ended version", date: date(2026, 6, 1), wantID: 3, wantNext: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotID, gotNext int64
+			if v := BudgetVersionAt(versions, tt.date); v != nil {
+				gotID = v.ID
+			}
+			if v := NextBudgetVersion(versions, tt.date); v != nil {
+				gotNext = v.ID
+			}
+			if gotID != tt.wantID {
+				t.Errorf("BudgetVersionAt() = %d, want %d", gotID, tt.wantID)
+			}
+			if gotNext != tt.wantNext {
+				t.Errorf("NextBudgetVersion() = %d, want %d", gotNext, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestProjectedSpend(t *testing.T) {
+	budget := Budget{Period: PeriodMonthly, StartDay: 1}
+	from, to := budget.PeriodFor(date(2025, 1, 15))
 
+	tests := []struct {
+		name  string
+		spent Money
+		now   time.Time
+		want  Money
+	}{
+		{name: "first day", spent: 1000, now: date(2025, 1, 1).Add(10 * time.Hour), want: 31000},
+		{name: "tenth day", spent: 100000, now: date(2025, 1, 10).Add(12 * time.Hour), want: 310000},
+		{name: "last day", spent: 50000, now: date(2025, 1, 31).Add(23 * time.Hour), want: 50000},
+		{name: "after period", spent: 50000, now: date(2025, 2, 3), want: 50000},
+		{name: "nothing spent", spent: 0, now: date(2025, 1, 20), want: 0},
+	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProjectedSpend(tt.spent, from, to, tt.now); got != tt.want {
+				t.Errorf("ProjectedSpend() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const maxCategoryNameLength = 100
+
+var ErrDuplicateCategory = errors.New("category with this name already exists")
+
+type Category struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	ParentID  *int64
+	Archived  bool
+	CreatedAt time.Time
+}
+
+func (c *Category) Validate() error {
+	c.Name = NormalizeCategoryName(c.Name)
+	if c.Name == "" {
+		return errors.New("name is required")
+	}
+	if utf8.RuneCountInString(c.Name) > maxCategoryNameLength {
+		return errors.New("name must be at most 100 characters")
+	}
+	if c.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	if c.ParentID != nil && *c.ParentID <= 0 {
+		c.ParentID = nil
+	}
+	if c.ParentID != nil && c.ID > 0 && *c.ParentID == c.ID {
+		return errors.New("category cannot be its own parent")
+	}
+	return nil
+}
+
+func NormalizeCategoryName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func CategoryKey(name string) string {
+	return strings.ToLower(NormalizeCategoryName(name))
+}
+
+func CategoryAncestors(categories []Category, id int64) []Category {
+	byID := make(map[int64]*Category, len(categories))
+	for i := range categories {
+		byID[categories[i].ID] = &categories[i]
+	}
+
+	var ancestors []Category
+	seen := map[int64]bool{id: true}
+	for c := byID[id]; c != nil && c.ParentID != nil && !seen[*c.ParentID]; {
+		parent := byID[*c.ParentID]
+		if parent == nil {
+			break
+		}
+		seen[parent.ID] = true
+		ancestors = append(ancestors, *parent)
+		c = parent
+	}
+	return ancestors
+}
+
+func CreatesCycle(categories []Category, id, parentID int64) bool {
+	if id == parentID {
+		return true
+	}
+	for _, a := range CategoryAncestors(categories, parentID) {
+		if a.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func RollUpCategories(summaries []CategorySummary, categories []Category) []CategorySummary {
+	byKey := make(map[string]*Category, len(categories))
+	byID := make(map[int64]*Category, len(categories))
+	for i := range categories {
+		byKey[CategoryKey(categories[i].Name)] = &categories[i]
+		byID[categories[i].ID] = &categories[i]
+	}
+
+	index := make(map[string]int, len(summaries))
+	for i := range summaries {
+		index[CategoryKey(summaries[i].Category)] = i
+		summaries[i].RollupTotal = summaries[i].Total
+	}
+
+	direct := len(summaries)
+	for i := 0; i < direct; i++ {
+		category := byKey[CategoryKey(summaries[i].Category)]
+		if category == nil {
+			continue
+		}
+		for _, ancestor := range CategoryAncestors(categories, category.ID) {
+			key := CategoryKey(ancestor.Name)
+			j, ok := index[key]
+			if !ok {
+				summaries = append(summaries, CategorySummary{Category: ancestor.Name})
+				j = len(summaries) - 1
+				index[key] = j
+			}
+			summaries[j].RollupTotal += summaries[i].Total
+		}
+	}
+
+	for i := range summaries {
+		category := byKey[CategoryKey(summaries[i].Category)]
+		if category == nil || category.ParentID == nil {
+			continue
+		}
+		if parent := byID[*category.ParentID]; parent != nil {
+			summaries[i].Parent = parent.Name
+		}
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].RollupTotal > summaries[j].RollupTotal
+	})
+	return summaries
+}
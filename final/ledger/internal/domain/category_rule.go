@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MatchContains = "contains"
+	MatchRegex    = "regex"
+
+	maxRulePatternLength = 200
+)
+
+type CategoryRule struct {
+	ID        int64
+	UserID    int64
+	MatchType string
+	Pattern   string
+	MinAmount *Money
+	MaxAmount *Money
+	Category  string
+	Tags      []string
+	Priority  int
+	CreatedAt time.Time
+
+	re *regexp.Regexp
+}
+
+func (r *CategoryRule) Validate() error {
+	if r.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	r.Category = NormalizeCategoryName(r.Category)
+	if r.Category == "" {
+		return errors.New("category is required")
+	}
+	r.Pattern = strings.TrimSpace(r.Pattern)
+	if utf8.RuneCountInString(r.Pattern) > maxRulePatternLength {
+		return errors.New("pattern must be at most 200 characters")
+	}
+	switch r.MatchType {
+	case "":
+		r.MatchType = MatchContains
+	case MatchContains, MatchRegex:
+	default:
+		return errors.New("match_type must be contains or regex")
+	}
+	if r.MatchType == MatchRegex {
+		re, err := regexp.Compile("(?i)" + r.Pattern)
+		if err != nil {
+			return errors.New("pattern is not a valid regular expression")
+		}
+		r.re = re
+	}
+	if r.Pattern == "" && r.MinAmount == nil && r.MaxAmount == nil {
+		return errors.New("pattern or amount range is required")
+	}
+	if (r.MinAmount != nil && *r.MinAmount < 0) || (r.MaxAmount != nil && *r.MaxAmount < 0) {
+		return errors.New("amount range must not be negative")
+	}
+	if r.MinAmount != nil && r.MaxAmount != nil && *r.MinAmount > *r.MaxAmount {
+		return errors.New("min_amount must not exceed max_amount")
+	}
+	tags, err := NormalizeTags(r.Tags)
+	if err != nil {
+		return err
+	}
+	r.Tags = tags
+	return nil
+}
+
+func (r *CategoryRule) Matches(tx *Transaction) bool {
+	if r.MinAmount != nil && tx.Amount < *r.MinAmount {
+		return false
+	}
+	if r.MaxAmount != nil && tx.Amount > *r.MaxAmount {
+		return false
+	}
+	if r.Pattern == "" {
+		return true
+	}
+	if r.MatchType == MatchRegex {
+		if r.re == nil {
+			re, err := regexp.Compile("(?i)" + r.Pattern)
+			if err != nil {
+				return false
+			}
+			r.re = re
+		}
+		return r.re.MatchString(tx.Description)
+	}
+	return strings.Contains(strings.ToLower(tx.Description), strings.ToLower(r.Pattern))
+}
+
+func ApplyCategoryRules(rules []CategoryRule, tx *Transaction) *CategoryRule {
+	if tx.Category != "" || len(tx.Splits) > 0 {
+		return nil
+	}
+	for i := range rules {
+		if !rules[i].Matches(tx) {
+			continue
+		}
+		tx.Category = rules[i].Category
+		if len(rules[i].Tags) > 0 {
+			tags := append(append([]string{}, tx.Tags...), rules[i].Tags...)
+			if normalized, err := NormalizeTags(tags); err == nil {
+				tx.Tags = normalized
+			}
+		}
+		return &rules[i]
+	}
+	return nil
+}
+
+type RuleTestResult struct {
+	Matched int
+	Changed int
+	Samples []Transaction
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCategoryRule_Validate(t *testing.T) {
+	high, low := Money(50000), Money(10000)
+
+	tests := []struct {
+		name      string
+		rule      CategoryRule
+		wantErr   bool
+		wantMatch string
+	}{
+		{
+			name:      "contains by default",
+			rule:      CategoryRule{UserID: 1, Pattern: " Yandex Go ", Category: "taxi"},
+			wantMatch: MatchContains,
+		},
+		{
+			name:      "regex",
+			rule:      CategoryRule{UserID: 1, MatchType: MatchRegex, Pattern: `^(uber|bolt)\b`, Category: "taxi"},
+			wantMatch: MatchRegex,
+		},
+		{
+			name:      "amount range only",
+			rule:      CategoryRule{UserID: 1, MinAmount: &low, Category: "big purchases"},
+			wantMatch: MatchContains,
+		},
+		{
+			name:    "invalid regex",
+			rule:    CategoryRule{UserID: 1, MatchType: MatchRegex, Pattern: "(", Category: "taxi"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown match type",
+			rule:    CategoryRule{UserID: 1, MatchType: "equals", Pattern: "uber", Category: "taxi"},
+			wantErr: true,
+		},
+		{
+			name:    "no condition",
+			rule:    CategoryRule{UserID: 1, Category: "taxi"},
+			wantErr: true,
+		},
+		{
+			name:    "inverted amount range",
+			rule:    CategoryRule{UserID: 1, MinAmount: &high, MaxAmount: &low, Category: "taxi"},
+			wantErr: true,
+		},
+		{
+			name:    "missing category",
+			rule:    CategoryRule{UserID: 1, Pattern: "uber"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.rule.MatchType != tt.wantMatch {
+				t.Errorf("Validate() match type = %q, want %q", tt.rule.MatchType, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestCategoryRule_Matches(t *testing.T) {
+	low, high := Money(100000), Money(500000)
+
+	tests := []struct {
+		name string
+		rule CategoryRule
+		tx   Transaction
+		want bool
+	}{
+		{
+			name: "contains is case-insensitive",
+			rule: CategoryRule{Pattern: "yandex go"},
+			tx:   Transaction{Description: "Поездка YANDEX GO Москва", Amount: 45000},
+			want: true,
+		},
+		{
+			name: "contains miss",
+			rule: CategoryRule{Pattern: "yandex go"},
+			tx:   Transaction{Description: "Yandex Lavka", Amount: 45000},
+			want: false,
+		},
+		{
+			name: "regex",
+			rule: CategoryRule{MatchType: MatchRegex, Pattern: `^(uber|bolt)\b`},
+			tx:   Transaction{Description: "Bolt ride", Amount: 45000},
+			want: true,
+		},
+		{
+			name: "amount inside range",
+			rule: CategoryRule{MinAmount: &low, MaxAmount: &high},
+			tx:   Transaction{Amount: 250000},
+			want: true,
+		},
+		{
+			name: "amount below range",
+			rule: CategoryRule{Pattern: "ikea", MinAmount: &low},
+			tx:   Transaction{Description: "IKEA", Amount: 99999},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Matches(&tt.tx); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyCategoryRules(t *testing.T) {
+	rules := []CategoryRule{
+		{ID: 1, Pattern: "uber eats", Category: "Food delivery", Tags: []string{"delivery"}},
+		{ID: 2, Pattern: "uber", Category: "Taxi"},
+	}
+
+	tx := Transaction{Description: "UBER EATS order", Amount: 1500, Tags: []string{"work"}}
+	rule := ApplyCategoryRules(rules, &tx)
+	if rule == nil || rule.ID != 1 {
+		t.Fatalf("ApplyCategoryRules() = %v, want rule 1", rule)
+	}
+	if tx.Category != "Food delivery" || !reflect.DeepEqual(tx.Tags, []string{"delivery", "work"}) {
+		t.Errorf("ApplyCategoryRules() category %q tags %q", tx.Category, tx.Tags)
+	}
+
+	tx = Transaction{Description: "Uber trip", Amount: 1500, Category: "Travel"}
+	if rule := ApplyCategoryRules(rules, &tx); rule != nil || tx.Category != "Travel" {
+		t.Errorf("ApplyCategoryRules() overrode explicit category: rule %v category %q", rule, tx.Category)
+	}
+
+	tx = Transaction{Description: "Coffee", Amount: 1500}
+	if rule := ApplyCategoryRules(rules, &tx); rule != nil || tx.Category != "" {
+		t.Errorf("ApplyCategoryRules() = %v, want no match", rule)
+	}
+}
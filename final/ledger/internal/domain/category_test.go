@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestCategory_Validate(t *testing.T) {
+	self := int64(5)
+	tests := []struct {
+		name     string
+		category Category
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:     "valid category",
+			category: Category{UserID: 1, Name: "Food"},
+			wantName: "Food",
+		},
+		{
+			name:     "whitespace collapsed",
+			category: Category{UserID: 1, Name: "  Eating   out "},
+			wantName: "Eating out",
+		},
+		{
+			name:     "empty name",
+			category: Category{UserID: 1, Name: "   "},
+			wantErr:  true,
+		},
+		{
+			name:     "missing user",
+			category: Category{Name: "Food"},
+			wantErr:  true,
+		},
+		{
+			name:     "own parent",
+			category: Category{ID: 5, UserID: 1, Name: "Food", ParentID: &self},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.category.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.category.Name != tt.wantName {
+				t.Errorf("Validate() name = %q, want %q", tt.category.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestCategoryKey(t *testing.T) {
+	if CategoryKey(" Food ") != CategoryKey("food") {
+		t.Errorf("CategoryKey() must ignore case and surrounding spaces")
+	}
+	if CategoryKey("Еда") != CategoryKey("еда") {
+		t.Errorf("CategoryKey() must ignore case of non-latin names")
+	}
+}
+
+func testCategoryTree() []Category {
+	food, groceries := int64(1), int64(2)
+	return []Category{
+		{ID: 1, Name: "Food"},
+		{ID: 2, Name: "Groceries", ParentID: &food},
+		{ID: 3, Name: "Restaurants", ParentID: &food},
+		{ID: 4, Name: "Vegetables", ParentID: &groceries},
+		{ID: 5, Name: "Transport"},
+	}
+}
+
+func TestCreatesCycle(t *testing.T) {
+	categories := testCategoryTree()
+	tests := []struct {
+		name     string
+		id       int64
+		parentID int64
+		want     bool
+	}{
+		{name: "self", id: 1, parentID: 1, want: true},
+		{name: "direct child", id: 1, parentID: 2, want: true},
+		{name: "grandchild", id: 1, parentID: 4, want: true},
+		{name: "sibling", id: 3, parentID: 2, want: false},
+		{name: "unrelated root", id: 5, parentID: 4, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CreatesCycle(categories, tt.id, tt.parentID); got != tt.want {
+				t.Errorf("CreatesCycle(%d, %d) = %v, want %v", tt.id, tt.parentID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollUpCategories(t *testing.T) {
+	summaries := []CategorySummary{
+		{Category: "Vegetables", Total: 3000},
+		{Category: "Restaurants", Total: 5000},
+		{Category: "Groceries", Total: 1000},
+		{Category: "Transport", Total: 2000},
+		{Category: "unknown", Total: 500},
+	}
+
+	got := RollUpCategories(summaries, testCategoryTree())
+
+	want := map[string]struct {
+		total  Money
+		rollup Money
+		parent string
+	}{
+		"Food":        {total: 0, rollup: 9000},
+		"Restaurants": {total: 5000, rollup: 5000, parent: "Food"},
+		"Groceries":   {total: 1000, rollup: 4000, parent: "Food"},
+		"Vegetables":  {total: 3000, rollup: 3000, parent: "Groceries"},
+		"Transport":   {total: 2000, rollup: 2000},
+		"unknown":     {total: 500, rollup: 500},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RollUpCategories() returned %d summaries, want %d", len(got), len(want))
+	}
+	for _, s := range got {
+		w, ok := want[s.Category]
+		if !ok {
+			t.Errorf("unexpected category %q", s.Category)
+			continue
+		}
+		if s.Total != w.total || s.RollupTotal != w.rollup || s.Parent != w.parent {
+			t.Errorf("%s = {total %v, rollup %v, parent %q}, want {total %v, rollup %v, parent %q}",
+				s.Category, s.Total, s.RollupTotal, s.Parent, w.total, w.rollup, w.parent)
+		}
+	}
+	if got[0].Category != "Food" {
+		t.Errorf("first category = %q, want Food (largest roll-up)", got[0].Category)
+	}
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	BaselinePrevious = "previous"
+	BaselineYearAgo  = "year_ago"
+)
+
+const (
+	ChangeNew         = "new"
+	ChangeDisappeared = "disappeared"
+	ChangeIncreased   = "increased"
+	ChangeDecreased   = "decreased"
+	ChangeUnchanged   = "unchanged"
+)
+
+type PeriodChange struct {
+	Current      Money
+	Previous     Money
+	Delta        Money
+	DeltaPercent float64
+}
+
+type CategoryComparison struct {
+	Category string
+	Parent   string
+	Change   PeriodChange
+	Status   string
+}
+
+type Comparison struct {
+	BaseCurrency          string
+	Baseline              string
+	BaselineFrom          time.Time
+	BaselineTo            time.Time
+	Categories            []CategoryComparison
+	NewCategories         []string
+	DisappearedCategories []string
+	Expenses              PeriodChange
+	Income                PeriodChange
+	NetBalance            PeriodChange
+}
+
+func NormalizeBaseline(baseline string) (string, error) {
+	switch baseline = strings.ToLower(strings.TrimSpace(baseline)); baseline {
+	case "":
+		return BaselinePrevious, nil
+	case BaselinePrevious, BaselineYearAgo:
+		return baseline, nil
+	}
+	return "", fmt.Errorf("baseline must be %s or %s", BaselinePrevious, BaselineYearAgo)
+}
+
+func BaselinePeriod(from, to time.Time, baseline string) (time.Time, time.Time, error) {
+	baseline, err := NormalizeBaseline(baseline)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, errors.New("to must not be before from")
+	}
+
+	months := 12
+	if baseline == BaselinePrevious {
+		if !wholeMonths(from, to) {
+			days := int(dateOf(to).Sub(dateOf(from)).Hours()/24) + 1
+			return from.AddDate(0, 0, -days), to.AddDate(0, 0, -days), nil
+		}
+		months = (to.Year()-from.Year())*12 + int(to.Month()-from.Month()) + 1
+	}
+
+	if wholeMonths(from, to) {
+		return shiftMonths(from, -months), shiftMonths(to.AddDate(0, 0, 1), -months).AddDate(0, 0, -1), nil
+	}
+	return shiftMonths(from, -months), shiftMonths(to, -months), nil
+}
+
+func NewPeriodChange(current, previous Money) PeriodChange {
+	change := PeriodChange{
+		Current:  current,
+		Previous: previous,
+		Delta:    current - previous,
+	}
+	if previous < 0 {
+		change.DeltaPercent = change.Delta.Percent(-previous)
+	} else {
+		change.DeltaPercent = change.Delta.Percent(previous)
+	}
+	return change
+}
+
+func CompareReports(current, previous *Report) *Comparison {
+	comparison := &Comparison{
+		BaseCurrency: current.BaseCurrency,
+		Expenses:     NewPeriodChange(current.TotalExpenses, previous.TotalExpenses),
+		Income:       NewPeriodChange(current.TotalIncome, previous.TotalIncome),
+		NetBalance:   NewPeriodChange(current.NetBalance, previous.NetBalance),
+	}
+
+	index := make(map[string]int)
+	add := func(summary CategorySummary) *CategoryComparison {
+		key := CategoryKey(summary.Category)
+		if i, ok := index[key]; ok {
+			return &comparison.Categories[i]
+		}
+		index[key] = len(comparison.Categories)
+		comparison.Categories = append(comparison.Categories, CategoryComparison{Category: summary.Category, Parent: summary.Parent})
+		return &comparison.Categories[len(comparison.Categories)-1]
+	}
+	for _, summary := range current.Categories {
+		if summary.Total != 0 {
+			add(summary).Change.Current = summary.Total
+		}
+	}
+	for _, summary := range previous.Categories {
+		if summary.Total != 0 {
+			add(summary).Change.Previous = summary.Total
+		}
+	}
+
+	for i := range comparison.Categories {
+		category := &comparison.Categories[i]
+		category.Change = NewPeriodChange(category.Change.Current, category.Change.Previous)
+		switch {
+		case category.Change.Previous == 0:
+			category.Status = ChangeNew
+			comparison.NewCategories = append(comparison.NewCategories, category.Category)
+		case category.Change.Current == 0:
+			category.Status = ChangeDisappeared
+			comparison.DisappearedCategories = append(comparison.DisappearedCategories, category.Category)
+		case category.Change.Delta > 0:
+			category.Status = ChangeIncreased
+		case category.Change.Delta < 0:
+			category.Status = ChangeDecreased
+		default:
+			category.Status = ChangeUnchanged
+		}
+	}
+
+	sort.SliceStable(comparison.Categories, func(i, j int) bool {
+		a, b := comparison.Categories[i].Change.Delta, comparison.Categories[j].Change.Delta
+		if a < 0 {
+			a = -a
+		}
+		if b < 0 {
+			b = -b
+		}
+		return a > b
+	})
+	return comparison
+}
+
+func wholeMonths(from, to time.Time) bool {
+	return from.Day() == 1 && to.AddDate(0, 0, 1).Day() == 1
+}
+
+func shiftMonths(date time.Time, months int) time.Time {
+	first := time.Date(date.Year(), date.Month()+time.Month(months), 1,
+		date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+	day := date.Day()
+	if lastDay := first.AddDate(0, 1, -1).Day(); day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+func dateOf(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBaselinePeriod(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		baseline string
+		wantFrom time.Time
+		wantTo   time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "month to previous month",
+			from:     day(2025, 3, 1),
+			to:       day(2025, 3, 31),
+			wantFrom: day(2025, 2, 1),
+			wantTo:   day(2025, 2, 28),
+		},
+		{
+			name:     "quarter to previous quarter",
+			from:     day(2025, 4, 1),
+			to:       day(2025, 6, 30),
+			baseline: "previous",
+			wantFrom: day(2025, 1, 1),
+			wantTo:   day(2025, 3, 31),
+		},
+		{
+			name:     "days to same number of days before",
+			from:     day(2025, 3, 10),
+			to:       day(2025, 3, 16),
+			wantFrom: day(2025, 3, 3),
+			wantTo:   day(2025, 3, 9),
+		},
+		{
+			name:     "month a year ago",
+			from:     day(2024, 2, 1),
+			to:       day(2024, 2, 29),
+			baseline: " Year_Ago ",
+			wantFrom: day(2023, 2, 1),
+			wantTo:   day(2023, 2, 28),
+		},
+		{
+			name:     "days a year ago",
+			from:     day(2024, 2, 20),
+			to:       day(2024, 2, 29),
+			baseline: "year_ago",
+			wantFrom: day(2023, 2, 20),
+			wantTo:   day(2023, 2, 28),
+		},
+		{
+			name:     "end of day kept",
+			from:     day(2025, 3, 1),
+			to:       time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC),
+			wantFrom: day(2025, 2, 1),
+			wantTo:   time.Date(2025, 2, 28, 23, 59, 59, 0, time.UTC),
+		},
+		{name: "to before from", from: day(2025, 3, 31), to: day(2025, 3, 1), wantErr: true},
+		{name: "unknown baseline", from: day(2025, 3, 1), to: day(2025, 3, 31), baseline: "budget", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := BaselinePeriod(tt.from, tt.to, tt.baseline)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BaselinePeriod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !from.Equal(tt.wantFrom) || !to.Equal(tt.wantTo) {
+				t.Errorf("BaselinePeriod() = %v - %v, want %v - %v", from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
+
+func TestNewPeriodChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  Money
+		previous Money
+		want     PeriodChange
+	}{
+		{name: "growth", current: 15000, previous: 10000, want: PeriodChange{Current: 15000, Previous: 10000, Delta: 5000, DeltaPercent: 50}},
+		{name: "drop", current: 2500, previous: 10000, want: PeriodChange{Current: 2500, Previous: 10000, Delta: -7500, DeltaPercent: -75}},
+		{name: "from zero", current: 10000, want: PeriodChange{Current: 10000, Delta: 10000}},
+		{name: "negative baseline", current: 5000, previous: -10000, want: PeriodChange{Current: 5000, Previous: -10000, Delta: 15000, DeltaPercent: 150}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPeriodChange(tt.current, tt.previous); got != tt.want {
+				t.Errorf("NewPeriodChange(%v, %v) = %+v, want %+v", tt.current, tt.previous, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareReports(t *testing.T) {
+	current := &Report{
+		BaseCurrency:  "RUB",
+		TotalExpenses: 60000,
+		TotalIncome:   100000,
+		NetBalance:    40000,
+		Categories: []CategorySummary{
+			{Category: "groceries", Total: 30000},
+			{Category: "Cafe", Parent: "food", Total: 20000},
+			{Category: "taxi", Total: 10000},
+			{Category: "food", RollupTotal: 20000},
+		},
+	}
+	previous := &Report{
+		BaseCurrency:  "RUB",
+		TotalExpenses: 50000,
+		TotalIncome:   100000,
+		NetBalance:    50000,
+		Categories: []CategorySummary{
+			{Category: "groceries", Total: 30000},
+			{Category: "cafe", Parent: "food", Total: 5000},
+			{Category: "cinema", Total: 15000},
+		},
+	}
+
+	comparison := CompareReports(current, previous)
+
+	if comparison.Expenses.Delta != 10000 || comparison.Expenses.DeltaPercent != 20 {
+		t.Errorf("Expenses = %+v", comparison.Expenses)
+	}
+	if comparison.NetBalance.Delta != -10000 {
+		t.Errorf("NetBalance = %+v", comparison.NetBalance)
+	}
+
+	want := []CategoryComparison{
+		{Category: "Cafe", Parent: "food", Status: ChangeIncreased, Change: PeriodChange{Current: 20000, Previous: 5000, Delta: 15000, DeltaPercent: 300}},
+		{Category: "cinema", Status: ChangeDisappeared, Change: PeriodChange{Previous: 15000, Delta: -15000, DeltaPercent: -100}},
+		{Category: "taxi", Status: ChangeNew, Change: PeriodChange{Current: 10000, Delta: 10000}},
+		{Category: "groceries", Status: ChangeUnchanged, Change: PeriodChange{Current: 30000, Previous: 30000}},
+	}
+	if !reflect.DeepEqual(comparison.Categories, want) {
+		t.Errorf("Categories = %+v, want %+v", comparison.Categories, want)
+	}
+	if !reflect.DeepEqual(comparison.NewCategories, []string{"taxi"}) {
+		t.Errorf("NewCategories = %v", comparison.NewCategories)
+	}
+	if !reflect.DeepEqual(comparison.DisappearedCategories, []string{"cinema"}) {
+		t.Errorf("DisappearedCategories = %v", comparison.DisappearedCategories)
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const DefaultCurrency = "RUB"
+
+var ErrExchangeRateNotFound = errors.New("exchange rate not found")
+
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", errors.New("currency must be a 3-letter ISO 4217 code")
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", errors.New("currency must be a 3-letter ISO 4217 code")
+		}
+	}
+	return code, nil
+}
+
+type ExchangeRate struct {
+	UserID        int64
+	BaseCurrency  string
+	QuoteCurrency string
+	Date          time.Time
+	Rate          float64
+}
+
+func (r *ExchangeRate) Validate() error {
+	if r.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	base, err := NormalizeCurrency(r.BaseCurrency)
+	if err != nil {
+		return err
+	}
+	quote, err := NormalizeCurrency(r.QuoteCurrency)
+	if err != nil {
+		return err
+	}
+	if base == quote {
+		return errors.New("base and quote currencies must differ")
+	}
+	if r.Rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if r.Date.IsZero() {
+		return errors.New("date is required")
+	}
+	r.BaseCurrency, r.QuoteCurrency = base, quote
+	return nil
+}
+
+type UserSettings struct {
+	UserID       int64
+	BaseCurrency string
+}
+
+type CurrencyTotal struct {
+	Currency  string
+	Amount    Money
+	Converted Money
+}
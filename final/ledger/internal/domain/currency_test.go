@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalizeCurrency(t *testing.T) {
+	tests := []struct {
+		code    string
+		want    string
+		wantErr bool
+	}{
+		{code: "RUB", want: "RUB"},
+		{code: " usd ", want: "USD"},
+		{code: "eur", want: "EUR"},
+		{code: "", wantErr: true},
+		{code: "RU", wantErr: true},
+		{code: "RUBL", wantErr: true},
+		{code: "R1B", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizeCurrency(tt.code)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NormalizeCurrency(%q) error = %v, wantErr %v", tt.code, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeCurrency(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestExchangeRate_Validate(t *testing.T) {
+	date := time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		rate    ExchangeRate
+		wantErr bool
+	}{
+		{
+			name:    "valid rate",
+			rate:    ExchangeRate{UserID: 1, BaseCurrency: "usd", QuoteCurrency: "rub", Date: date, Rate: 100.5},
+			wantErr: false,
+		},
+		{
+			name:    "same currencies",
+			rate:    ExchangeRate{UserID: 1, BaseCurrency: "RUB", QuoteCurrency: "RUB", Date: date, Rate: 1},
+			wantErr: true,
+		},
+		{
+			name:    "zero rate",
+			rate:    ExchangeRate{UserID: 1, BaseCurrency: "USD", QuoteCurrency: "RUB", Date: date},
+			wantErr: true,
+		},
+		{
+			name:    "missing date",
+			rate:    ExchangeRate{UserID: 1, BaseCurrency: "USD", QuoteCurrency: "RUB", Rate: 100},
+			wantErr: true,
+		},
+		{
+			name:    "zero user_id",
+			rate:    ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "RUB", Date: date, Rate: 100},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rate.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
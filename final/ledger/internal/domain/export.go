@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	FormatJSONL = "jsonl"
+	FormatXLSX  = "xlsx"
+)
+
+const (
+	ColumnID        = "id"
+	ColumnAccountID = "account_id"
+)
+
+var exportColumns = append(append([]string{ColumnID}, importColumns...), ColumnAccountID)
+
+func NormalizeExportFormat(format string) (string, error) {
+	switch format = strings.ToLower(strings.TrimSpace(format)); format {
+	case "":
+		return FormatCSV, nil
+	case FormatCSV, FormatJSONL, FormatXLSX, FormatOFX:
+		return format, nil
+	}
+	return "", errors.New("format must be csv, jsonl, xlsx or ofx")
+}
+
+func NormalizeExportColumns(columns []string) ([]string, error) {
+	if len(columns) == 0 {
+		return append([]string(nil), importColumns...), nil
+	}
+
+	normalized := make([]string, 0, len(columns))
+	seen := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !isExportColumn(column) {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("column %q listed twice", column)
+		}
+		seen[column] = true
+		normalized = append(normalized, column)
+	}
+	return normalized, nil
+}
+
+func isExportColumn(column string) bool {
+	for _, known := range exportColumns {
+		if column == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeExportFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{format: "", want: FormatCSV},
+		{format: "JSONL", want: FormatJSONL},
+		{format: " xlsx ", want: FormatXLSX},
+		{format: "ofx", want: FormatOFX},
+		{format: "qif", wantErr: true},
+		{format: "pdf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := NormalizeExportFormat(tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeExportFormat(%q) error = %v, wantErr %v", tt.format, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeExportFormat(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeExportColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "default matches import layout",
+			want: []string{"amount", "category", "description", "date", "kind", "currency", "tags"},
+		},
+		{
+			name:    "selected order kept",
+			columns: []string{" Date ", "amount", "ID", "account_id"},
+			want:    []string{"date", "amount", "id", "account_id"},
+		},
+		{name: "unknown column", columns: []string{"date", "balance"}, wantErr: true},
+		{name: "empty column", columns: []string{"date", ""}, wantErr: true},
+		{name: "duplicate column", columns: []string{"date", "DATE"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeExportColumns(tt.columns)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeExportColumns(%v) error = %v, wantErr %v", tt.columns, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeExportColumns(%v) = %v, want %v", tt.columns, got, tt.want)
+			}
+		})
+	}
+}
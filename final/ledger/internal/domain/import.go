@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DuplicateSkip  = "skip"
+	DuplicateForce = "force"
+	DuplicateFlag  = "flag"
+)
+
+const (
+	FormatCSV = "csv"
+	FormatOFX = "ofx"
+	FormatQIF = "qif"
+)
+
+const (
+	RowReady     = "ready"
+	RowImported  = "imported"
+	RowDuplicate = "duplicate"
+	RowFailed    = "failed"
+)
+
+var ErrDuplicateTransaction = errors.New("transaction already imported")
+
+type ImportOptions struct {
+	DuplicateMode string
+	Preview       bool
+	Atomic        bool
+}
+
+type ImportRow struct {
+	Line        int
+	ExternalID  string
+	Transaction Transaction
+	Status      string
+	Error       string
+	Warning     string
+	DateError   string
+	ExistingID  int64
+	Probable    bool
+}
+
+type BudgetImpact struct {
+	Category    string
+	Enforcement string
+	Currency    string
+	PeriodFrom  time.Time
+	PeriodTo    time.Time
+	Limit       Money
+	Spent       Money
+	Incoming    Money
+}
+
+type ImportResult struct {
+	Rows         []ImportRow
+	Imported     int
+	BudgetImpact []BudgetImpact
+	RolledBack   bool
+}
+
+func (r *ImportRow) Fail(err error) {
+	r.Status = RowFailed
+	r.Error = err.Error()
+}
+
+func (r *ImportRow) Categorize(rules []CategoryRule) {
+	ApplyCategoryRules(rules, &r.Transaction)
+	if r.Transaction.Category == "" && len(r.Transaction.Splits) == 0 {
+		r.Fail(errors.New("empty category and no matching rule"))
+		return
+	}
+	if err := r.Transaction.Validate(); err != nil {
+		r.Fail(err)
+	}
+}
+
+func RequireDates(rows []ImportRow) {
+	for i := range rows {
+		if rows[i].Status != RowReady || rows[i].DateError == "" {
+			continue
+		}
+		rows[i].Warning = ""
+		rows[i].Fail(errors.New(rows[i].DateError))
+	}
+}
+
+func (b BudgetImpact) Exceeded() bool {
+	return b.Spent+b.Incoming > b.Limit
+}
+
+func (r *ImportResult) Duplicates() []ImportRow {
+	var duplicates []ImportRow
+	for _, row := range r.Rows {
+		if row.ExistingID != 0 {
+			duplicates = append(duplicates, row)
+		}
+	}
+	return duplicates
+}
+
+func (r *ImportResult) Failed() int {
+	failed := 0
+	for _, row := range r.Rows {
+		if row.Status == RowFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+func (r *ImportResult) Skipped() int {
+	skipped := 0
+	for _, row := range r.Rows {
+		if row.Status == RowFailed || (r.RolledBack && row.Status == RowReady) {
+			skipped++
+		}
+	}
+	return skipped
+}
+
+func (r *ImportResult) Errors() []string {
+	var messages []string
+	for _, row := range r.Rows {
+		if row.Error != "" {
+			messages = append(messages, fmt.Sprintf("row %d: %s", row.Line, row.Error))
+		}
+		if row.Warning != "" {
+			messages = append(messages, fmt.Sprintf("row %d: %s", row.Line, row.Warning))
+		}
+	}
+	return messages
+}
+
+func NormalizeDuplicateMode(mode string) (string, error) {
+	switch mode = strings.ToLower(strings.TrimSpace(mode)); mode {
+	case "":
+		return DuplicateSkip, nil
+	case DuplicateSkip, DuplicateForce, DuplicateFlag:
+		return mode, nil
+	}
+	return "", errors.New("duplicate_mode must be skip, force or flag")
+}
+
+func NormalizeImportFormat(format string) (string, error) {
+	switch format = strings.ToLower(strings.TrimSpace(format)); format {
+	case "":
+		return FormatCSV, nil
+	case FormatCSV, FormatOFX, FormatQIF:
+		return format, nil
+	case "qfx":
+		return FormatOFX, nil
+	}
+	return "", errors.New("format must be csv, ofx or qif")
+}
+
+func DuplicateKey(tx *Transaction) string {
+	description := strings.Join(strings.Fields(strings.ToLower(tx.Description)), " ")
+	return tx.Date.Format("2006-01-02") + "|" + tx.Kind + "|" + tx.Amount.String() + "|" + tx.Currency + "|" + description
+}
+
+func AssignFingerprints(rows []ImportRow) {
+	seen := make(map[string]int)
+	for i := range rows {
+		if rows[i].Status != RowReady {
+			continue
+		}
+		key := DuplicateKey(&rows[i].Transaction)
+		if rows[i].ExternalID != "" {
+			key = "id|" + rows[i].ExternalID
+		}
+		seen[key]++
+		sum := sha256.Sum256([]byte(key + "|" + strconv.Itoa(seen[key])))
+		rows[i].Transaction.Fingerprint = hex.EncodeToString(sum[:])
+	}
+}
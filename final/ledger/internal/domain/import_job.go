@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobCompleted = "completed"
+	JobFailed    = "failed"
+	JobCanceled  = "canceled"
+)
+
+const ImportJobBatchSize = 500
+
+type ImportJob struct {
+	ID            int64
+	UserID        int64
+	Status        string
+	Format        string
+	Profile       string
+	DuplicateMode string
+	Atomic        bool
+	Data          []byte
+	TotalRows     int
+	ProcessedRows int
+	ImportedRows  int
+	DuplicateRows int
+	FailedRows    int
+	Error         string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+}
+
+type ImportJobError struct {
+	Line    int
+	Message string
+}
+
+func (j *ImportJob) Validate() error {
+	if j.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	if len(j.Data) == 0 {
+		return errors.New("data is required")
+	}
+	format, err := NormalizeImportFormat(j.Format)
+	if err != nil {
+		return err
+	}
+	if format != FormatCSV && j.Profile != "" {
+		return errors.New("profile applies to csv format only")
+	}
+	mode, err := NormalizeDuplicateMode(j.DuplicateMode)
+	if err != nil {
+		return err
+	}
+	j.Format = format
+	j.DuplicateMode = mode
+	return nil
+}
+
+func (j *ImportJob) Finished() bool {
+	return j.Status == JobCompleted || j.Status == JobFailed || j.Status == JobCanceled
+}
+
+func (j *ImportJob) Record(result *ImportResult) []ImportJobError {
+	j.ProcessedRows += len(result.Rows)
+	j.ImportedRows += result.Imported
+	j.DuplicateRows += len(result.Duplicates())
+	j.FailedRows += result.Failed()
+
+	var rowErrors []ImportJobError
+	for _, row := range result.Rows {
+		if row.Error != "" {
+			rowErrors = append(rowErrors, ImportJobError{Line: row.Line, Message: row.Error})
+		}
+		if row.Warning != "" {
+			rowErrors = append(rowErrors, ImportJobError{Line: row.Line, Message: row.Warning})
+		}
+	}
+	return rowErrors
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestImportJob_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		job        ImportJob
+		wantFormat string
+		wantMode   string
+		wantErr    bool
+	}{
+		{name: "defaults", job: ImportJob{UserID: 1, Data: []byte("350,cafe")}, wantFormat: FormatCSV, wantMode: DuplicateSkip},
+		{name: "qfx as ofx", job: ImportJob{UserID: 1, Data: []byte("<OFX>"), Format: "QFX", DuplicateMode: "flag"}, wantFormat: FormatOFX, wantMode: DuplicateFlag},
+		{name: "csv profile", job: ImportJob{UserID: 1, Data: []byte("x"), Profile: "Сбербанк"}, wantFormat: FormatCSV, wantMode: DuplicateSkip},
+		{name: "missing user", job: ImportJob{Data: []byte("x")}, wantErr: true},
+		{name: "empty data", job: ImportJob{UserID: 1}, wantErr: true},
+		{name: "unknown format", job: ImportJob{UserID: 1, Data: []byte("x"), Format: "xlsx"}, wantErr: true},
+		{name: "profile for qif", job: ImportJob{UserID: 1, Data: []byte("x"), Format: "qif", Profile: "Банк"}, wantErr: true},
+		{name: "unknown duplicate mode", job: ImportJob{UserID: 1, Data: []byte("x"), DuplicateMode: "merge"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.job.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.job.Format != tt.wantFormat || tt.job.DuplicateMode != tt.wantMode {
+				t.Errorf("Validate() format %q, mode %q, want %q, %q", tt.job.Format, tt.job.DuplicateMode, tt.wantFormat, tt.wantMode)
+			}
+		})
+	}
+}
+
+func TestImportJob_Record(t *testing.T) {
+	job := ImportJob{ProcessedRows: 500, ImportedRows: 480, FailedRows: 20}
+	result := &ImportResult{
+		Imported: 2,
+		Rows: []ImportRow{
+			{Line: 502, Status: RowImported},
+			{Line: 503, Status: RowFailed, Error: "invalid amount 'abc'"},
+			{Line: 504, Status: RowDuplicate, ExistingID: 17},
+			{Line: 505, Status: RowImported, Warning: "invalid date '31.02', using today"},
+		},
+	}
+
+	rowErrors := job.Record(result)
+
+	if job.ProcessedRows != 504 || job.ImportedRows != 482 || job.DuplicateRows != 1 || job.FailedRows != 21 {
+		t.Errorf("Record() counters = %d processed, %d imported, %d duplicates, %d failed",
+			job.ProcessedRows, job.ImportedRows, job.DuplicateRows, job.FailedRows)
+	}
+	want := []ImportJobError{
+		{Line: 503, Message: "invalid amount 'abc'"},
+		{Line: 505, Message: "invalid date '31.02', using today"},
+	}
+	if !reflect.DeepEqual(rowErrors, want) {
+		t.Errorf("Record() = %v, want %v", rowErrors, want)
+	}
+}
+
+func TestImportJob_Finished(t *testing.T) {
+	for status, want := range map[string]bool{
+		JobQueued:    false,
+		JobRunning:   false,
+		JobCompleted: true,
+		JobFailed:    true,
+		JobCanceled:  true,
+	} {
+		job := ImportJob{Status: status}
+		if got := job.Finished(); got != want {
+			t.Errorf("Finished() for %q = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	ColumnAmount      = "amount"
+	ColumnCategory    = "category"
+	ColumnDescription = "description"
+	ColumnDate        = "date"
+	ColumnKind        = "kind"
+	ColumnCurrency    = "currency"
+	ColumnTags        = "tags"
+)
+
+const (
+	SignPositive        = "positive"
+	SignNegativeExpense = "negative_expense"
+	SignNegativeIncome  = "negative_income"
+)
+
+const (
+	EncodingUTF8        = "utf-8"
+	EncodingWindows1251 = "windows-1251"
+	EncodingKOI8R       = "koi8-r"
+)
+
+const (
+	DefaultDateLayout = "2006-01-02"
+
+	maxProfileNameLength = 100
+	maxDateLayouts       = 10
+	maxColumnIndex       = 100
+	maxColumnNameLength  = 100
+)
+
+var ErrDuplicateImportProfile = errors.New("import profile with this name already exists")
+
+var importColumns = []string{ColumnAmount, ColumnCategory, ColumnDescription, ColumnDate, ColumnKind, ColumnCurrency, ColumnTags}
+
+var columnHeaders = map[string][]string{
+	ColumnAmount:      {"amount", "sum", "сумма", "сумма операции"},
+	ColumnCategory:    {"category", "категория"},
+	ColumnDescription: {"description", "описание"},
+	ColumnDate:        {"date", "дата", "дата операции"},
+	ColumnKind:        {"kind", "type", "тип"},
+	ColumnCurrency:    {"currency", "валюта"},
+	ColumnTags:        {"tags", "теги"},
+}
+
+type ImportProfile struct {
+	ID             int64
+	UserID         int64
+	Name           string
+	Delimiter      string
+	DecimalComma   bool
+	DateLayouts    []string
+	Encoding       string
+	SignConvention string
+	Columns        map[string]string
+	CreatedAt      time.Time
+}
+
+func DefaultImportProfile() *ImportProfile {
+	profile := &ImportProfile{}
+	profile.applyDefaults()
+	return profile
+}
+
+func (p *ImportProfile) applyDefaults() {
+	if p.Delimiter == "" {
+		p.Delimiter = ","
+	}
+	if len(p.DateLayouts) == 0 {
+		p.DateLayouts = []string{DefaultDateLayout}
+	}
+	if p.Encoding == "" {
+		p.Encoding = EncodingUTF8
+	}
+	if p.SignConvention == "" {
+		p.SignConvention = SignPositive
+	}
+	if len(p.Columns) == 0 {
+		p.Columns = make(map[string]string, len(importColumns))
+		for i, column := range importColumns {
+			p.Columns[column] = strconv.Itoa(i + 1)
+		}
+	}
+}
+
+func (p *ImportProfile) Validate() error {
+	if p.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	p.Name = NormalizeCategoryName(p.Name)
+	if p.Name == "" {
+		return errors.New("name is required")
+	}
+	if utf8.RuneCountInString(p.Name) > maxProfileNameLength {
+		return errors.New("name must be at most 100 characters")
+	}
+
+	if p.Delimiter == `\t` || strings.EqualFold(p.Delimiter, "tab") {
+		p.Delimiter = "\t"
+	}
+	p.Encoding = strings.ToLower(strings.TrimSpace(p.Encoding))
+	p.SignConvention = strings.ToLower(strings.TrimSpace(p.SignConvention))
+
+	layouts := make([]string, 0, len(p.DateLayouts))
+	for _, layout := range p.DateLayouts {
+		if layout = strings.TrimSpace(layout); layout != "" {
+			layouts = append(layouts, layout)
+		}
+	}
+	p.DateLayouts = layouts
+
+	columns := make(map[string]string, len(p.Columns))
+	for column, ref := range p.Columns {
+		columns[strings.ToLower(strings.TrimSpace(column))] = strings.TrimSpace(ref)
+	}
+	p.Columns = columns
+
+	p.applyDefaults()
+
+	delimiter, size := utf8.DecodeRuneInString(p.Delimiter)
+	if size != len(p.Delimiter) || delimiter == utf8.RuneError || delimiter == '"' || delimiter == '\r' || delimiter == '\n' {
+		return errors.New("delimiter must be a single character other than quote or newline")
+	}
+	if p.DecimalComma && delimiter == ',' {
+		return errors.New("decimal_comma requires a delimiter other than comma")
+	}
+
+	switch p.Encoding {
+	case EncodingUTF8, EncodingWindows1251, EncodingKOI8R:
+	default:
+		return errors.New("encoding must be utf-8, windows-1251 or koi8-r")
+	}
+
+	switch p.SignConvention {
+	case SignPositive, SignNegativeExpense, SignNegativeIncome:
+	default:
+		return errors.New("sign_convention must be positive, negative_expense or negative_income")
+	}
+
+	if len(p.DateLayouts) > maxDateLayouts {
+		return errors.New("at most 10 date layouts allowed")
+	}
+	reference := time.Date(2025, time.November, 23, 0, 0, 0, 0, time.UTC)
+	for _, layout := range p.DateLayouts {
+		parsed, err := time.Parse(layout, reference.Format(layout))
+		if err != nil || !parsed.Equal(reference) {
+			return fmt.Errorf("date layout %q must contain year, month and day", layout)
+		}
+	}
+
+	for column, ref := range p.Columns {
+		if !isImportColumn(column) {
+			return fmt.Errorf("unknown column %q", column)
+		}
+		if ref == "" {
+			return fmt.Errorf("column %q must reference a header name or index", column)
+		}
+		if index, err := strconv.Atoi(ref); err == nil {
+			if index < 1 || index > maxColumnIndex {
+				return fmt.Errorf("column %q index must be between 1 and 100", column)
+			}
+		} else if utf8.RuneCountInString(ref) > maxColumnNameLength {
+			return fmt.Errorf("column %q header name must be at most 100 characters", column)
+		}
+	}
+	if _, ok := p.Columns[ColumnAmount]; !ok {
+		return errors.New("amount column is required")
+	}
+	return nil
+}
+
+func (p *ImportProfile) Comma() rune {
+	delimiter, _ := utf8.DecodeRuneInString(p.Delimiter)
+	return delimiter
+}
+
+func (p *ImportProfile) ColumnPositions(first []string) (map[string]int, bool, error) {
+	positions := make(map[string]int, len(p.Columns))
+	byName := false
+	for column, ref := range p.Columns {
+		if index, err := strconv.Atoi(ref); err == nil {
+			positions[column] = index - 1
+			continue
+		}
+		byName = true
+		index := headerIndex(first, ref)
+		if index < 0 {
+			return nil, false, fmt.Errorf("column %q not found in header", ref)
+		}
+		positions[column] = index
+	}
+	if byName {
+		return positions, true, nil
+	}
+
+	for column, index := range positions {
+		if index < len(first) && isColumnHeader(column, first[index]) {
+			return positions, true, nil
+		}
+	}
+	return positions, false, nil
+}
+
+func (p *ImportProfile) ParseAmount(value string) (Money, string, error) {
+	value = strings.TrimSpace(value)
+	if p.DecimalComma {
+		value = strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "", ".", "", ",", ".").Replace(value)
+	}
+	amount, err := ParseMoney(value)
+	if err != nil {
+		return 0, "", err
+	}
+
+	switch p.SignConvention {
+	case SignNegativeExpense:
+		if amount < 0 {
+			return -amount, KindExpense, nil
+		}
+		return amount, KindIncome, nil
+	case SignNegativeIncome:
+		if amount < 0 {
+			return -amount, KindIncome, nil
+		}
+		return amount, KindExpense, nil
+	}
+	return amount, "", nil
+}
+
+func (p *ImportProfile) ParseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range p.DateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("date %q does not match any layout", value)
+}
+
+func isImportColumn(column string) bool {
+	for _, known := range importColumns {
+		if column == known {
+			return true
+		}
+	}
+	return false
+}
+
+func headerIndex(header []string, name string) int {
+	for i, cell := range header {
+		if headerCellIs(cell, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isColumnHeader(column, cell string) bool {
+	for _, name := range columnHeaders[column] {
+		if headerCellIs(cell, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func headerCellIs(cell, name string) bool {
+	return strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(cell, "\ufeff")), name)
+}
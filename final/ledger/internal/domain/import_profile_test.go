@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestImportProfile_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile ImportProfile
+		wantErr bool
+	}{
+		{name: "defaults", profile: ImportProfile{UserID: 1, Name: "Банк"}},
+		{
+			name: "bank statement",
+			profile: ImportProfile{
+				UserID: 1, Name: "Сбер", Delimiter: ";", DecimalComma: true, DateLayouts: []string{"02.01.2006"},
+				Encoding: "Windows-1251", SignConvention: "negative_expense",
+				Columns: map[string]string{"Amount": "Сумма", "description": "Описание", "date": "1"},
+			},
+		},
+		{name: "tab alias", profile: ImportProfile{UserID: 1, Name: "tsv", Delimiter: "tab"}},
+		{name: "missing user", profile: ImportProfile{Name: "Банк"}, wantErr: true},
+		{name: "missing name", profile: ImportProfile{UserID: 1, Name: "  "}, wantErr: true},
+		{name: "multi-character delimiter", profile: ImportProfile{UserID: 1, Name: "x", Delimiter: ";;"}, wantErr: true},
+		{name: "quote delimiter", profile: ImportProfile{UserID: 1, Name: "x", Delimiter: `"`}, wantErr: true},
+		{name: "decimal comma with comma delimiter", profile: ImportProfile{UserID: 1, Name: "x", DecimalComma: true}, wantErr: true},
+		{name: "unknown encoding", profile: ImportProfile{UserID: 1, Name: "x", Encoding: "latin-1"}, wantErr: true},
+		{name: "unknown sign convention", profile: ImportProfile{UserID: 1, Name: "x", SignConvention: "inverted"}, wantErr: true},
+		{name: "layout without day", profile: ImportProfile{UserID: 1, Name: "x", DateLayouts: []string{"01.2006"}}, wantErr: true},
+		{name: "unknown column", profile: ImportProfile{UserID: 1, Name: "x", Columns: map[string]string{"amount": "1", "memo": "2"}}, wantErr: true},
+		{name: "zero index", profile: ImportProfile{UserID: 1, Name: "x", Columns: map[string]string{"amount": "0"}}, wantErr: true},
+		{name: "missing amount column", profile: ImportProfile{UserID: 1, Name: "x", Columns: map[string]string{"category": "2"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.profile.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestImportProfile_ValidateNormalizes(t *testing.T) {
+	profile := ImportProfile{
+		UserID: 1, Name: " Сбер ", Delimiter: `\t`, Encoding: " KOI8-R ",
+		Columns: map[string]string{" Amount ": " Сумма "},
+	}
+	if err := profile.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	if profile.Name != "Сбер" || profile.Delimiter != "\t" || profile.Encoding != EncodingKOI8R {
+		t.Errorf("Validate() name %q, delimiter %q, encoding %q", profile.Name, profile.Delimiter, profile.Encoding)
+	}
+	if profile.SignConvention != SignPositive {
+		t.Errorf("SignConvention = %q, want %q", profile.SignConvention, SignPositive)
+	}
+	if !reflect.DeepEqual(profile.DateLayouts, []string{DefaultDateLayout}) {
+		t.Errorf("DateLayouts = %v, want default", profile.DateLayouts)
+	}
+	if !reflect.DeepEqual(profile.Columns, map[string]string{"amount": "Сумма"}) {
+		t.Errorf("Columns = %v", profile.Columns)
+	}
+}
+
+func TestImportProfile_ParseAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  ImportProfile
+		value    string
+		want     Money
+		wantKind string
+		wantErr  bool
+	}{
+		{name: "default", profile: *DefaultImportProfile(), value: "1500.50", want: 150050},
+		{name: "default rejects comma", profile: *DefaultImportProfile(), value: "1500,50", wantErr: true},
+		{name: "decimal comma", profile: ImportProfile{DecimalComma: true}, value: "1 500,50", want: 150050},
+		{name: "decimal comma with dots", profile: ImportProfile{DecimalComma: true}, value: "1.500,5", want: 150050},
+		{name: "decimal comma with nbsp", profile: ImportProfile{DecimalComma: true}, value: "12\u00a0000", want: 1200000},
+		{name: "negative expense", profile: ImportProfile{SignConvention: SignNegativeExpense}, value: "-350.00", want: 35000, wantKind: KindExpense},
+		{name: "positive income", profile: ImportProfile{SignConvention: SignNegativeExpense}, value: "+90000", want: 9000000, wantKind: KindIncome},
+		{name: "card statement charge", profile: ImportProfile{SignConvention: SignNegativeIncome}, value: "350", want: 35000, wantKind: KindExpense},
+		{name: "card statement payment", profile: ImportProfile{SignConvention: SignNegativeIncome}, value: "-1000", want: 100000, wantKind: KindIncome},
+		{name: "not a number", profile: *DefaultImportProfile(), value: "Сумма", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, kind, err := tt.profile.ParseAmount(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAmount(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want || kind != tt.wantKind {
+				t.Errorf("ParseAmount(%q) = %v, %q, want %v, %q", tt.value, got, kind, tt.want, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestImportProfile_ParseDate(t *testing.T) {
+	profile := ImportProfile{DateLayouts: []string{"02.01.2006", DefaultDateLayout}}
+	want := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{"14.03.2025", "2025-03-14", " 14.03.2025 "} {
+		got, err := profile.ParseDate(value)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v", value, got, err, want)
+		}
+	}
+	if _, err := profile.ParseDate("03/14/2025"); err == nil {
+		t.Error("ParseDate(03/14/2025) expected error")
+	}
+}
+
+func TestImportProfile_ColumnPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    ImportProfile
+		first      []string
+		want       map[string]int
+		wantHeader bool
+		wantErr    bool
+	}{
+		{
+			name:       "default with header",
+			profile:    ImportProfile{Columns: map[string]string{"amount": "1", "category": "2"}},
+			first:      []string{"Сумма", "Категория"},
+			want:       map[string]int{"amount": 0, "category": 1},
+			wantHeader: true,
+		},
+		{
+			name:    "default without header",
+			profile: ImportProfile{Columns: map[string]string{"amount": "1", "category": "2"}},
+			first:   []string{"350.00", "Кафе"},
+			want:    map[string]int{"amount": 0, "category": 1},
+		},
+		{
+			name:       "default with header by other column",
+			profile:    ImportProfile{Columns: map[string]string{"amount": "2", "date": "1"}},
+			first:      []string{"Date", "Итого"},
+			want:       map[string]int{"amount": 1, "date": 0},
+			wantHeader: true,
+		},
+		{
+			name:    "malformed first data row is not a header",
+			profile: ImportProfile{Columns: map[string]string{"amount": "1", "category": "2"}},
+			first:   []string{"35O.00", "Кафе"},
+			want:    map[string]int{"amount": 0, "category": 1},
+		},
+		{
+			name:       "by header name",
+			profile:    ImportProfile{Columns: map[string]string{"amount": "сумма операции", "date": "1"}},
+			first:      []string{"\ufeffДата", " Сумма операции ", "Описание"},
+			want:       map[string]int{"amount": 1, "date": 0},
+			wantHeader: true,
+		},
+		{
+			name:    "header name missing",
+			profile: ImportProfile{Columns: map[string]string{"amount": "Сумма"}},
+			first:   []string{"Дата", "Итого"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, header, err := tt.profile.ColumnPositions(tt.first)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ColumnPositions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) || header != tt.wantHeader {
+				t.Errorf("ColumnPositions() = %v, %v, want %v, %v", got, header, tt.want, tt.wantHeader)
+			}
+		})
+	}
+}
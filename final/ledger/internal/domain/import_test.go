@@ -0,0 +1,316 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNormalizeDuplicateMode(t *testing.T) {
+	tests := []struct {
+		mode    string
+		want    string
+		wantErr bool
+	}{
+		{mode: "", want: DuplicateSkip},
+		{mode: "skip", want: DuplicateSkip},
+		{mode: " Force ", want: DuplicateForce},
+		{mode: "flag", want: DuplicateFlag},
+		{mode: "merge", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			got, err := NormalizeDuplicateMode(tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeDuplicateMode(%q) error = %v, wantErr %v", tt.mode, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeDuplicateMode(%q) = %q, want %q", tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeImportFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{format: "", want: FormatCSV},
+		{format: "CSV", want: FormatCSV},
+		{format: " ofx ", want: FormatOFX},
+		{format: "qfx", want: FormatOFX},
+		{format: "qif", want: FormatQIF},
+		{format: "xlsx", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := NormalizeImportFormat(tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeImportFormat(%q) error = %v, wantErr %v", tt.format, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeImportFormat(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicateKey(t *testing.T) {
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	base := Transaction{Kind: KindExpense, Amount: 45000, Currency: "RUB", Description: "Кофе  Хауз", Date: date}
+
+	tests := []struct {
+		name string
+		tx   Transaction
+		same bool
+	}{
+		{
+			name: "case and whitespace ignored",
+			tx:   Transaction{Kind: KindExpense, Amount: 45000, Currency: "RUB", Description: " кофе хауз ", Date: date.Add(15 * time.Hour), Category: "cafe"},
+			same: true,
+		},
+		{
+			name: "different amount",
+			tx:   Transaction{Kind: KindExpense, Amount: 45001, Currency: "RUB", Description: "Кофе Хауз", Date: date},
+		},
+		{
+			name: "different date",
+			tx:   Transaction{Kind: KindExpense, Amount: 45000, Currency: "RUB", Description: "Кофе Хауз", Date: date.AddDate(0, 0, 1)},
+		},
+		{
+			name: "different description",
+			tx:   Transaction{Kind: KindExpense, Amount: 45000, Currency: "RUB", Description: "Кофе", Date: date},
+		},
+		{
+			name: "income instead of expense",
+			tx:   Transaction{Kind: KindIncome, Amount: 45000, Currency: "RUB", Description: "Кофе Хауз", Date: date},
+		},
+		{
+			name: "different currency",
+			tx:   Transaction{Kind: KindExpense, Amount: 45000, Currency: "USD", Description: "Кофе Хауз", Date: date},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DuplicateKey(&tt.tx) == DuplicateKey(&base); got != tt.same {
+				t.Errorf("DuplicateKey(%+v) == DuplicateKey(base) = %v, want %v", tt.tx, got, tt.same)
+			}
+		})
+	}
+}
+
+func TestAssignFingerprints(t *testing.T) {
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	statement := func() []ImportRow {
+		return []ImportRow{
+			{Line: 2, Status: RowReady, Transaction: Transaction{Amount: 45000, Description: "Coffee", Date: date}},
+			{Line: 3, Status: RowFailed, Error: "invalid amount 'abc'"},
+			{Line: 4, Status: RowReady, Transaction: Transaction{Amount: 45000, Description: "coffee", Date: date}},
+			{Line: 5, Status: RowReady, Transaction: Transaction{Amount: 120000, Description: "Groceries", Date: date}},
+		}
+	}
+
+	first, again := statement(), statement()
+	AssignFingerprints(first)
+	AssignFingerprints(again)
+
+	if first[1].Transaction.Fingerprint != "" {
+		t.Error("failed row got a fingerprint")
+	}
+	if first[0].Transaction.Fingerprint == first[2].Transaction.Fingerprint {
+		t.Error("identical rows in one statement share a fingerprint")
+	}
+	for _, i := range []int{0, 2, 3} {
+		if first[i].Transaction.Fingerprint == "" {
+			t.Fatalf("row %d has no fingerprint", first[i].Line)
+		}
+		if first[i].Transaction.Fingerprint != again[i].Transaction.Fingerprint {
+			t.Errorf("row %d fingerprint changed on re-import", first[i].Line)
+		}
+	}
+}
+
+func TestAssignFingerprints_KindAndCurrency(t *testing.T) {
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	rows := []ImportRow{
+		{Line: 2, Status: RowReady, Transaction: Transaction{Kind: KindExpense, Amount: 500000, Currency: "RUB", Description: "Перевод", Date: date}},
+		{Line: 3, Status: RowReady, Transaction: Transaction{Kind: KindIncome, Amount: 500000, Currency: "RUB", Description: "Перевод", Date: date}},
+		{Line: 4, Status: RowReady, Transaction: Transaction{Kind: KindExpense, Amount: 500000, Currency: "USD", Description: "Перевод", Date: date}},
+	}
+	AssignFingerprints(rows)
+
+	income := []ImportRow{rows[1]}
+	AssignFingerprints(income)
+	if income[0].Transaction.Fingerprint != rows[1].Transaction.Fingerprint {
+		t.Error("income fingerprint depends on the expense row before it")
+	}
+	seen := make(map[string]int)
+	for _, row := range rows {
+		if line, ok := seen[row.Transaction.Fingerprint]; ok {
+			t.Errorf("rows %d and %d share a fingerprint", line, row.Line)
+		}
+		seen[row.Transaction.Fingerprint] = row.Line
+	}
+}
+
+func TestAssignFingerprints_ExternalID(t *testing.T) {
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	rows := []ImportRow{
+		{Line: 13, ExternalID: "98765:2025031401", Status: RowReady, Transaction: Transaction{Amount: 4520, Description: "COFFEE", Date: date}},
+		{Line: 21, ExternalID: "98765:2025031402", Status: RowReady, Transaction: Transaction{Amount: 4520, Description: "COFFEE", Date: date}},
+		{Line: 29, Status: RowReady, Transaction: Transaction{Amount: 4520, Description: "COFFEE", Date: date}},
+	}
+	corrected := []ImportRow{
+		{Line: 13, ExternalID: "98765:2025031401", Status: RowReady, Transaction: Transaction{Amount: 4520, Description: "COFFEE SHOP", Date: date}},
+	}
+	AssignFingerprints(rows)
+	AssignFingerprints(corrected)
+
+	if rows[0].Transaction.Fingerprint == rows[1].Transaction.Fingerprint {
+		t.Error("rows with different FITIDs share a fingerprint")
+	}
+	if rows[0].Transaction.Fingerprint == rows[2].Transaction.Fingerprint {
+		t.Error("row with FITID shares a fingerprint with a row without one")
+	}
+	if rows[0].Transaction.Fingerprint != corrected[0].Transaction.Fingerprint {
+		t.Error("fingerprint changed with description although FITID is the same")
+	}
+}
+
+func TestImportRow_Categorize(t *testing.T) {
+	rules := []CategoryRule{{UserID: 1, Pattern: "кофе", Category: "cafe"}}
+	if err := rules[0].Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		tx           Transaction
+		wantStatus   string
+		wantCategory string
+	}{
+		{
+			name:         "rule fills category",
+			tx:           Transaction{UserID: 1, Kind: KindExpense, Amount: 35000, Description: "Кофе Хауз", Date: date},
+			wantStatus:   RowReady,
+			wantCategory: "cafe",
+		},
+		{
+			name:       "no matching rule",
+			tx:         Transaction{UserID: 1, Kind: KindExpense, Amount: 35000, Description: "Аптека", Date: date},
+			wantStatus: RowFailed,
+		},
+		{
+			name: "splits carry categories",
+			tx: Transaction{UserID: 1, Kind: KindExpense, Amount: 10000, Date: date, Splits: []TransactionSplit{
+				{Category: "groceries", Amount: 6000},
+				{Category: "household", Amount: 4000},
+			}},
+			wantStatus:   RowReady,
+			wantCategory: "groceries",
+		},
+		{
+			name:       "invalid transaction",
+			tx:         Transaction{UserID: 1, Kind: KindExpense, Category: "cafe", Date: date},
+			wantStatus: RowFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := ImportRow{Status: RowReady, Transaction: tt.tx}
+			row.Categorize(rules)
+			if row.Status != tt.wantStatus {
+				t.Fatalf("Status = %q, want %q (error %q)", row.Status, tt.wantStatus, row.Error)
+			}
+			if tt.wantCategory != "" && row.Transaction.Category != tt.wantCategory {
+				t.Errorf("Category = %q, want %q", row.Transaction.Category, tt.wantCategory)
+			}
+		})
+	}
+}
+
+func TestRequireDates(t *testing.T) {
+	rows := []ImportRow{
+		{Line: 2, Status: RowReady},
+		{Line: 3, Status: RowReady, DateError: "missing date"},
+		{Line: 4, Status: RowReady, DateError: "invalid date '31.02'", Warning: "invalid date '31.02', using today"},
+		{Line: 5, Status: RowFailed, Error: "invalid amount 'abc'", DateError: "missing date"},
+	}
+
+	RequireDates(rows)
+
+	want := []struct {
+		status string
+		error  string
+	}{
+		{RowReady, ""},
+		{RowFailed, "missing date"},
+		{RowFailed, "invalid date '31.02'"},
+		{RowFailed, "invalid amount 'abc'"},
+	}
+	for i, w := range want {
+		if rows[i].Status != w.status || rows[i].Error != w.error {
+			t.Errorf("row %d = (%q, %q), want (%q, %q)", rows[i].Line, rows[i].Status, rows[i].Error, w.status, w.error)
+		}
+		if rows[i].Warning != "" {
+			t.Errorf("row %d Warning = %q, want empty", rows[i].Line, rows[i].Warning)
+		}
+	}
+}
+
+func TestImportResult_Counts(t *testing.T) {
+	result := ImportResult{
+		Rows: []ImportRow{
+			{Line: 2, Status: RowReady},
+			{Line: 3, Status: RowFailed, Error: "invalid amount 'abc'"},
+			{Line: 4, Status: RowDuplicate, ExistingID: 17},
+			{Line: 5, Status: RowReady, Warning: "invalid date '31.02', using today"},
+		},
+	}
+
+	if got := result.Failed(); got != 1 {
+		t.Errorf("Failed() = %d, want 1", got)
+	}
+	if got := result.Skipped(); got != 1 {
+		t.Errorf("Skipped() = %d, want 1", got)
+	}
+	if got := len(result.Duplicates()); got != 1 {
+		t.Errorf("len(Duplicates()) = %d, want 1", got)
+	}
+	want := []string{"row 3: invalid amount 'abc'", "row 5: invalid date '31.02', using today"}
+	if got := result.Errors(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Errors() = %v, want %v", got, want)
+	}
+
+	result.RolledBack = true
+	if got := result.Skipped(); got != 3 {
+		t.Errorf("Skipped() after rollback = %d, want 3", got)
+	}
+}
+
+func TestBudgetImpact_Exceeded(t *testing.T) {
+	tests := []struct {
+		name   string
+		impact BudgetImpact
+		want   bool
+	}{
+		{name: "within limit", impact: BudgetImpact{Limit: 10000, Spent: 6000, Incoming: 4000}},
+		{name: "import pushes over limit", impact: BudgetImpact{Limit: 10000, Spent: 6000, Incoming: 4001}, want: true},
+		{name: "already over limit", impact: BudgetImpact{Limit: 10000, Spent: 12000}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.impact.Exceeded(); got != tt.want {
+				t.Errorf("Exceeded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Money int64
+
+const maxMoneyDigits = 16
+
+var ErrInvalidMoney = errors.New("invalid money amount")
+
+func ParseMoney(s string) (Money, error) {
+	return parseDecimal(s, false)
+}
+
+func MoneyFromFloat(f float64) Money {
+	return Money(math.Round(f * 100))
+}
+
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+func (m Money) String() string {
+	sign := ""
+	v := int64(m)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+func (m Money) MulRate(rate float64) Money {
+	return Money(math.Round(float64(m) * rate))
+}
+
+func (m Money) Percent(of Money) float64 {
+	if of == 0 {
+		return 0
+	}
+	return float64(m) / float64(of) * 100
+}
+
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return errors.New("cannot scan NULL into Money")
+	case string:
+		parsed, err := parseDecimal(v, true)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case []byte:
+		parsed, err := parseDecimal(string(v), true)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case int64:
+		*m = Money(v * 100)
+	case float64:
+		*m = MoneyFromFloat(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func parseDecimal(s string, round bool) (Money, error) {
+	s = strings.TrimSpace(s)
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return 0, ErrInvalidMoney
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	if len(intPart) > maxMoneyDigits || !isDigits(intPart) || !isDigits(fracPart) {
+		return 0, ErrInvalidMoney
+	}
+
+	roundUp := false
+	if len(fracPart) > 2 {
+		if !round {
+			return 0, fmt.Errorf("%w: at most 2 decimal places allowed", ErrInvalidMoney)
+		}
+		roundUp = fracPart[2] >= '5'
+		fracPart = fracPart[:2]
+	}
+	for len(fracPart) < 2 {
+		fracPart += "0"
+	}
+
+	units, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidMoney
+	}
+	if roundUp {
+		units++
+	}
+	if negative {
+		units = -units
+	}
+	return Money(units), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
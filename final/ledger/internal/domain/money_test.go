@@ -0,0 +1,98 @@
+package domain
+
+import "testing"
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr bool
+	}{
+		{in: "1500", want: 150000},
+		{in: "1500.5", want: 150050},
+		{in: "1500.50", want: 150050},
+		{in: "0.01", want: 1},
+		{in: ".99", want: 99},
+		{in: "-3.10", want: -310},
+		{in: " 42 ", want: 4200},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1,50", wantErr: true},
+		{in: "1.005", wantErr: true},
+		{in: "1e3", wantErr: true},
+		{in: "12345678901234567", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMoney(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseMoney(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseMoney(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	tests := []struct {
+		in   Money
+		want string
+	}{
+		{in: 0, want: "0.00"},
+		{in: 1, want: "0.01"},
+		{in: 150050, want: "1500.50"},
+		{in: -310, want: "-3.10"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("Money(%d).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMoney_Scan(t *testing.T) {
+	tests := []struct {
+		src     any
+		want    Money
+		wantErr bool
+	}{
+		{src: "1500.50", want: 150050},
+		{src: "100.12345678", want: 10012},
+		{src: "0.005", want: 1},
+		{src: "-0.005", want: -1},
+		{src: []byte("15.5"), want: 1550},
+		{src: int64(7), want: 700},
+		{src: nil, wantErr: true},
+		{src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var m Money
+		err := m.Scan(tt.src)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Scan(%v) error = %v, wantErr %v", tt.src, err, tt.wantErr)
+			continue
+		}
+		if m != tt.want {
+			t.Errorf("Scan(%v) = %d, want %d", tt.src, m, tt.want)
+		}
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	if got := MoneyFromFloat(0.1) + MoneyFromFloat(0.2); got != MoneyFromFloat(0.3) {
+		t.Errorf("0.1 + 0.2 = %s, want 0.30", got)
+	}
+	if got := Money(10000).MulRate(100.5); got != 1005000 {
+		t.Errorf("MulRate() = %d, want 1005000", got)
+	}
+	if got := Money(8000).Percent(10000); got != 80 {
+		t.Errorf("Percent() = %v, want 80", got)
+	}
+	if got := Money(100).Percent(0); got != 0 {
+		t.Errorf("Percent() by zero = %v, want 0", got)
+	}
+}
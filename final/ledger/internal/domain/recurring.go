@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+	FrequencyYearly  = "yearly"
+)
+
+const maxRecurringInterval = 1000
+
+var ErrDuplicateOccurrence = errors.New("occurrence already materialized")
+
+type RecurringRule struct {
+	ID          int64
+	UserID      int64
+	Kind        string
+	Amount      Money
+	Currency    string
+	Category    string
+	Description string
+	Frequency   string
+	Interval    int
+	StartDate   time.Time
+	EndDate     *time.Time
+	NextDate    *time.Time
+	LastError   string
+	CreatedAt   time.Time
+}
+
+func (r *RecurringRule) Validate() error {
+	if r.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+	if r.Category == "" {
+		return errors.New("category is required")
+	}
+	if r.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	if !ValidKind(r.Kind) {
+		return errors.New("kind must be expense, income or transfer")
+	}
+	if r.Kind == "" {
+		r.Kind = KindExpense
+	}
+	if !ValidFrequency(r.Frequency) {
+		return errors.New("frequency must be daily, weekly, monthly or yearly")
+	}
+	if r.Interval == 0 {
+		r.Interval = 1
+	}
+	if r.Interval < 0 || r.Interval > maxRecurringInterval {
+		return errors.New("interval must be between 1 and 1000")
+	}
+	if r.StartDate.IsZero() {
+		return errors.New("start_date is required")
+	}
+	r.StartDate = truncateDay(r.StartDate)
+	if r.EndDate != nil {
+		end := truncateDay(*r.EndDate)
+		if end.Before(r.StartDate) {
+			return errors.New("end_date must not be before start_date")
+		}
+		r.EndDate = &end
+	}
+	if r.Currency != "" {
+		currency, err := NormalizeCurrency(r.Currency)
+		if err != nil {
+			return err
+		}
+		r.Currency = currency
+	}
+	return nil
+}
+
+func ValidFrequency(frequency string) bool {
+	switch frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+		return true
+	}
+	return false
+}
+
+func (r *RecurringRule) Occurrence(n int) time.Time {
+	start := r.StartDate
+	step := n * r.Interval
+	switch r.Frequency {
+	case FrequencyDaily:
+		return start.AddDate(0, 0, step)
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*step)
+	case FrequencyMonthly:
+		return addMonthsClamped(start, step)
+	default:
+		return addMonthsClamped(start, 12*step)
+	}
+}
+
+func (r *RecurringRule) NextOnOrAfter(t time.Time) (time.Time, bool) {
+	t = truncateDay(t)
+	for n := 0; ; n++ {
+		occurrence := r.Occurrence(n)
+		if r.EndDate != nil && occurrence.After(*r.EndDate) {
+			return time.Time{}, false
+		}
+		if !occurrence.Before(t) {
+			return occurrence, true
+		}
+	}
+}
+
+func (r *RecurringRule) Advance(after time.Time) {
+	next, ok := r.NextOnOrAfter(truncateDay(after).AddDate(0, 0, 1))
+	if !ok {
+		r.NextDate = nil
+		return
+	}
+	r.NextDate = &next
+}
+
+func (r *RecurringRule) Transaction(date time.Time) Transaction {
+	ruleID := r.ID
+	return Transaction{
+		UserID:          r.UserID,
+		Kind:            r.Kind,
+		Amount:          r.Amount,
+		Currency:        r.Currency,
+		Category:        r.Category,
+		Description:     r.Description,
+		Date:            date,
+		RecurringRuleID: &ruleID,
+	}
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, months, 0)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRecurringRule_Validate(t *testing.T) {
+	end := date(2024, 12, 31)
+	before := date(2023, 12, 31)
+
+	tests := []struct {
+		name    string
+		rule    RecurringRule
+		wantErr bool
+	}{
+		{
+			name: "valid monthly rule",
+			rule: RecurringRule{UserID: 1, Amount: 3000000, Category: "rent", Frequency: FrequencyMonthly, StartDate: date(2024, 1, 1), EndDate: &end},
+		},
+		{
+			name:    "unknown frequency",
+			rule:    RecurringRule{UserID: 1, Amount: 100, Category: "rent", Frequency: "hourly", StartDate: date(2024, 1, 1)},
+			wantErr: true,
+		},
+		{
+			name:    "missing start date",
+			rule:    RecurringRule{UserID: 1, Amount: 100, Category: "rent", Frequency: FrequencyDaily},
+			wantErr: true,
+		},
+		{
+			name:    "end before start",
+			rule:    RecurringRule{UserID: 1, Amount: 100, Category: "rent", Frequency: FrequencyDaily, StartDate: date(2024, 1, 1), EndDate: &before},
+			wantErr: true,
+		},
+		{
+			name:    "negative interval",
+			rule:    RecurringRule{UserID: 1, Amount: 100, Category: "rent", Frequency: FrequencyWeekly, Interval: -1, StartDate: date(2024, 1, 1)},
+			wantErr: true,
+		},
+		{
+			name:    "zero amount",
+			rule:    RecurringRule{UserID: 1, Category: "rent", Frequency: FrequencyDaily, StartDate: date(2024, 1, 1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (tt.rule.Interval != 1 || tt.rule.Kind != KindExpense) {
+				t.Errorf("Validate() did not apply defaults: interval = %d, kind = %q", tt.rule.Interval, tt.rule.Kind)
+			}
+		})
+	}
+}
+
+func TestRecurringRule_Occurrence(t *testing.T) {
+	tests := []struct {
+		name string
+		rule RecurringRule
+		n    int
+		want time.Time
+	}{
+		{name: "daily", rule: RecurringRule{Frequency: FrequencyDaily, Interval: 3, StartDate: date(2024, 1, 30)}, n: 1, want: date(2024, 2, 2)},
+		{name: "weekly", rule: RecurringRule{Frequency: FrequencyWeekly, Interval: 2, StartDate: date(2024, 1, 1)}, n: 2, want: date(2024, 1, 29)},
+		{name: "monthly clamps to month end", rule: RecurringRule{Frequency: FrequencyMonthly, Interval: 1, StartDate: date(2024, 1, 31)}, n: 1, want: date(2024, 2, 29)},
+		{name: "monthly keeps day after short month", rule: RecurringRule{Frequency: FrequencyMonthly, Interval: 1, StartDate: date(2024, 1, 31)}, n: 2, want: date(2024, 3, 31)},
+		{name: "yearly leap day", rule: RecurringRule{Frequency: FrequencyYearly, Interval: 1, StartDate: date(2024, 2, 29)}, n: 1, want: date(2025, 2, 28)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Occurrence(tt.n); !got.Equal(tt.want) {
+				t.Errorf("Occurrence(%d) = %s, want %s", tt.n, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestRecurringRule_Advance(t *testing.T) {
+	end := date(2024, 3, 15)
+	rule := RecurringRule{Frequency: FrequencyMonthly, Interval: 1, StartDate: date(2024, 1, 10), EndDate: &end}
+
+	rule.Advance(date(2024, 1, 10))
+	if rule.NextDate == nil || !rule.NextDate.Equal(date(2024, 2, 10)) {
+		t.Fatalf("Advance() next = %v, want 2024-02-10", rule.NextDate)
+	}
+
+	rule.Advance(date(2024, 3, 10))
+	if rule.NextDate != nil {
+		t.Errorf("Advance() past end date next = %v, want nil", rule.NextDate)
+	}
+}
@@ -2,10 +2,19 @@ package domain
 
 type CategorySummary struct {
 	Category         string
-	Total            float64
-	BudgetLimit      float64
+	Parent           string
+	Total            Money
+	RollupTotal      Money
+	Currencies       []CurrencyTotal
+	BudgetLimit      Money
 	BudgetPercentage float64
 }
 
-
-
+type Report struct {
+	BaseCurrency  string
+	Categories    []CategorySummary
+	TotalExpenses Money
+	TotalIncome   Money
+	NetBalance    Money
+	SavingsRate   float64
+}
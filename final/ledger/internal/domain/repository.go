@@ -7,16 +7,96 @@ import (
 
 type TransactionRepository interface {
 	Create(ctx context.Context, tx *Transaction) error
-	GetByUserID(ctx context.Context, userID int64, from, to *time.Time, category string) ([]Transaction, error)
-	SumByCategory(ctx context.Context, userID int64, category string, from, to time.Time) (float64, error)
-	GetReportSummary(ctx context.Context, userID int64, from, to time.Time) ([]CategorySummary, error)
+	CreateBatch(ctx context.Context, transactions []Transaction) error
+	GetByID(ctx context.Context, id, userID int64) (*Transaction, error)
+	Update(ctx context.Context, tx *Transaction) (bool, error)
+	Delete(ctx context.Context, id, userID int64) (bool, error)
+	GetByUserID(ctx context.Context, userID int64, filter TransactionFilter) ([]Transaction, error)
+	GetByFingerprints(ctx context.Context, userID int64, fingerprints []string) (map[string]int64, error)
+	SumByCategory(ctx context.Context, userID int64, category string, from, to time.Time, currency string) (Money, error)
+	GetReportSummary(ctx context.Context, userID int64, from, to time.Time, currency string) ([]CategorySummary, error)
+	GetTagSummary(ctx context.Context, userID int64, from, to time.Time, currency string, tags []string) ([]TagSummary, error)
+	GetCashFlow(ctx context.Context, userID int64, from, to time.Time, currency string) (income, expenses Money, err error)
+	GetSpendingTrend(ctx context.Context, trend TrendQuery, currency string) ([]TrendPoint, error)
 }
 
 type BudgetRepository interface {
 	Upsert(ctx context.Context, budget *Budget) error
 	GetByUserID(ctx context.Context, userID int64) ([]Budget, error)
 	GetByCategory(ctx context.Context, userID int64, category string) (*Budget, error)
+	GetInForce(ctx context.Context, userID int64, category string, date time.Time) (*Budget, error)
+	GetHistory(ctx context.Context, userID int64, category string) ([]Budget, error)
+	GetHistoryByUserID(ctx context.Context, userID int64) ([]Budget, error)
+	Delete(ctx context.Context, userID int64, category string, from time.Time) (bool, error)
 }
 
+type ExchangeRateRepository interface {
+	Upsert(ctx context.Context, rates []ExchangeRate) error
+	GetRate(ctx context.Context, userID int64, base, quote string, date time.Time) (float64, error)
+	List(ctx context.Context, userID int64, from, to *time.Time) ([]ExchangeRate, error)
+}
+
+type UserSettingsRepository interface {
+	Get(ctx context.Context, userID int64) (*UserSettings, error)
+	Upsert(ctx context.Context, settings *UserSettings) error
+}
 
+type RecurringRuleRepository interface {
+	Create(ctx context.Context, rule *RecurringRule) error
+	GetByID(ctx context.Context, id, userID int64) (*RecurringRule, error)
+	GetByUserID(ctx context.Context, userID int64) ([]RecurringRule, error)
+	Update(ctx context.Context, rule *RecurringRule) error
+	Delete(ctx context.Context, id, userID int64) (bool, error)
+	ListDue(ctx context.Context, date time.Time, limit int) ([]RecurringRule, error)
+	UpdateSchedule(ctx context.Context, rule *RecurringRule) error
+}
 
+type CategoryRepository interface {
+	Create(ctx context.Context, category *Category) error
+	Ensure(ctx context.Context, userID int64, name string) (*Category, error)
+	GetByID(ctx context.Context, id, userID int64) (*Category, error)
+	GetByName(ctx context.Context, userID int64, name string) (*Category, error)
+	GetByUserID(ctx context.Context, userID int64, includeArchived bool) ([]Category, error)
+	Update(ctx context.Context, category *Category, previousName string) error
+	Delete(ctx context.Context, id, userID int64) (bool, error)
+	InUse(ctx context.Context, userID int64, name string) (bool, error)
+	Merge(ctx context.Context, source, target *Category) (int64, error)
+}
+
+type AccountRepository interface {
+	Create(ctx context.Context, account *Account) error
+	GetByID(ctx context.Context, id, userID int64) (*Account, error)
+	GetByUserID(ctx context.Context, userID int64, includeArchived bool) ([]Account, error)
+	Update(ctx context.Context, account *Account) error
+	Delete(ctx context.Context, id, userID int64) (bool, error)
+	InUse(ctx context.Context, id, userID int64) (bool, error)
+	CreateTransfer(ctx context.Context, transfer *Transfer, out, in *Transaction) error
+	GetBalances(ctx context.Context, id, userID int64, dates []time.Time) ([]BalancePoint, error)
+}
+
+type CategoryRuleRepository interface {
+	Create(ctx context.Context, rule *CategoryRule) error
+	GetByUserID(ctx context.Context, userID int64) ([]CategoryRule, error)
+	Update(ctx context.Context, rule *CategoryRule) (bool, error)
+	Delete(ctx context.Context, id, userID int64) (bool, error)
+}
+
+type ImportProfileRepository interface {
+	Create(ctx context.Context, profile *ImportProfile) error
+	GetByUserID(ctx context.Context, userID int64) ([]ImportProfile, error)
+	GetByName(ctx context.Context, userID int64, name string) (*ImportProfile, error)
+	Update(ctx context.Context, profile *ImportProfile) (bool, error)
+	Delete(ctx context.Context, id, userID int64) (bool, error)
+}
+
+type ImportJobRepository interface {
+	Create(ctx context.Context, job *ImportJob) error
+	GetByID(ctx context.Context, id, userID int64) (*ImportJob, error)
+	GetErrors(ctx context.Context, jobID int64, limit int) ([]ImportJobError, error)
+	Claim(ctx context.Context) (*ImportJob, error)
+	SaveBatch(ctx context.Context, job *ImportJob, transactions []Transaction, rowErrors []ImportJobError) (bool, error)
+	Finish(ctx context.Context, job *ImportJob) (bool, error)
+	Touch(ctx context.Context, id int64) (bool, error)
+	Cancel(ctx context.Context, id, userID int64) (bool, error)
+	RequeueStale(ctx context.Context, before time.Time) (int64, error)
+}
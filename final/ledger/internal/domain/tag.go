@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+
+	maxTagLength          = 50
+	MaxTagsPerTransaction = 20
+)
+
+type TagSummary struct {
+	Tag              string
+	Total            Money
+	TransactionCount int64
+	Currencies       []CurrencyTotal
+}
+
+type TagReport struct {
+	BaseCurrency string
+	Tags         []TagSummary
+}
+
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	var normalized []string
+	for _, tag := range tags {
+		tag = CategoryKey(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if strings.ContainsAny(tag, ",;") {
+			return nil, fmt.Errorf("tag %q must not contain commas or semicolons", tag)
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, fmt.Errorf("tag %q must be at most 50 characters", tag)
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > MaxTagsPerTransaction {
+		return nil, errors.New("at most 20 tags are allowed")
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+func ParseTags(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ';'
+	})
+}
+
+func FormatTags(tags []string) string {
+	return strings.Join(tags, ",")
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			tags: nil,
+			want: nil,
+		},
+		{
+			name: "lowercased, trimmed and sorted",
+			tags: []string{" Vacation-2026 ", "reimbursable"},
+			want: []string{"reimbursable", "vacation-2026"},
+		},
+		{
+			name: "duplicates and blanks dropped",
+			tags: []string{"work", "WORK", "", "  "},
+			want: []string{"work"},
+		},
+		{
+			name: "inner whitespace collapsed",
+			tags: []string{"business   trip"},
+			want: []string{"business trip"},
+		},
+		{
+			name:    "comma inside tag",
+			tags:    []string{"a,b"},
+			wantErr: true,
+		},
+		{
+			name:    "too long",
+			tags:    []string{strings.Repeat("x", 51)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTags(tt.tags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeTags() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTags_Limit(t *testing.T) {
+	tags := make([]string, 0, MaxTagsPerTransaction+1)
+	for i := 0; i <= MaxTagsPerTransaction; i++ {
+		tags = append(tags, strings.Repeat("t", i+1))
+	}
+	if _, err := NormalizeTags(tags); err == nil {
+		t.Error("NormalizeTags() expected error for too many tags")
+	}
+	if _, err := NormalizeTags(tags[:MaxTagsPerTransaction]); err != nil {
+		t.Errorf("NormalizeTags() error = %v", err)
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	got := ParseTags("vacation-2026, reimbursable;;work")
+	want := []string{"vacation-2026", " reimbursable", "work"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseTags() = %q, want %q", got, want)
+	}
+}
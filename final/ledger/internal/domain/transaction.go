@@ -2,31 +2,147 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
+	"unicode/utf8"
+)
+
+const (
+	KindExpense  = "expense"
+	KindIncome   = "income"
+	KindTransfer = "transfer"
+
+	MaxTransactionSplits = 50
+	maxSplitNoteLength   = 200
 )
 
 type Transaction struct {
-	ID          int64
-	UserID      int64
-	Amount      float64
-	Category    string
-	Description string
-	Date        time.Time
-	CreatedAt   time.Time
+	ID              int64
+	UserID          int64
+	Kind            string
+	Amount          Money
+	Currency        string
+	Category        string
+	Description     string
+	Date            time.Time
+	RecurringRuleID *int64
+	AccountID       *int64
+	TransferID      *int64
+	OverBudget      bool
+	Tags            []string
+	Splits          []TransactionSplit
+	Rank            float32
+	Fingerprint     string
+	CreatedAt       time.Time
+}
+
+type TransactionSplit struct {
+	Category string
+	Amount   Money
+	Note     string
 }
 
 func (t *Transaction) Validate() error {
 	if t.Amount <= 0 {
 		return errors.New("amount must be positive")
 	}
+	if err := t.validateSplits(); err != nil {
+		return err
+	}
 	if t.Category == "" {
 		return errors.New("category is required")
 	}
 	if t.UserID <= 0 {
 		return errors.New("user_id is required")
 	}
+	if !ValidKind(t.Kind) {
+		return errors.New("kind must be expense, income or transfer")
+	}
+	if t.Kind == "" {
+		t.Kind = KindExpense
+	}
+	if t.Kind == KindTransfer && t.AccountID != nil && t.TransferID == nil {
+		return errors.New("use a transfer between accounts to move money from an account")
+	}
+	if t.Currency != "" {
+		currency, err := NormalizeCurrency(t.Currency)
+		if err != nil {
+			return err
+		}
+		t.Currency = currency
+	}
+	tags, err := NormalizeTags(t.Tags)
+	if err != nil {
+		return err
+	}
+	t.Tags = tags
+	return nil
+}
+
+func (t *Transaction) validateSplits() error {
+	if len(t.Splits) == 0 {
+		return nil
+	}
+	if len(t.Splits) == 1 {
+		return errors.New("split transaction needs at least two lines")
+	}
+	if len(t.Splits) > MaxTransactionSplits {
+		return errors.New("at most 50 split lines are allowed")
+	}
+
+	var total Money
+	for i := range t.Splits {
+		split := &t.Splits[i]
+		split.Category = NormalizeCategoryName(split.Category)
+		if split.Category == "" {
+			return fmt.Errorf("split %d: category is required", i+1)
+		}
+		if split.Amount <= 0 {
+			return fmt.Errorf("split %d: amount must be positive", i+1)
+		}
+		if utf8.RuneCountInString(split.Note) > maxSplitNoteLength {
+			return fmt.Errorf("split %d: note must be at most 200 characters", i+1)
+		}
+		total += split.Amount
+	}
+	if total != t.Amount {
+		return fmt.Errorf("splits add up to %s, transaction amount is %s", total, t.Amount)
+	}
+
+	t.Category = t.Splits[0].Category
 	return nil
 }
 
+func (t *Transaction) Lines() []TransactionSplit {
+	if len(t.Splits) > 0 {
+		return t.Splits
+	}
+	return []TransactionSplit{{Category: t.Category, Amount: t.Amount}}
+}
+
+func (t *Transaction) CategoryAmounts() ([]string, map[string]Money) {
+	var categories []string
+	amounts := make(map[string]Money)
+	for _, line := range t.Lines() {
+		if _, ok := amounts[line.Category]; !ok {
+			categories = append(categories, line.Category)
+		}
+		amounts[line.Category] += line.Amount
+	}
+	return categories, amounts
+}
+
+func ValidKind(kind string) bool {
+	switch kind {
+	case "", KindExpense, KindIncome, KindTransfer:
+		return true
+	}
+	return false
+}
+
+func (t *Transaction) IsExpense() bool {
+	return t.Kind == "" || t.Kind == KindExpense
+}
+
 
 
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	SortDateDesc  = "date_desc"
+	SortDateAsc   = "date_asc"
+	SortRelevance = "relevance"
+
+	MaxPageSize    = 1000
+	maxQueryLength = 200
+)
+
+type TransactionFilter struct {
+	From      *time.Time
+	To        *time.Time
+	Category  string
+	AccountID int64
+	Query     string
+	Currency  string
+	MinAmount *Money
+	MaxAmount *Money
+	Tags      []string
+	TagMatch  string
+	Sort      string
+	Limit     int
+	After     *TransactionCursor
+}
+
+func (f *TransactionFilter) Validate() error {
+	f.Query = strings.TrimSpace(f.Query)
+	if utf8.RuneCountInString(f.Query) > maxQueryLength {
+		return errors.New("query must be at most 200 characters")
+	}
+	switch f.Sort {
+	case "":
+		f.Sort = SortDateDesc
+		if f.Query != "" {
+			f.Sort = SortRelevance
+		}
+	case SortDateDesc, SortDateAsc:
+	case SortRelevance:
+		if f.Query == "" {
+			return errors.New("sort relevance requires query")
+		}
+	default:
+		return errors.New("sort must be date_desc, date_asc or relevance")
+	}
+	if f.After != nil && f.After.Sort != f.Sort {
+		return errors.New("page token does not match sort order")
+	}
+	if f.Currency != "" {
+		currency, err := NormalizeCurrency(f.Currency)
+		if err != nil {
+			return err
+		}
+		f.Currency = currency
+	}
+	if (f.MinAmount != nil && *f.MinAmount < 0) || (f.MaxAmount != nil && *f.MaxAmount < 0) {
+		return errors.New("amount range must not be negative")
+	}
+	if f.MinAmount != nil && f.MaxAmount != nil && *f.MinAmount > *f.MaxAmount {
+		return errors.New("min_amount must not exceed max_amount")
+	}
+	switch f.TagMatch {
+	case "":
+		f.TagMatch = TagMatchAny
+	case TagMatchAny, TagMatchAll:
+	default:
+		return errors.New("tag_match must be any or all")
+	}
+	tags, err := NormalizeTags(f.Tags)
+	if err != nil {
+		return err
+	}
+	f.Tags = tags
+	if f.Limit < 0 {
+		return errors.New("page_size must not be negative")
+	}
+	if f.Limit > MaxPageSize {
+		f.Limit = MaxPageSize
+	}
+	return nil
+}
+
+type TransactionCursor struct {
+	Sort string
+	Date time.Time
+	ID   int64
+	Rank *float32
+}
+
+func (c TransactionCursor) Encode() string {
+	raw := c.Sort + ":" + c.Date.Format("2006-01-02") + ":" + strconv.FormatInt(c.ID, 10)
+	if c.Rank != nil {
+		raw += ":" + strconv.FormatFloat(float64(*c.Rank), 'g', -1, 32)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func ParseTransactionCursor(token string) (*TransactionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) < 3 || len(parts) > 4 {
+		return nil, errors.New("invalid page token")
+	}
+	sort := parts[0]
+	switch sort {
+	case SortDateDesc, SortDateAsc, SortRelevance:
+	default:
+		return nil, errors.New("invalid page token")
+	}
+	date, err := time.Parse("2006-01-02", parts[1])
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+	id, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || id <= 0 {
+		return nil, errors.New("invalid page token")
+	}
+	cursor := &TransactionCursor{Sort: sort, Date: date, ID: id}
+	if (len(parts) == 4) != (sort == SortRelevance) {
+		return nil, errors.New("invalid page token")
+	}
+	if sort == SortRelevance {
+		rank, err := strconv.ParseFloat(parts[3], 32)
+		if err != nil {
+			return nil, errors.New("invalid page token")
+		}
+		r := float32(rank)
+		cursor.Rank = &r
+	}
+	return cursor, nil
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransactionCursor_RoundTrip(t *testing.T) {
+	cursor := TransactionCursor{
+		Sort: SortDateAsc,
+		Date: time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC),
+		ID:   42,
+	}
+
+	parsed, err := ParseTransactionCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("ParseTransactionCursor() error = %v", err)
+	}
+	if parsed.Sort != cursor.Sort || !parsed.Date.Equal(cursor.Date) || parsed.ID != cursor.ID || parsed.Rank != nil {
+		t.Errorf("ParseTransactionCursor() = %+v, want %+v", *parsed, cursor)
+	}
+}
+
+func TestTransactionCursor_RankRoundTrip(t *testing.T) {
+	rank := float32(0.0607927)
+	cursor := TransactionCursor{
+		Sort: SortRelevance,
+		Date: time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC),
+		ID:   42,
+		Rank: &rank,
+	}
+
+	parsed, err := ParseTransactionCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("ParseTransactionCursor() error = %v", err)
+	}
+	if parsed.Sort != SortRelevance || parsed.Rank == nil || *parsed.Rank != rank || parsed.ID != cursor.ID {
+		t.Errorf("ParseTransactionCursor() = %+v, want rank %v", *parsed, rank)
+	}
+}
+
+func TestParseTransactionCursor_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"not base64!",
+		"MjAyNC0xMi0xNQ",
+		"YWJjOjE",
+		"MjAyNC0xMi0xNTph",
+		"MjAyNC0xMi0xNTo0Mjp4",
+		"MjAyNC0xMi0xNTo0Mg",
+		"YW1vdW50OjIwMjQtMTItMTU6NDI",
+		"cmVsZXZhbmNlOjIwMjQtMTItMTU6NDI",
+		"ZGF0ZV9kZXNjOjIwMjQtMTItMTU6NDI6MC41",
+	}
+
+	for _, token := range tests {
+		if _, err := ParseTransactionCursor(token); err == nil {
+			t.Errorf("ParseTransactionCursor(%q) expected error", token)
+		}
+	}
+}
+
+func TestTransactionFilter_Validate(t *testing.T) {
+	rank := float32(0.5)
+	minAmount, maxAmount, negative := Money(1000), Money(500), Money(-1)
+
+	tests := []struct {
+		name         string
+		filter       TransactionFilter
+		wantErr      bool
+		wantSort     string
+		wantLimit    int
+		wantCurrency string
+	}{
+		{
+			name:      "defaults",
+			filter:    TransactionFilter{},
+			wantSort:  SortDateDesc,
+			wantLimit: 0,
+		},
+		{
+			name:      "ascending",
+			filter:    TransactionFilter{Sort: SortDateAsc, Limit: 50},
+			wantSort:  SortDateAsc,
+			wantLimit: 50,
+		},
+		{
+			name:      "limit clamped",
+			filter:    TransactionFilter{Limit: MaxPageSize + 1},
+			wantSort:  SortDateDesc,
+			wantLimit: MaxPageSize,
+		},
+		{
+			name:    "unknown sort",
+			filter:  TransactionFilter{Sort: "amount"},
+			wantErr: true,
+		},
+		{
+			name:      "all tags",
+			filter:    TransactionFilter{Tags: []string{"Vacation"}, TagMatch: TagMatchAll},
+			wantSort:  SortDateDesc,
+			wantLimit: 0,
+		},
+		{
+			name:    "unknown tag match",
+			filter:  TransactionFilter{Tags: []string{"vacation"}, TagMatch: "none"},
+			wantErr: true,
+		},
+		{
+			name:    "negative limit",
+			filter:  TransactionFilter{Limit: -1},
+			wantErr: true,
+		},
+		{
+			name:      "query defaults to relevance",
+			filter:    TransactionFilter{Query: "  такси airport "},
+			wantSort:  SortRelevance,
+			wantLimit: 0,
+		},
+		{
+			name:      "query sorted by date",
+			filter:    TransactionFilter{Query: "coffee", Sort: SortDateAsc},
+			wantSort:  SortDateAsc,
+			wantLimit: 0,
+		},
+		{
+			name:    "relevance without query",
+			filter:  TransactionFilter{Sort: SortRelevance},
+			wantErr: true,
+		},
+		{
+			name:    "ranked cursor with date sort",
+			filter:  TransactionFilter{After: &TransactionCursor{Sort: SortRelevance, ID: 1, Rank: &rank}},
+			wantErr: true,
+		},
+		{
+			name:    "date cursor with relevance sort",
+			filter:  TransactionFilter{Query: "coffee", After: &TransactionCursor{Sort: SortDateDesc, ID: 1}},
+			wantErr: true,
+		},
+		{
+			name:    "descending cursor with ascending sort",
+			filter:  TransactionFilter{Sort: SortDateAsc, After: &TransactionCursor{Sort: SortDateDesc, ID: 1}},
+			wantErr: true,
+		},
+		{
+			name:      "cursor with matching sort",
+			filter:    TransactionFilter{Sort: SortDateAsc, After: &TransactionCursor{Sort: SortDateAsc, ID: 1}},
+			wantSort:  SortDateAsc,
+			wantLimit: 0,
+		},
+		{
+			name:    "min above max",
+			filter:  TransactionFilter{MinAmount: &minAmount, MaxAmount: &maxAmount},
+			wantErr: true,
+		},
+		{
+			name:    "negative amount",
+			filter:  TransactionFilter{MinAmount: &negative},
+			wantErr: true,
+		},
+		{
+			name:         "amount range in currency",
+			filter:       TransactionFilter{MinAmount: &maxAmount, Currency: "usd"},
+			wantSort:     SortDateDesc,
+			wantCurrency: "USD",
+		},
+		{
+			name:    "invalid currency",
+			filter:  TransactionFilter{MinAmount: &maxAmount, Currency: "dollars"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.filter.Sort != tt.wantSort || tt.filter.Limit != tt.wantLimit || tt.filter.Currency != tt.wantCurrency {
+				t.Errorf("Validate() = sort %q limit %d currency %q, want sort %q limit %d currency %q",
+					tt.filter.Sort, tt.filter.Limit, tt.filter.Currency, tt.wantSort, tt.wantLimit, tt.wantCurrency)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 )
 
 func TestTransaction_Validate(t *testing.T) {
+	accountID := int64(1)
 	tests := []struct {
 		name    string
 		tx      Transaction
@@ -15,7 +16,7 @@ func TestTransaction_Validate(t *testing.T) {
 			name: "valid transaction",
 			tx: Transaction{
 				UserID:   1,
-				Amount:   100.50,
+				Amount:   10050,
 				Category: "food",
 				Date:     time.Now(),
 			},
@@ -34,7 +35,7 @@ func TestTransaction_Validate(t *testing.T) {
 			name: "negative amount",
 			tx: Transaction{
 				UserID:   1,
-				Amount:   -50,
+				Amount:   -5000,
 				Category: "food",
 			},
 			wantErr: true,
@@ -43,14 +44,111 @@ func TestTransaction_Validate(t *testing.T) {
 			name: "empty category",
 			tx: Transaction{
 				UserID: 1,
-				Amount: 100,
+				Amount: 10000,
 			},
 			wantErr: true,
 		},
 		{
 			name: "zero user_id",
 			tx: Transaction{
-				Amount:   100,
+				Amount:   10000,
+				Category: "food",
+			},
+			wantErr: true,
+		},
+		{
+			name: "income transaction",
+			tx: Transaction{
+				UserID:   1,
+				Kind:     KindIncome,
+				Amount:   5000000,
+				Category: "salary",
+			},
+			wantErr: false,
+		},
+		{
+			name: "with tags",
+			tx: Transaction{
+				UserID:   1,
+				Amount:   10000,
+				Category: "travel",
+				Tags:     []string{"vacation-2026", "reimbursable"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid tag",
+			tx: Transaction{
+				UserID:   1,
+				Amount:   10000,
+				Category: "travel",
+				Tags:     []string{"a,b"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "split across categories",
+			tx: Transaction{
+				UserID: 1,
+				Amount: 10000,
+				Splits: []TransactionSplit{
+					{Category: "groceries", Amount: 7000},
+					{Category: "household", Amount: 3000, Note: "detergent"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "splits do not add up",
+			tx: Transaction{
+				UserID: 1,
+				Amount: 10000,
+				Splits: []TransactionSplit{
+					{Category: "groceries", Amount: 7000},
+					{Category: "household", Amount: 2000},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "single split line",
+			tx: Transaction{
+				UserID:   1,
+				Amount:   10000,
+				Category: "groceries",
+				Splits:   []TransactionSplit{{Category: "groceries", Amount: 10000}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "split without category",
+			tx: Transaction{
+				UserID: 1,
+				Amount: 10000,
+				Splits: []TransactionSplit{
+					{Category: "groceries", Amount: 7000},
+					{Amount: 3000},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "transfer kind on account",
+			tx: Transaction{
+				UserID:    1,
+				Kind:      KindTransfer,
+				Amount:    10000,
+				Category:  "savings",
+				AccountID: &accountID,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown kind",
+			tx: Transaction{
+				UserID:   1,
+				Kind:     "refund",
+				Amount:   10000,
 				Category: "food",
 			},
 			wantErr: true,
@@ -67,5 +165,44 @@ func TestTransaction_Validate(t *testing.T) {
 	}
 }
 
+func TestTransaction_Validate_DefaultKind(t *testing.T) {
+	tx := Transaction{UserID: 1, Amount: 10000, Category: "food"}
+	if err := tx.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if tx.Kind != KindExpense {
+		t.Errorf("Kind = %q, want %q", tx.Kind, KindExpense)
+	}
+}
+
+func TestTransaction_CategoryAmounts(t *testing.T) {
+	tx := Transaction{
+		UserID: 1,
+		Amount: 10000,
+		Splits: []TransactionSplit{
+			{Category: "groceries", Amount: 5000},
+			{Category: "household", Amount: 3000},
+			{Category: "groceries", Amount: 2000},
+		},
+	}
+	if err := tx.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if tx.Category != "groceries" {
+		t.Errorf("Category = %q, want first split category", tx.Category)
+	}
 
+	categories, amounts := tx.CategoryAmounts()
+	if len(categories) != 2 || categories[0] != "groceries" || categories[1] != "household" {
+		t.Fatalf("CategoryAmounts() categories = %q", categories)
+	}
+	if amounts["groceries"] != 7000 || amounts["household"] != 3000 {
+		t.Errorf("CategoryAmounts() amounts = %v", amounts)
+	}
 
+	plain := Transaction{UserID: 1, Amount: 1500, Category: "food"}
+	categories, amounts = plain.CategoryAmounts()
+	if len(categories) != 1 || amounts["food"] != 1500 {
+		t.Errorf("CategoryAmounts() without splits = %q %v", categories, amounts)
+	}
+}
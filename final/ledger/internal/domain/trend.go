@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+	GranularityYear  = "year"
+)
+
+const (
+	DefaultTrendWindow = 3
+
+	maxTrendWindow  = 100
+	maxTrendBuckets = 1000
+)
+
+type TrendQuery struct {
+	UserID      int64
+	From        time.Time
+	To          time.Time
+	Granularity string
+	ByCategory  bool
+	Window      int
+}
+
+type TrendPoint struct {
+	Period   time.Time
+	Category string
+	Total    Money
+}
+
+type TrendBucket struct {
+	Period        time.Time
+	Total         Money
+	RunningTotal  Money
+	MovingAverage Money
+}
+
+type TrendSeries struct {
+	Category string
+	Total    Money
+	Buckets  []TrendBucket
+}
+
+type Trend struct {
+	BaseCurrency string
+	Granularity  string
+	Window       int
+	Total        Money
+	Buckets      []TrendBucket
+	Categories   []TrendSeries
+}
+
+func (q *TrendQuery) Validate() error {
+	if q.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	if q.To.Before(q.From) {
+		return errors.New("to must not be before from")
+	}
+
+	q.Granularity = strings.ToLower(strings.TrimSpace(q.Granularity))
+	switch q.Granularity {
+	case "":
+		q.Granularity = GranularityMonth
+	case GranularityDay, GranularityWeek, GranularityMonth, GranularityYear:
+	default:
+		return errors.New("granularity must be day, week, month or year")
+	}
+
+	if q.Window == 0 {
+		q.Window = DefaultTrendWindow
+	}
+	if q.Window < 1 || q.Window > maxTrendWindow {
+		return fmt.Errorf("window must be between 1 and %d", maxTrendWindow)
+	}
+
+	count := 0
+	for period := TruncateDate(q.From, q.Granularity); !period.After(q.To); period = nextPeriod(period, q.Granularity) {
+		if count++; count > maxTrendBuckets {
+			return fmt.Errorf("period is too long for %s granularity: at most %d buckets allowed", q.Granularity, maxTrendBuckets)
+		}
+	}
+	return nil
+}
+
+func (q *TrendQuery) Periods() []time.Time {
+	var periods []time.Time
+	for period := TruncateDate(q.From, q.Granularity); !period.After(q.To); period = nextPeriod(period, q.Granularity) {
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+func TruncateDate(date time.Time, granularity string) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	switch granularity {
+	case GranularityWeek:
+		weekday := int(day.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		return day.AddDate(0, 0, 1-weekday)
+	case GranularityMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	case GranularityYear:
+		return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+	}
+	return day
+}
+
+func nextPeriod(period time.Time, granularity string) time.Time {
+	switch granularity {
+	case GranularityWeek:
+		return period.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return period.AddDate(0, 1, 0)
+	case GranularityYear:
+		return period.AddDate(1, 0, 0)
+	}
+	return period.AddDate(0, 0, 1)
+}
+
+func NewTrend(query TrendQuery, points []TrendPoint) *Trend {
+	periods := query.Periods()
+	index := make(map[string]int, len(periods))
+	for i, period := range periods {
+		index[period.Format("2006-01-02")] = i
+	}
+
+	totals := make([]Money, len(periods))
+	categories := make(map[string]*TrendSeries)
+	categoryTotals := make(map[string][]Money)
+	for _, point := range points {
+		i, ok := index[point.Period.Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+		totals[i] += point.Total
+		if !query.ByCategory {
+			continue
+		}
+
+		key := CategoryKey(point.Category)
+		if _, ok := categories[key]; !ok {
+			categories[key] = &TrendSeries{Category: point.Category}
+			categoryTotals[key] = make([]Money, len(periods))
+		}
+		categories[key].Total += point.Total
+		categoryTotals[key][i] += point.Total
+	}
+
+	trend := &Trend{
+		Granularity: query.Granularity,
+		Window:      query.Window,
+		Buckets:     trendBuckets(periods, totals, query.Window),
+	}
+	for _, total := range totals {
+		trend.Total += total
+	}
+
+	for key, series := range categories {
+		series.Buckets = trendBuckets(periods, categoryTotals[key], query.Window)
+		trend.Categories = append(trend.Categories, *series)
+	}
+	sort.Slice(trend.Categories, func(i, j int) bool {
+		if trend.Categories[i].Total != trend.Categories[j].Total {
+			return trend.Categories[i].Total > trend.Categories[j].Total
+		}
+		return trend.Categories[i].Category < trend.Categories[j].Category
+	})
+	return trend
+}
+
+func trendBuckets(periods []time.Time, totals []Money, window int) []TrendBucket {
+	buckets := make([]TrendBucket, len(periods))
+	var running, windowSum Money
+	for i, period := range periods {
+		running += totals[i]
+		windowSum += totals[i]
+		if i >= window {
+			windowSum -= totals[i-window]
+		}
+		size := i + 1
+		if size > window {
+			size = window
+		}
+		buckets[i] = TrendBucket{
+			Period:        period,
+			Total:         totals[i],
+			RunningTotal:  running,
+			MovingAverage: Money(math.Round(float64(windowSum) / float64(size))),
+		}
+	}
+	return buckets
+}
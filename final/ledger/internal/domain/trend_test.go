@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTrendQuery_Validate(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		query           TrendQuery
+		wantGranularity string
+		wantWindow      int
+		wantErr         bool
+	}{
+		{name: "defaults", query: TrendQuery{UserID: 1, From: from, To: from.AddDate(0, 11, 30)}, wantGranularity: GranularityMonth, wantWindow: DefaultTrendWindow},
+		{name: "weekly", query: TrendQuery{UserID: 1, From: from, To: from.AddDate(0, 3, 0), Granularity: " Week ", Window: 4}, wantGranularity: GranularityWeek, wantWindow: 4},
+		{name: "daily for two years", query: TrendQuery{UserID: 1, From: from, To: from.AddDate(2, 0, 0), Granularity: "day"}, wantGranularity: GranularityDay, wantWindow: DefaultTrendWindow},
+		{name: "missing user", query: TrendQuery{From: from, To: from}, wantErr: true},
+		{name: "to before from", query: TrendQuery{UserID: 1, From: from, To: from.AddDate(0, 0, -1)}, wantErr: true},
+		{name: "unknown granularity", query: TrendQuery{UserID: 1, From: from, To: from, Granularity: "quarter"}, wantErr: true},
+		{name: "negative window", query: TrendQuery{UserID: 1, From: from, To: from, Window: -1}, wantErr: true},
+		{name: "window too large", query: TrendQuery{UserID: 1, From: from, To: from, Window: 101}, wantErr: true},
+		{name: "too many days", query: TrendQuery{UserID: 1, From: from, To: from.AddDate(3, 0, 0), Granularity: "day"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.query.Granularity != tt.wantGranularity || tt.query.Window != tt.wantWindow {
+				t.Errorf("Validate() granularity %q, window %d, want %q, %d", tt.query.Granularity, tt.query.Window, tt.wantGranularity, tt.wantWindow)
+			}
+		})
+	}
+}
+
+func TestTruncateDate(t *testing.T) {
+	date := time.Date(2025, 3, 16, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		granularity string
+		want        time.Time
+	}{
+		{granularity: GranularityDay, want: time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{granularity: GranularityWeek, want: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{granularity: GranularityMonth, want: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{granularity: GranularityYear, want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.granularity, func(t *testing.T) {
+			if got := TruncateDate(date, tt.granularity); !got.Equal(tt.want) {
+				t.Errorf("TruncateDate(%v, %q) = %v, want %v", date, tt.granularity, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTrend(t *testing.T) {
+	month := func(m time.Month) time.Time {
+		return time.Date(2025, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+	query := TrendQuery{
+		UserID:      1,
+		From:        time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC),
+		Granularity: GranularityMonth,
+		ByCategory:  true,
+		Window:      2,
+	}
+	points := []TrendPoint{
+		{Period: month(1), Category: "cafe", Total: 10000},
+		{Period: month(1), Category: "groceries", Total: 30000},
+		{Period: month(3), Category: "Cafe", Total: 5000},
+		{Period: month(4), Category: "groceries", Total: 20001},
+	}
+
+	trend := NewTrend(query, points)
+
+	wantBuckets := []TrendBucket{
+		{Period: month(1), Total: 40000, RunningTotal: 40000, MovingAverage: 40000},
+		{Period: month(2), Total: 0, RunningTotal: 40000, MovingAverage: 20000},
+		{Period: month(3), Total: 5000, RunningTotal: 45000, MovingAverage: 2500},
+		{Period: month(4), Total: 20001, RunningTotal: 65001, MovingAverage: 12501},
+	}
+	if !reflect.DeepEqual(trend.Buckets, wantBuckets) {
+		t.Errorf("Buckets = %+v, want %+v", trend.Buckets, wantBuckets)
+	}
+	if trend.Total != 65001 {
+		t.Errorf("Total = %v, want 650.01", trend.Total)
+	}
+
+	if len(trend.Categories) != 2 {
+		t.Fatalf("len(Categories) = %d, want 2", len(trend.Categories))
+	}
+	groceries, cafe := trend.Categories[0], trend.Categories[1]
+	if groceries.Category != "groceries" || groceries.Total != 50001 {
+		t.Errorf("Categories[0] = %q %v, want groceries 500.01", groceries.Category, groceries.Total)
+	}
+	if cafe.Category != "cafe" || cafe.Total != 15000 {
+		t.Errorf("Categories[1] = %q %v, want cafe 150.00", cafe.Category, cafe.Total)
+	}
+	wantCafe := []Money{10000, 0, 5000, 0}
+	for i, bucket := range cafe.Buckets {
+		if bucket.Total != wantCafe[i] {
+			t.Errorf("cafe bucket %d = %v, want %v", i, bucket.Total, wantCafe[i])
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type csvExporter struct{}
+
+func (csvExporter) ContentType() string {
+	return "text/csv; charset=utf-8"
+}
+
+func (csvExporter) Extension() string {
+	return "csv"
+}
+
+type csvRowWriter struct {
+	writer  *csv.Writer
+	columns []string
+	record  []string
+}
+
+func (e csvExporter) Export(w io.Writer, transactions []domain.Transaction, options Options) (int, error) {
+	return exportRows(e, w, transactions, options)
+}
+
+func (csvExporter) NewRowWriter(w io.Writer, options Options) (RowWriter, error) {
+	columns, err := domain.NormalizeExportColumns(options.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return nil, err
+	}
+	return &csvRowWriter{writer: writer, columns: columns, record: make([]string, len(columns))}, nil
+}
+
+func (r *csvRowWriter) Write(tx *domain.Transaction) (int, error) {
+	lines := tx.Lines()
+	for _, line := range lines {
+		for i, column := range r.columns {
+			r.record[i] = columnValue(tx, &line, column)
+		}
+		if err := r.writer.Write(r.record); err != nil {
+			return 0, err
+		}
+	}
+	return len(lines), nil
+}
+
+func (r *csvRowWriter) Flush() error {
+	r.writer.Flush()
+	return r.writer.Error()
+}
+
+func columnValue(tx *domain.Transaction, line *domain.TransactionSplit, column string) string {
+	switch column {
+	case domain.ColumnID:
+		return strconv.FormatInt(tx.ID, 10)
+	case domain.ColumnAmount:
+		return line.Amount.String()
+	case domain.ColumnCategory:
+		return line.Category
+	case domain.ColumnDescription:
+		return tx.Description
+	case domain.ColumnDate:
+		return tx.Date.Format("2006-01-02")
+	case domain.ColumnKind:
+		return tx.Kind
+	case domain.ColumnCurrency:
+		return tx.Currency
+	case domain.ColumnTags:
+		return domain.FormatTags(tx.Tags)
+	case domain.ColumnAccountID:
+		if tx.AccountID != nil {
+			return strconv.FormatInt(*tx.AccountID, 10)
+		}
+	}
+	return ""
+}
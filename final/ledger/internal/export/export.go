@@ -0,0 +1,82 @@
+package export
+
+import (
+	"io"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type Options struct {
+	Columns []string
+	From    time.Time
+	To      time.Time
+}
+
+type Exporter interface {
+	ContentType() string
+	Extension() string
+	Export(w io.Writer, transactions []domain.Transaction, options Options) (int, error)
+}
+
+type RowWriter interface {
+	Write(tx *domain.Transaction) (int, error)
+	Flush() error
+}
+
+type StreamExporter interface {
+	Exporter
+	NewRowWriter(w io.Writer, options Options) (RowWriter, error)
+}
+
+var exporters = map[string]Exporter{
+	domain.FormatCSV:   csvExporter{},
+	domain.FormatJSONL: jsonlExporter{},
+	domain.FormatXLSX:  xlsxExporter{},
+	domain.FormatOFX:   ofxExporter{},
+}
+
+func Get(format string) (Exporter, error) {
+	format, err := domain.NormalizeExportFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	return exporters[format], nil
+}
+
+func Filename(exporter Exporter, from, to time.Time) string {
+	name := "transactions"
+	if !from.IsZero() {
+		name += "_" + from.Format("2006-01-02")
+	}
+	if !to.IsZero() {
+		name += "_" + to.Format("2006-01-02")
+	}
+	return name + "." + exporter.Extension()
+}
+
+func exportRows(exporter StreamExporter, w io.Writer, transactions []domain.Transaction, options Options) (int, error) {
+	writer, err := exporter.NewRowWriter(w, options)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for i := range transactions {
+		n, err := writer.Write(&transactions[i])
+		if err != nil {
+			return 0, err
+		}
+		count += n
+	}
+	if err := writer.Flush(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func signedAmount(tx *domain.Transaction) domain.Money {
+	if tx.Kind == domain.KindExpense {
+		return -tx.Amount
+	}
+	return tx.Amount
+}
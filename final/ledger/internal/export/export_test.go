@@ -0,0 +1,254 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/ofx"
+)
+
+func testTransactions() []domain.Transaction {
+	accountID := int64(3)
+	return []domain.Transaction{
+		{
+			ID: 1, UserID: 1, Kind: domain.KindExpense, Amount: 135050, Currency: "RUB", Category: "cafe",
+			Description: "Кофе, \"Хауз\"", Date: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), Tags: []string{"work", "coffee"},
+		},
+		{
+			ID: 2, UserID: 1, Kind: domain.KindIncome, Amount: 12000000, Currency: "USD", Category: "salary",
+			Description: "Зарплата", Date: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC), AccountID: &accountID,
+		},
+		{
+			ID: 3, UserID: 1, Kind: domain.KindExpense, Amount: 10000, Currency: "RUB", Category: "groceries",
+			Description: "Ашан", Date: time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC),
+			Splits: []domain.TransactionSplit{
+				{Category: "groceries", Amount: 6000, Note: "овощи"},
+				{Category: "household", Amount: 4000},
+			},
+		},
+	}
+}
+
+func export(t *testing.T, format string, options Options) []byte {
+	t.Helper()
+	exporter, err := Get(format)
+	if err != nil {
+		t.Fatalf("Get(%q) error = %v", format, err)
+	}
+	var buf bytes.Buffer
+	if _, err := exporter.Export(&buf, testTransactions(), options); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestGet(t *testing.T) {
+	for _, format := range []string{"", "CSV", "jsonl", "xlsx", "ofx"} {
+		if _, err := Get(format); err != nil {
+			t.Errorf("Get(%q) error = %v", format, err)
+		}
+	}
+	if _, err := Get("pdf"); err == nil {
+		t.Error("Get(\"pdf\") error = nil")
+	}
+}
+
+func TestFilename(t *testing.T) {
+	exporter, _ := Get("xlsx")
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	if got := Filename(exporter, from, to); got != "transactions_2025-03-01_2025-03-31.xlsx" {
+		t.Errorf("Filename() = %q", got)
+	}
+	if got := Filename(exporter, time.Time{}, time.Time{}); got != "transactions.xlsx" {
+		t.Errorf("Filename() without period = %q", got)
+	}
+}
+
+func TestCSVExporter(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		want    string
+	}{
+		{
+			name:    "selected columns",
+			columns: []string{"ID", "date", "amount", "description", "account_id"},
+			want: "id,date,amount,description,account_id\n" +
+				"1,2025-03-14,1350.50,\"Кофе, \"\"Хауз\"\"\",\n" +
+				"2,2025-03-15,120000.00,Зарплата,3\n" +
+				"3,2025-03-16,60.00,Ашан,\n" +
+				"3,2025-03-16,40.00,Ашан,\n",
+		},
+		{
+			name: "default columns split by category",
+			want: "amount,category,description,date,kind,currency,tags\n" +
+				"1350.50,cafe,\"Кофе, \"\"Хауз\"\"\",2025-03-14,expense,RUB,\"work,coffee\"\n" +
+				"120000.00,salary,Зарплата,2025-03-15,income,USD,\n" +
+				"60.00,groceries,Ашан,2025-03-16,expense,RUB,\n" +
+				"40.00,household,Ашан,2025-03-16,expense,RUB,\n",
+		},
+		{
+			name:    "tags",
+			columns: []string{"id", "tags"},
+			want:    "id,tags\n1,\"work,coffee\"\n2,\n3,\n3,\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(export(t, "csv", Options{Columns: tt.columns})); got != tt.want {
+				t.Errorf("Export() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	exporter, _ := Get("csv")
+	var buf bytes.Buffer
+	if _, err := exporter.Export(&buf, testTransactions(), Options{Columns: []string{"amount", "balance"}}); err == nil {
+		t.Error("Export() error = nil for unknown column")
+	}
+}
+
+func TestExporter_RowsCount(t *testing.T) {
+	tests := []struct {
+		format string
+		want   int
+	}{
+		{format: "csv", want: 4},
+		{format: "jsonl", want: 3},
+		{format: "xlsx", want: 3},
+		{format: "ofx", want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			exporter, err := Get(tt.format)
+			if err != nil {
+				t.Fatalf("Get(%q) error = %v", tt.format, err)
+			}
+			count, err := exporter.Export(&bytes.Buffer{}, testTransactions(), Options{})
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+			if count != tt.want {
+				t.Errorf("Export() = %d rows, want %d", count, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONLExporter(t *testing.T) {
+	scanner := bufio.NewScanner(bytes.NewReader(export(t, "jsonl", Options{})))
+	var records []jsonlRecord
+	for scanner.Scan() {
+		var record jsonlRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("line %d: %v", len(records)+1, err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 3 {
+		t.Fatalf("len(records) = %d, want 3", len(records))
+	}
+
+	if r := records[0]; r.Amount != "1350.50" || r.Date != "2025-03-14" || r.Description != "Кофе, \"Хауз\"" || len(r.Tags) != 2 {
+		t.Errorf("records[0] = %+v", r)
+	}
+	if r := records[1]; r.AccountID == nil || *r.AccountID != 3 || r.Currency != "USD" {
+		t.Errorf("records[1] = %+v", r)
+	}
+	splits := records[2].Splits
+	if len(splits) != 2 || splits[0].Amount != "60.00" || splits[0].Note != "овощи" || splits[1].Category != "household" {
+		t.Errorf("records[2].Splits = %+v", splits)
+	}
+}
+
+func TestXLSXExporter(t *testing.T) {
+	f, err := excelize.OpenReader(bytes.NewReader(export(t, "xlsx", Options{})))
+	if err != nil {
+		t.Fatalf("OpenReader() error = %v", err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows(transactionsSheet)
+	if err != nil {
+		t.Fatalf("GetRows() error = %v", err)
+	}
+	if len(rows) != 4 || rows[0][0] != "ID" || rows[1][5] != "cafe" || rows[2][4] != "USD" {
+		t.Errorf("Transactions sheet = %v", rows)
+	}
+
+	summary, err := f.GetRows(summarySheet)
+	if err != nil {
+		t.Fatalf("GetRows() error = %v", err)
+	}
+	totals := make(map[string]string)
+	for _, row := range summary {
+		if len(row) >= 4 && row[1] == domain.KindExpense {
+			totals[row[0]] = row[3]
+		}
+	}
+	if totals["groceries"] != "60.00" || totals["household"] != "40.00" || totals["cafe"] != "1,350.50" {
+		t.Errorf("expense totals by category = %v", totals)
+	}
+}
+
+func TestOFXExporter_RoundTrip(t *testing.T) {
+	rows, err := ofx.ParseOFX(export(t, "ofx", Options{}), 1, nil)
+	if err != nil {
+		t.Fatalf("ParseOFX() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("len(rows) = %d, want 3", len(rows))
+	}
+
+	byID := make(map[string]domain.Transaction)
+	for _, row := range rows {
+		byID[row.ExternalID] = row.Transaction
+	}
+	if tx := byID["ledger-RUB:1"]; tx.Kind != domain.KindExpense || tx.Amount != 135050 || tx.Description != "Кофе, \"Хауз\" / cafe" {
+		t.Errorf("transaction 1 = %+v", tx)
+	}
+	if tx := byID["ledger-USD:2"]; tx.Kind != domain.KindIncome || tx.Amount != 12000000 || tx.Currency != "USD" {
+		t.Errorf("transaction 2 = %+v", tx)
+	}
+}
+
+func TestOFXExporter_SkipsTransfers(t *testing.T) {
+	transferID := int64(5)
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	transactions := []domain.Transaction{
+		{ID: 1, UserID: 1, Kind: domain.KindExpense, Amount: 35000, Currency: "RUB", Category: "cafe", Date: date},
+		{ID: 2, UserID: 1, Kind: domain.KindTransfer, Amount: 500000, Currency: "RUB", Category: domain.TransferCategory, Date: date, TransferID: &transferID},
+		{ID: 3, UserID: 1, Kind: domain.KindTransfer, Amount: 500000, Currency: "RUB", Category: domain.TransferCategory, Date: date, TransferID: &transferID},
+	}
+
+	var buf bytes.Buffer
+	count, err := (ofxExporter{}).Export(&buf, transactions, Options{})
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Export() = %d rows, want 1", count)
+	}
+	if !strings.Contains(buf.String(), "<BALAMT>-350.00</BALAMT>") {
+		t.Errorf("statement balance is not -350.00:\n%s", buf.String())
+	}
+
+	rows, err := ofx.ParseOFX(buf.Bytes(), 1, nil)
+	if err != nil {
+		t.Fatalf("ParseOFX() error = %v", err)
+	}
+	if len(rows) != 1 || rows[0].ExternalID != "ledger-RUB:1" {
+		t.Errorf("rows = %+v, want only transaction 1", rows)
+	}
+}
@@ -0,0 +1,80 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type jsonlExporter struct{}
+
+type jsonlRowWriter struct {
+	encoder *json.Encoder
+}
+
+type jsonlSplit struct {
+	Category string      `json:"category"`
+	Amount   json.Number `json:"amount"`
+	Note     string      `json:"note,omitempty"`
+}
+
+type jsonlRecord struct {
+	ID          int64        `json:"id"`
+	Date        string       `json:"date"`
+	Kind        string       `json:"kind"`
+	Amount      json.Number  `json:"amount"`
+	Currency    string       `json:"currency"`
+	Category    string       `json:"category"`
+	Description string       `json:"description,omitempty"`
+	Tags        []string     `json:"tags,omitempty"`
+	Splits      []jsonlSplit `json:"splits,omitempty"`
+	AccountID   *int64       `json:"account_id,omitempty"`
+}
+
+func (jsonlExporter) ContentType() string {
+	return "application/x-ndjson"
+}
+
+func (jsonlExporter) Extension() string {
+	return "jsonl"
+}
+
+func (e jsonlExporter) Export(w io.Writer, transactions []domain.Transaction, options Options) (int, error) {
+	return exportRows(e, w, transactions, options)
+}
+
+func (jsonlExporter) NewRowWriter(w io.Writer, _ Options) (RowWriter, error) {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return &jsonlRowWriter{encoder: encoder}, nil
+}
+
+func (r *jsonlRowWriter) Write(tx *domain.Transaction) (int, error) {
+	record := jsonlRecord{
+		ID:          tx.ID,
+		Date:        tx.Date.Format("2006-01-02"),
+		Kind:        tx.Kind,
+		Amount:      json.Number(tx.Amount.String()),
+		Currency:    tx.Currency,
+		Category:    tx.Category,
+		Description: tx.Description,
+		Tags:        tx.Tags,
+		AccountID:   tx.AccountID,
+	}
+	for _, split := range tx.Splits {
+		record.Splits = append(record.Splits, jsonlSplit{
+			Category: split.Category,
+			Amount:   json.Number(split.Amount.String()),
+			Note:     split.Note,
+		})
+	}
+	if err := r.encoder.Encode(record); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func (r *jsonlRowWriter) Flush() error {
+	return nil
+}
@@ -0,0 +1,127 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+const (
+	ofxDateLayout    = "20060102"
+	maxOFXNameLength = 32
+)
+
+type ofxExporter struct{}
+
+func (ofxExporter) ContentType() string {
+	return "application/x-ofx"
+}
+
+func (ofxExporter) Extension() string {
+	return "ofx"
+}
+
+func (ofxExporter) Export(w io.Writer, transactions []domain.Transaction, options Options) (int, error) {
+	count := 0
+	byCurrency := make(map[string][]*domain.Transaction)
+	var currencies []string
+	for i := range transactions {
+		if transactions[i].Kind == domain.KindTransfer {
+			continue
+		}
+		currency := transactions[i].Currency
+		if _, ok := byCurrency[currency]; !ok {
+			currencies = append(currencies, currency)
+		}
+		byCurrency[currency] = append(byCurrency[currency], &transactions[i])
+		count++
+	}
+	sort.Strings(currencies)
+
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="no"?>` + "\n")
+	buf.WriteString(`<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n")
+	buf.WriteString("<OFX>\n")
+	fmt.Fprintf(&buf, "<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>"+
+		"<DTSERVER>%s</DTSERVER><LANGUAGE>ENG</LANGUAGE></SONRS></SIGNONMSGSRSV1>\n", time.Now().UTC().Format("20060102150405"))
+	buf.WriteString("<BANKMSGSRSV1>\n")
+
+	for i, currency := range currencies {
+		writeStatement(&buf, i+1, currency, byCurrency[currency], options)
+	}
+
+	buf.WriteString("</BANKMSGSRSV1>\n")
+	buf.WriteString("</OFX>\n")
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func writeStatement(buf *bytes.Buffer, uid int, currency string, transactions []*domain.Transaction, options Options) {
+	from, to := options.From, options.To
+	var balance domain.Money
+	for _, tx := range transactions {
+		if from.IsZero() || tx.Date.Before(from) {
+			from = tx.Date
+		}
+		if to.IsZero() || tx.Date.After(to) {
+			to = tx.Date
+		}
+		balance += signedAmount(tx)
+	}
+
+	fmt.Fprintf(buf, "<STMTTRNRS><TRNUID>%d</TRNUID><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n", uid)
+	fmt.Fprintf(buf, "<STMTRS><CURDEF>%s</CURDEF>\n", escape(currency))
+	fmt.Fprintf(buf, "<BANKACCTFROM><BANKID>ledger</BANKID><ACCTID>ledger-%s</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>\n", escape(currency))
+	fmt.Fprintf(buf, "<BANKTRANLIST><DTSTART>%s</DTSTART><DTEND>%s</DTEND>\n", from.Format(ofxDateLayout), to.Format(ofxDateLayout))
+
+	for _, tx := range transactions {
+		buf.WriteString("<STMTTRN>")
+		fmt.Fprintf(buf, "<TRNTYPE>%s</TRNTYPE>", transactionType(tx.Kind))
+		fmt.Fprintf(buf, "<DTPOSTED>%s</DTPOSTED>", tx.Date.Format(ofxDateLayout))
+		fmt.Fprintf(buf, "<TRNAMT>%s</TRNAMT>", signedAmount(tx))
+		fmt.Fprintf(buf, "<FITID>%s</FITID>", strconv.FormatInt(tx.ID, 10))
+		if name := ofxName(tx); name != "" {
+			fmt.Fprintf(buf, "<NAME>%s</NAME>", escape(name))
+		}
+		if tx.Category != "" {
+			fmt.Fprintf(buf, "<MEMO>%s</MEMO>", escape(tx.Category))
+		}
+		buf.WriteString("</STMTTRN>\n")
+	}
+
+	buf.WriteString("</BANKTRANLIST>\n")
+	fmt.Fprintf(buf, "<LEDGERBAL><BALAMT>%s</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>\n", balance, to.Format(ofxDateLayout))
+	buf.WriteString("</STMTRS></STMTTRNRS>\n")
+}
+
+func transactionType(kind string) string {
+	if kind == domain.KindExpense {
+		return "DEBIT"
+	}
+	return "CREDIT"
+}
+
+func ofxName(tx *domain.Transaction) string {
+	name := tx.Description
+	if name == "" {
+		name = tx.Category
+	}
+	if utf8.RuneCountInString(name) > maxOFXNameLength {
+		name = string([]rune(name)[:maxOFXNameLength])
+	}
+	return name
+}
+
+func escape(s string) string {
+	return html.EscapeString(s)
+}
@@ -0,0 +1,243 @@
+package export
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+const (
+	transactionsSheet = "Transactions"
+	summarySheet      = "Summary"
+)
+
+type xlsxExporter struct{}
+
+type currencySummary struct {
+	currency string
+	income   domain.Money
+	expense  domain.Money
+	count    int
+}
+
+type categorySummary struct {
+	category string
+	kind     string
+	currency string
+	total    domain.Money
+	count    int
+}
+
+func (xlsxExporter) ContentType() string {
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+
+func (xlsxExporter) Extension() string {
+	return "xlsx"
+}
+
+func (xlsxExporter) Export(w io.Writer, transactions []domain.Transaction, options Options) (int, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	dateFormat := "yyyy-mm-dd"
+	dateStyle, err := f.NewStyle(&excelize.Style{CustomNumFmt: &dateFormat})
+	if err != nil {
+		return 0, err
+	}
+	amountStyle, err := f.NewStyle(&excelize.Style{NumFmt: 4})
+	if err != nil {
+		return 0, err
+	}
+	headerStyle, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := f.SetSheetName("Sheet1", transactionsSheet); err != nil {
+		return 0, err
+	}
+	if err := writeTransactionsSheet(f, transactions, dateStyle, amountStyle, headerStyle); err != nil {
+		return 0, err
+	}
+	if _, err := f.NewSheet(summarySheet); err != nil {
+		return 0, err
+	}
+	if err := writeSummarySheet(f, transactions, options, dateStyle, amountStyle, headerStyle); err != nil {
+		return 0, err
+	}
+
+	if err := f.Write(w); err != nil {
+		return 0, err
+	}
+	return len(transactions), nil
+}
+
+func writeTransactionsSheet(f *excelize.File, transactions []domain.Transaction, dateStyle, amountStyle, headerStyle int) error {
+	header := []interface{}{"ID", "Date", "Kind", "Amount", "Currency", "Category", "Description", "Tags"}
+	if err := f.SetSheetRow(transactionsSheet, "A1", &header); err != nil {
+		return err
+	}
+	if err := f.SetCellStyle(transactionsSheet, "A1", "H1", headerStyle); err != nil {
+		return err
+	}
+
+	for i := range transactions {
+		tx := &transactions[i]
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+		row := []interface{}{tx.ID, tx.Date, tx.Kind, tx.Amount.Float64(), tx.Currency, tx.Category, tx.Description, domain.FormatTags(tx.Tags)}
+		if err := f.SetSheetRow(transactionsSheet, cell, &row); err != nil {
+			return err
+		}
+	}
+
+	if last := len(transactions) + 1; last > 1 {
+		if err := f.SetCellStyle(transactionsSheet, "B2", "B"+strconv.Itoa(last), dateStyle); err != nil {
+			return err
+		}
+		if err := f.SetCellStyle(transactionsSheet, "D2", "D"+strconv.Itoa(last), amountStyle); err != nil {
+			return err
+		}
+	}
+	if err := f.SetColWidth(transactionsSheet, "B", "B", 12); err != nil {
+		return err
+	}
+	if err := f.SetColWidth(transactionsSheet, "F", "G", 30); err != nil {
+		return err
+	}
+	return f.SetPanes(transactionsSheet, &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"})
+}
+
+func writeSummarySheet(f *excelize.File, transactions []domain.Transaction, options Options, dateStyle, amountStyle, headerStyle int) error {
+	currencies, categories := summarize(transactions)
+	row := 1
+	set := func(values ...interface{}) error {
+		cell, err := excelize.CoordinatesToCellName(1, row)
+		if err != nil {
+			return err
+		}
+		row++
+		return f.SetSheetRow(summarySheet, cell, &values)
+	}
+
+	if !options.From.IsZero() || !options.To.IsZero() {
+		if err := set("Period", dateCell(options.From), dateCell(options.To)); err != nil {
+			return err
+		}
+		if err := f.SetCellStyle(summarySheet, "B1", "C1", dateStyle); err != nil {
+			return err
+		}
+		row++
+	}
+
+	currencyHeader := row
+	if err := set("Currency", "Income", "Expense", "Net", "Transactions"); err != nil {
+		return err
+	}
+	for _, s := range currencies {
+		if err := set(s.currency, s.income.Float64(), s.expense.Float64(), (s.income - s.expense).Float64(), s.count); err != nil {
+			return err
+		}
+	}
+	if err := styleRange(f, currencyHeader, row-1, "B", "D", headerStyle, amountStyle); err != nil {
+		return err
+	}
+	row++
+
+	categoryHeader := row
+	if err := set("Category", "Kind", "Currency", "Total", "Transactions"); err != nil {
+		return err
+	}
+	for _, s := range categories {
+		if err := set(s.category, s.kind, s.currency, s.total.Float64(), s.count); err != nil {
+			return err
+		}
+	}
+	if err := styleRange(f, categoryHeader, row-1, "D", "D", headerStyle, amountStyle); err != nil {
+		return err
+	}
+	return f.SetColWidth(summarySheet, "A", "A", 30)
+}
+
+func styleRange(f *excelize.File, header, last int, fromCol, toCol string, headerStyle, amountStyle int) error {
+	if err := f.SetCellStyle(summarySheet, "A"+strconv.Itoa(header), "E"+strconv.Itoa(header), headerStyle); err != nil {
+		return err
+	}
+	if last <= header {
+		return nil
+	}
+	return f.SetCellStyle(summarySheet, fromCol+strconv.Itoa(header+1), toCol+strconv.Itoa(last), amountStyle)
+}
+
+func dateCell(date time.Time) interface{} {
+	if date.IsZero() {
+		return ""
+	}
+	return date
+}
+
+func summarize(transactions []domain.Transaction) ([]currencySummary, []categorySummary) {
+	currencies := make(map[string]*currencySummary)
+	categories := make(map[string]*categorySummary)
+
+	for i := range transactions {
+		tx := &transactions[i]
+		c, ok := currencies[tx.Currency]
+		if !ok {
+			c = &currencySummary{currency: tx.Currency}
+			currencies[tx.Currency] = c
+		}
+		c.count++
+		switch tx.Kind {
+		case domain.KindIncome:
+			c.income += tx.Amount
+		case domain.KindExpense:
+			c.expense += tx.Amount
+		}
+
+		names, amounts := tx.CategoryAmounts()
+		for _, name := range names {
+			key := name + "|" + tx.Kind + "|" + tx.Currency
+			s, ok := categories[key]
+			if !ok {
+				s = &categorySummary{category: name, kind: tx.Kind, currency: tx.Currency}
+				categories[key] = s
+			}
+			s.total += amounts[name]
+			s.count++
+		}
+	}
+
+	currencyList := make([]currencySummary, 0, len(currencies))
+	for _, c := range currencies {
+		currencyList = append(currencyList, *c)
+	}
+	sort.Slice(currencyList, func(i, j int) bool {
+		return currencyList[i].currency < currencyList[j].currency
+	})
+
+	categoryList := make([]categorySummary, 0, len(categories))
+	for _, s := range categories {
+		categoryList = append(categoryList, *s)
+	}
+	sort.Slice(categoryList, func(i, j int) bool {
+		a, b := categoryList[i], categoryList[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.total != b.total {
+			return a.total > b.total
+		}
+		return a.category+a.currency < b.category+b.currency
+	})
+
+	return currencyList, categoryList
+}
@@ -0,0 +1,235 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+)
+
+func (s *LedgerServer) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	openingBalance, err := moneyFromProto(req.GetOpeningBalanceDecimal(), req.GetOpeningBalance())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	account := &domain.Account{
+		UserID:         req.GetUserId(),
+		Name:           req.GetName(),
+		Type:           req.GetType(),
+		Currency:       req.GetCurrency(),
+		OpeningBalance: openingBalance,
+	}
+	if err := account.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.ledgerService.CreateAccount(ctx, account); err != nil {
+		return nil, accountStatus(err, "failed to create account")
+	}
+
+	return &pb.CreateAccountResponse{
+		Account: toProtoAccount(account),
+	}, nil
+}
+
+func (s *LedgerServer) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*pb.GetAccountsResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	accounts, err := s.ledgerService.GetAccounts(ctx, req.GetUserId(), req.GetIncludeArchived())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get accounts: %v", err)
+	}
+
+	protoAccounts := make([]*pb.Account, 0, len(accounts))
+	for i := range accounts {
+		protoAccounts = append(protoAccounts, toProtoAccount(&accounts[i]))
+	}
+
+	return &pb.GetAccountsResponse{
+		Accounts: protoAccounts,
+	}, nil
+}
+
+func (s *LedgerServer) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.UpdateAccountResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	openingBalance, err := moneyFromProto(req.GetOpeningBalanceDecimal(), req.GetOpeningBalance())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	account := &domain.Account{
+		ID:             req.GetId(),
+		UserID:         req.GetUserId(),
+		Name:           req.GetName(),
+		Type:           req.GetType(),
+		Currency:       req.GetCurrency(),
+		OpeningBalance: openingBalance,
+		Archived:       req.GetArchived(),
+	}
+	if err := account.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.ledgerService.UpdateAccount(ctx, account); err != nil {
+		return nil, accountStatus(err, "failed to update account")
+	}
+
+	return &pb.UpdateAccountResponse{
+		Account: toProtoAccount(account),
+	}, nil
+}
+
+func (s *LedgerServer) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.ledgerService.DeleteAccount(ctx, req.GetId(), req.GetUserId()); err != nil {
+		return nil, accountStatus(err, "failed to delete account")
+	}
+
+	return &pb.DeleteAccountResponse{}, nil
+}
+
+func (s *LedgerServer) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	amount, err := moneyFromProto(req.GetAmountDecimal(), req.GetAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	toAmount, err := moneyFromProto(req.GetToAmountDecimal(), req.GetToAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	transfer := &domain.Transfer{
+		UserID:        req.GetUserId(),
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Amount:        amount,
+		ToAmount:      toAmount,
+		Description:   req.GetDescription(),
+	}
+	if req.GetDate() != nil {
+		transfer.Date = req.GetDate().AsTime()
+	}
+	if err := transfer.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	entries, err := s.ledgerService.CreateTransfer(ctx, transfer)
+	if err != nil {
+		if errors.Is(err, domain.ErrExchangeRateNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, accountStatus(err, "failed to create transfer")
+	}
+
+	protoTxs := make([]*pb.Transaction, 0, len(entries))
+	for i := range entries {
+		protoTxs = append(protoTxs, toProtoTransaction(&entries[i]))
+	}
+
+	return &pb.CreateTransferResponse{
+		Transfer:     toProtoTransfer(transfer),
+		Transactions: protoTxs,
+	}, nil
+}
+
+func (s *LedgerServer) GetAccountBalances(ctx context.Context, req *pb.GetAccountBalancesRequest) (*pb.GetAccountBalancesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetAccountId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+	if req.GetFrom() == nil || req.GetTo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	from, to := req.GetFrom().AsTime(), req.GetTo().AsTime()
+	if _, err := domain.BalanceDates(from, to, req.GetInterval()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	account, points, err := s.ledgerService.GetAccountBalances(ctx, req.GetUserId(), req.GetAccountId(), from, to, req.GetInterval())
+	if err != nil {
+		return nil, accountStatus(err, "failed to get account balances")
+	}
+
+	balances := make([]*pb.BalancePoint, 0, len(points))
+	for _, p := range points {
+		balances = append(balances, &pb.BalancePoint{
+			Date:           timestamppb.New(p.Date),
+			Balance:        p.Balance.Float64(),
+			BalanceDecimal: p.Balance.String(),
+		})
+	}
+
+	return &pb.GetAccountBalancesResponse{
+		Account:  toProtoAccount(account),
+		Balances: balances,
+	}, nil
+}
+
+func isAccountConflict(err error) bool {
+	return errors.Is(err, service.ErrAccountArchived) || errors.Is(err, service.ErrCurrencyMismatch) ||
+		errors.Is(err, service.ErrTransferEntry)
+}
+
+func accountStatus(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrAccountNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrDuplicateAccount):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrAccountInUse), isAccountConflict(err):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func toProtoAccount(a *domain.Account) *pb.Account {
+	return &pb.Account{
+		Id:                    a.ID,
+		UserId:                a.UserID,
+		Name:                  a.Name,
+		Type:                  a.Type,
+		Currency:              a.Currency,
+		OpeningBalance:        a.OpeningBalance.Float64(),
+		OpeningBalanceDecimal: a.OpeningBalance.String(),
+		Balance:               a.Balance.Float64(),
+		BalanceDecimal:        a.Balance.String(),
+		Archived:              a.Archived,
+		CreatedAt:             timestamppb.New(a.CreatedAt),
+	}
+}
+
+func toProtoTransfer(t *domain.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:              t.ID,
+		UserId:          t.UserID,
+		FromAccountId:   t.FromAccountID,
+		ToAccountId:     t.ToAccountID,
+		Amount:          t.Amount.Float64(),
+		AmountDecimal:   t.Amount.String(),
+		ToAmount:        t.ToAmount.Float64(),
+		ToAmountDecimal: t.ToAmount.String(),
+		Description:     t.Description,
+		Date:            timestamppb.New(t.Date),
+		CreatedAt:       timestamppb.New(t.CreatedAt),
+	}
+}
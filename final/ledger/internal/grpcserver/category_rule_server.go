@@ -0,0 +1,173 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+)
+
+type categoryRuleRequest interface {
+	GetUserId() int64
+	GetMatchType() string
+	GetPattern() string
+	GetMinAmount() float64
+	GetMinAmountDecimal() string
+	GetMaxAmount() float64
+	GetMaxAmountDecimal() string
+	GetCategory() string
+	GetTags() []string
+}
+
+func (s *LedgerServer) CreateCategoryRule(ctx context.Context, req *pb.CreateCategoryRuleRequest) (*pb.CreateCategoryRuleResponse, error) {
+	rule, err := categoryRuleFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	rule.Priority = int(req.GetPriority())
+
+	if err := s.ledgerService.CreateCategoryRule(ctx, rule); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create category rule: %v", err)
+	}
+
+	return &pb.CreateCategoryRuleResponse{
+		Rule: toProtoCategoryRule(rule),
+	}, nil
+}
+
+func (s *LedgerServer) GetCategoryRules(ctx context.Context, req *pb.GetCategoryRulesRequest) (*pb.GetCategoryRulesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	rules, err := s.ledgerService.GetCategoryRules(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get category rules: %v", err)
+	}
+
+	protoRules := make([]*pb.CategoryRule, 0, len(rules))
+	for i := range rules {
+		protoRules = append(protoRules, toProtoCategoryRule(&rules[i]))
+	}
+
+	return &pb.GetCategoryRulesResponse{
+		Rules: protoRules,
+	}, nil
+}
+
+func (s *LedgerServer) UpdateCategoryRule(ctx context.Context, req *pb.UpdateCategoryRuleRequest) (*pb.UpdateCategoryRuleResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	rule, err := categoryRuleFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = req.GetId()
+	rule.Priority = int(req.GetPriority())
+
+	if err := s.ledgerService.UpdateCategoryRule(ctx, rule); err != nil {
+		if errors.Is(err, service.ErrCategoryRuleNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update category rule: %v", err)
+	}
+
+	return &pb.UpdateCategoryRuleResponse{
+		Rule: toProtoCategoryRule(rule),
+	}, nil
+}
+
+func (s *LedgerServer) DeleteCategoryRule(ctx context.Context, req *pb.DeleteCategoryRuleRequest) (*pb.DeleteCategoryRuleResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.ledgerService.DeleteCategoryRule(ctx, req.GetId(), req.GetUserId()); err != nil {
+		if errors.Is(err, service.ErrCategoryRuleNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete category rule: %v", err)
+	}
+
+	return &pb.DeleteCategoryRuleResponse{}, nil
+}
+
+func (s *LedgerServer) TestCategoryRule(ctx context.Context, req *pb.TestCategoryRuleRequest) (*pb.TestCategoryRuleResponse, error) {
+	rule, err := categoryRuleFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.ledgerService.TestCategoryRule(ctx, rule, timeFromProto(req.GetFrom()), timeFromProto(req.GetTo()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to test category rule: %v", err)
+	}
+
+	samples := make([]*pb.Transaction, 0, len(result.Samples))
+	for i := range result.Samples {
+		samples = append(samples, toProtoTransaction(&result.Samples[i]))
+	}
+
+	return &pb.TestCategoryRuleResponse{
+		MatchedCount: int32(result.Matched),
+		ChangedCount: int32(result.Changed),
+		Samples:      samples,
+	}, nil
+}
+
+func categoryRuleFromProto(req categoryRuleRequest) (*domain.CategoryRule, error) {
+	minAmount, err := optionalMoneyFromProto(req.GetMinAmountDecimal(), req.GetMinAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	maxAmount, err := optionalMoneyFromProto(req.GetMaxAmountDecimal(), req.GetMaxAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rule := &domain.CategoryRule{
+		UserID:    req.GetUserId(),
+		MatchType: req.GetMatchType(),
+		Pattern:   req.GetPattern(),
+		MinAmount: minAmount,
+		MaxAmount: maxAmount,
+		Category:  req.GetCategory(),
+		Tags:      req.GetTags(),
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return rule, nil
+}
+
+func toProtoCategoryRule(rule *domain.CategoryRule) *pb.CategoryRule {
+	protoRule := &pb.CategoryRule{
+		Id:        rule.ID,
+		UserId:    rule.UserID,
+		MatchType: rule.MatchType,
+		Pattern:   rule.Pattern,
+		Category:  rule.Category,
+		Tags:      rule.Tags,
+		Priority:  int32(rule.Priority),
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+	if rule.MinAmount != nil {
+		protoRule.MinAmount = rule.MinAmount.Float64()
+		protoRule.MinAmountDecimal = rule.MinAmount.String()
+	}
+	if rule.MaxAmount != nil {
+		protoRule.MaxAmount = rule.MaxAmount.Float64()
+		protoRule.MaxAmountDecimal = rule.MaxAmount.String()
+	}
+	return protoRule
+}
@@ -0,0 +1,150 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+)
+
+func (s *LedgerServer) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	category := &domain.Category{
+		UserID:   req.GetUserId(),
+		Name:     req.GetName(),
+		ParentID: idFromProto(req.GetParentId()),
+	}
+	if err := category.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.ledgerService.CreateCategory(ctx, category); err != nil {
+		return nil, categoryStatus(err, "failed to create category")
+	}
+
+	return &pb.CreateCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
+
+func (s *LedgerServer) GetCategories(ctx context.Context, req *pb.GetCategoriesRequest) (*pb.GetCategoriesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	categories, err := s.ledgerService.GetCategories(ctx, req.GetUserId(), req.GetIncludeArchived())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get categories: %v", err)
+	}
+
+	protoCategories := make([]*pb.Category, 0, len(categories))
+	for i := range categories {
+		protoCategories = append(protoCategories, toProtoCategory(&categories[i]))
+	}
+
+	return &pb.GetCategoriesResponse{
+		Categories: protoCategories,
+	}, nil
+}
+
+func (s *LedgerServer) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	category := &domain.Category{
+		ID:       req.GetId(),
+		UserID:   req.GetUserId(),
+		Name:     req.GetName(),
+		ParentID: idFromProto(req.GetParentId()),
+		Archived: req.GetArchived(),
+	}
+	if err := category.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.ledgerService.UpdateCategory(ctx, category); err != nil {
+		return nil, categoryStatus(err, "failed to update category")
+	}
+
+	return &pb.UpdateCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
+
+func (s *LedgerServer) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.ledgerService.DeleteCategory(ctx, req.GetId(), req.GetUserId()); err != nil {
+		return nil, categoryStatus(err, "failed to delete category")
+	}
+
+	return &pb.DeleteCategoryResponse{}, nil
+}
+
+func (s *LedgerServer) MergeCategories(ctx context.Context, req *pb.MergeCategoriesRequest) (*pb.MergeCategoriesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetSourceId() <= 0 || req.GetTargetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "source_id and target_id are required")
+	}
+	if req.GetSourceId() == req.GetTargetId() {
+		return nil, status.Error(codes.InvalidArgument, "cannot merge category into itself")
+	}
+
+	category, moved, err := s.ledgerService.MergeCategories(ctx, req.GetUserId(), req.GetSourceId(), req.GetTargetId())
+	if err != nil {
+		return nil, categoryStatus(err, "failed to merge categories")
+	}
+
+	return &pb.MergeCategoriesResponse{
+		Category:          toProtoCategory(category),
+		MovedTransactions: moved,
+	}, nil
+}
+
+func categoryStatus(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrDuplicateCategory):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrInvalidCategoryParent):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrCategoryInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func idFromProto(id int64) *int64 {
+	if id <= 0 {
+		return nil
+	}
+	return &id
+}
+
+func toProtoCategory(c *domain.Category) *pb.Category {
+	protoCategory := &pb.Category{
+		Id:        c.ID,
+		UserId:    c.UserID,
+		Name:      c.Name,
+		Archived:  c.Archived,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+	if c.ParentID != nil {
+		protoCategory.ParentId = *c.ParentID
+	}
+	return protoCategory
+}
@@ -0,0 +1,72 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+)
+
+func (s *LedgerServer) CompareReports(ctx context.Context, req *pb.CompareReportsRequest) (*pb.CompareReportsResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetFrom() == nil || req.GetTo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to dates are required")
+	}
+	from := req.GetFrom().AsTime()
+	to := req.GetTo().AsTime()
+	if _, _, err := domain.BaselinePeriod(from, to, req.GetBaseline()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	comparison, err := s.ledgerService.CompareReports(ctx, req.GetUserId(), from, to, req.GetBaseline())
+	if err != nil {
+		if errors.Is(err, domain.ErrExchangeRateNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to compare reports: %v", err)
+	}
+
+	protoCategories := make([]*pb.CategoryComparison, 0, len(comparison.Categories))
+	for _, c := range comparison.Categories {
+		protoCategories = append(protoCategories, &pb.CategoryComparison{
+			Category: c.Category,
+			Parent:   c.Parent,
+			Change:   toProtoPeriodChange(c.Change),
+			Status:   c.Status,
+		})
+	}
+
+	return &pb.CompareReportsResponse{
+		Categories:            protoCategories,
+		From:                  req.GetFrom(),
+		To:                    req.GetTo(),
+		Baseline:              comparison.Baseline,
+		BaselineFrom:          timestamppb.New(comparison.BaselineFrom),
+		BaselineTo:            timestamppb.New(comparison.BaselineTo),
+		BaseCurrency:          comparison.BaseCurrency,
+		Expenses:              toProtoPeriodChange(comparison.Expenses),
+		Income:                toProtoPeriodChange(comparison.Income),
+		NetBalance:            toProtoPeriodChange(comparison.NetBalance),
+		NewCategories:         comparison.NewCategories,
+		DisappearedCategories: comparison.DisappearedCategories,
+	}, nil
+}
+
+func toProtoPeriodChange(change domain.PeriodChange) *pb.PeriodChange {
+	return &pb.PeriodChange{
+		Current:         change.Current.Float64(),
+		Previous:        change.Previous.Float64(),
+		Delta:           change.Delta.Float64(),
+		DeltaPercent:    change.DeltaPercent,
+		CurrentDecimal:  change.Current.String(),
+		PreviousDecimal: change.Previous.String(),
+		DeltaDecimal:    change.Delta.String(),
+	}
+}
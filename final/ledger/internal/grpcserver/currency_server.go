@@ -0,0 +1,132 @@
+package grpcserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/csv"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+)
+
+func (s *LedgerServer) GetSettings(ctx context.Context, req *pb.GetSettingsRequest) (*pb.GetSettingsResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	settings, err := s.ledgerService.GetSettings(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get settings: %v", err)
+	}
+
+	return &pb.GetSettingsResponse{
+		BaseCurrency: settings.BaseCurrency,
+	}, nil
+}
+
+func (s *LedgerServer) SetBaseCurrency(ctx context.Context, req *pb.SetBaseCurrencyRequest) (*pb.SetBaseCurrencyResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if _, err := domain.NormalizeCurrency(req.GetBaseCurrency()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	settings, err := s.ledgerService.SetBaseCurrency(ctx, req.GetUserId(), req.GetBaseCurrency())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set base currency: %v", err)
+	}
+
+	return &pb.SetBaseCurrencyResponse{
+		BaseCurrency: settings.BaseCurrency,
+	}, nil
+}
+
+func (s *LedgerServer) SetExchangeRates(ctx context.Context, req *pb.SetExchangeRatesRequest) (*pb.SetExchangeRatesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if len(req.GetRates()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "rates are required")
+	}
+
+	rates := make([]domain.ExchangeRate, 0, len(req.GetRates()))
+	for i, r := range req.GetRates() {
+		rate := domain.ExchangeRate{
+			UserID:        req.GetUserId(),
+			BaseCurrency:  r.GetBaseCurrency(),
+			QuoteCurrency: r.GetQuoteCurrency(),
+			Rate:          r.GetRate(),
+		}
+		if r.GetDate() != nil {
+			rate.Date = r.GetDate().AsTime()
+		}
+		if err := rate.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "rate %d: %v", i+1, err)
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := s.ledgerService.SetExchangeRates(ctx, req.GetUserId(), rates); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set exchange rates: %v", err)
+	}
+
+	return &pb.SetExchangeRatesResponse{
+		SavedCount: int32(len(rates)),
+	}, nil
+}
+
+func (s *LedgerServer) ImportExchangeRates(ctx context.Context, req *pb.ImportExchangeRatesRequest) (*pb.ImportExchangeRatesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if len(req.GetCsvData()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "csv_data is required")
+	}
+
+	rates, parseErrors, err := csv.ParseRatesCSV(req.GetCsvData(), req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse CSV: %v", err)
+	}
+
+	if len(rates) > 0 {
+		if err := s.ledgerService.SetExchangeRates(ctx, req.GetUserId(), rates); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to import exchange rates: %v", err)
+		}
+	}
+
+	return &pb.ImportExchangeRatesResponse{
+		ImportedCount: int32(len(rates)),
+		SkippedCount:  int32(len(parseErrors)),
+		Errors:        parseErrors,
+	}, nil
+}
+
+func (s *LedgerServer) GetExchangeRates(ctx context.Context, req *pb.GetExchangeRatesRequest) (*pb.GetExchangeRatesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	rates, err := s.ledgerService.GetExchangeRates(ctx, req.GetUserId(),
+		timeFromProto(req.GetFrom()), timeFromProto(req.GetTo()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get exchange rates: %v", err)
+	}
+
+	protoRates := make([]*pb.ExchangeRate, 0, len(rates))
+	for _, r := range rates {
+		protoRates = append(protoRates, &pb.ExchangeRate{
+			BaseCurrency:  r.BaseCurrency,
+			QuoteCurrency: r.QuoteCurrency,
+			Date:          timestamppb.New(r.Date),
+			Rate:          r.Rate,
+		})
+	}
+
+	return &pb.GetExchangeRatesResponse{
+		Rates: protoRates,
+	}, nil
+}
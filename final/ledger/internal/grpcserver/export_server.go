@@ -0,0 +1,77 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/export"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+)
+
+func (s *LedgerServer) ExportTransactions(ctx context.Context, req *pb.ExportTransactionsRequest) (*pb.ExportTransactionsResponse, error) {
+	exporter, filter, options, err := exportFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, _, err := s.ledgerService.GetTransactions(ctx, req.GetUserId(), filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transactions: %v", err)
+	}
+
+	var buf bytes.Buffer
+	count, err := exporter.Export(&buf, transactions, options)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export transactions: %v", err)
+	}
+
+	return &pb.ExportTransactionsResponse{
+		Data:        buf.Bytes(),
+		ContentType: exporter.ContentType(),
+		Filename:    export.Filename(exporter, options.From, options.To),
+		RowsCount:   int32(count),
+	}, nil
+}
+
+func exportFromProto(req *pb.ExportTransactionsRequest) (export.Exporter, domain.TransactionFilter, export.Options, error) {
+	var filter domain.TransactionFilter
+	var options export.Options
+	if req.GetUserId() <= 0 {
+		return nil, filter, options, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	format, err := domain.NormalizeExportFormat(req.GetFormat())
+	if err != nil {
+		return nil, filter, options, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.GetColumns()) > 0 {
+		if format != domain.FormatCSV {
+			return nil, filter, options, status.Error(codes.InvalidArgument, "columns are supported only for csv")
+		}
+		if _, err := domain.NormalizeExportColumns(req.GetColumns()); err != nil {
+			return nil, filter, options, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	exporter, err := export.Get(format)
+	if err != nil {
+		return nil, filter, options, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter = domain.TransactionFilter{
+		From: timeFromProto(req.GetFrom()),
+		To:   timeFromProto(req.GetTo()),
+		Sort: domain.SortDateAsc,
+	}
+	options.Columns = req.GetColumns()
+	if filter.From != nil {
+		options.From = *filter.From
+	}
+	if filter.To != nil {
+		options.To = *filter.To
+	}
+	return exporter, filter, options, nil
+}
@@ -0,0 +1,154 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+)
+
+const watchImportInterval = 500 * time.Millisecond
+
+func (s *LedgerServer) SubmitImport(ctx context.Context, req *pb.SubmitImportRequest) (*pb.SubmitImportResponse, error) {
+	job := &domain.ImportJob{
+		UserID:        req.GetUserId(),
+		Format:        req.GetFormat(),
+		Profile:       req.GetProfile(),
+		DuplicateMode: req.GetDuplicateMode(),
+		Atomic:        req.GetAtomic(),
+		Data:          req.GetData(),
+	}
+	if err := job.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.ledgerService.SubmitImportJob(ctx, job); err != nil {
+		return nil, importJobStatus(err, "failed to submit import")
+	}
+
+	return &pb.SubmitImportResponse{
+		Job: toProtoImportJob(job),
+	}, nil
+}
+
+func (s *LedgerServer) GetImport(ctx context.Context, req *pb.GetImportRequest) (*pb.GetImportResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	job, err := s.ledgerService.GetImportJob(ctx, req.GetId(), req.GetUserId())
+	if err != nil {
+		return nil, importJobStatus(err, "failed to get import")
+	}
+	rowErrors, err := s.ledgerService.GetImportJobErrors(ctx, job.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get import errors: %v", err)
+	}
+
+	resp := &pb.GetImportResponse{
+		Job: toProtoImportJob(job),
+	}
+	for _, rowError := range rowErrors {
+		resp.Errors = append(resp.Errors, &pb.ImportJobError{
+			Line:  int32(rowError.Line),
+			Error: rowError.Message,
+		})
+	}
+	return resp, nil
+}
+
+func (s *LedgerServer) CancelImport(ctx context.Context, req *pb.CancelImportRequest) (*pb.CancelImportResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	job, err := s.ledgerService.CancelImportJob(ctx, req.GetId(), req.GetUserId())
+	if err != nil {
+		return nil, importJobStatus(err, "failed to cancel import")
+	}
+
+	return &pb.CancelImportResponse{
+		Job: toProtoImportJob(job),
+	}, nil
+}
+
+func (s *LedgerServer) WatchImport(req *pb.WatchImportRequest, stream pb.LedgerService_WatchImportServer) error {
+	if req.GetUserId() <= 0 {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(watchImportInterval)
+	defer ticker.Stop()
+
+	var last *domain.ImportJob
+	for {
+		job, err := s.ledgerService.GetImportJob(ctx, req.GetId(), req.GetUserId())
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return importJobStatus(err, "failed to get import")
+		}
+
+		if last == nil || job.Status != last.Status || !job.UpdatedAt.Equal(last.UpdatedAt) {
+			if err := stream.Send(&pb.WatchImportResponse{Job: toProtoImportJob(job)}); err != nil {
+				return err
+			}
+			last = job
+		}
+		if job.Finished() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func importJobStatus(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrImportJobNotFound), errors.Is(err, service.ErrImportProfileNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrImportJobFinished):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func toProtoImportJob(job *domain.ImportJob) *pb.ImportJob {
+	protoJob := &pb.ImportJob{
+		Id:             job.ID,
+		UserId:         job.UserID,
+		Status:         job.Status,
+		Format:         job.Format,
+		Profile:        job.Profile,
+		DuplicateMode:  job.DuplicateMode,
+		Atomic:         job.Atomic,
+		TotalRows:      int32(job.TotalRows),
+		ProcessedRows:  int32(job.ProcessedRows),
+		ImportedCount:  int32(job.ImportedRows),
+		DuplicateCount: int32(job.DuplicateRows),
+		FailedCount:    int32(job.FailedRows),
+		Error:          job.Error,
+		CreatedAt:      timestamppb.New(job.CreatedAt),
+		UpdatedAt:      timestamppb.New(job.UpdatedAt),
+	}
+	if job.StartedAt != nil {
+		protoJob.StartedAt = timestamppb.New(*job.StartedAt)
+	}
+	if job.FinishedAt != nil {
+		protoJob.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	return protoJob
+}
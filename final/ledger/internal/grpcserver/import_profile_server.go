@@ -0,0 +1,136 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+)
+
+type importProfileRequest interface {
+	GetUserId() int64
+	GetName() string
+	GetDelimiter() string
+	GetDecimalComma() bool
+	GetDateLayouts() []string
+	GetEncoding() string
+	GetSignConvention() string
+	GetColumns() map[string]string
+}
+
+func (s *LedgerServer) CreateImportProfile(ctx context.Context, req *pb.CreateImportProfileRequest) (*pb.CreateImportProfileResponse, error) {
+	profile, err := importProfileFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ledgerService.CreateImportProfile(ctx, profile); err != nil {
+		return nil, importProfileStatus(err, "failed to create import profile")
+	}
+
+	return &pb.CreateImportProfileResponse{
+		Profile: toProtoImportProfile(profile),
+	}, nil
+}
+
+func (s *LedgerServer) GetImportProfiles(ctx context.Context, req *pb.GetImportProfilesRequest) (*pb.GetImportProfilesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	profiles, err := s.ledgerService.GetImportProfiles(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get import profiles: %v", err)
+	}
+
+	protoProfiles := make([]*pb.ImportProfile, 0, len(profiles))
+	for i := range profiles {
+		protoProfiles = append(protoProfiles, toProtoImportProfile(&profiles[i]))
+	}
+
+	return &pb.GetImportProfilesResponse{
+		Profiles: protoProfiles,
+	}, nil
+}
+
+func (s *LedgerServer) UpdateImportProfile(ctx context.Context, req *pb.UpdateImportProfileRequest) (*pb.UpdateImportProfileResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	profile, err := importProfileFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	profile.ID = req.GetId()
+
+	if err := s.ledgerService.UpdateImportProfile(ctx, profile); err != nil {
+		return nil, importProfileStatus(err, "failed to update import profile")
+	}
+
+	return &pb.UpdateImportProfileResponse{
+		Profile: toProtoImportProfile(profile),
+	}, nil
+}
+
+func (s *LedgerServer) DeleteImportProfile(ctx context.Context, req *pb.DeleteImportProfileRequest) (*pb.DeleteImportProfileResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.ledgerService.DeleteImportProfile(ctx, req.GetId(), req.GetUserId()); err != nil {
+		return nil, importProfileStatus(err, "failed to delete import profile")
+	}
+
+	return &pb.DeleteImportProfileResponse{}, nil
+}
+
+func importProfileFromProto(req importProfileRequest) (*domain.ImportProfile, error) {
+	profile := &domain.ImportProfile{
+		UserID:         req.GetUserId(),
+		Name:           req.GetName(),
+		Delimiter:      req.GetDelimiter(),
+		DecimalComma:   req.GetDecimalComma(),
+		DateLayouts:    req.GetDateLayouts(),
+		Encoding:       req.GetEncoding(),
+		SignConvention: req.GetSignConvention(),
+		Columns:        req.GetColumns(),
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return profile, nil
+}
+
+func importProfileStatus(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrImportProfileNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrDuplicateImportProfile):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func toProtoImportProfile(p *domain.ImportProfile) *pb.ImportProfile {
+	return &pb.ImportProfile{
+		Id:             p.ID,
+		UserId:         p.UserID,
+		Name:           p.Name,
+		Delimiter:      p.Delimiter,
+		DecimalComma:   p.DecimalComma,
+		DateLayouts:    p.DateLayouts,
+		Encoding:       p.Encoding,
+		SignConvention: p.SignConvention,
+		Columns:        p.Columns,
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+	}
+}
@@ -1,18 +1,22 @@
 package grpcserver
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"github.com/mikhailmogilnikov/go/final/ledger/internal/csv"
 	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/export"
 	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
 	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/statement"
 )
 
 type LedgerServer struct {
@@ -28,26 +32,54 @@ func (s *LedgerServer) AddTransaction(ctx context.Context, req *pb.AddTransactio
 	if req.GetUserId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
-	if req.GetAmount() <= 0 {
+	amount, err := moneyFromProto(req.GetAmountDecimal(), req.GetAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if amount <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
 	}
-	if req.GetCategory() == "" {
-		return nil, status.Error(codes.InvalidArgument, "category is required")
+	if !domain.ValidKind(req.GetKind()) {
+		return nil, status.Error(codes.InvalidArgument, "kind must be expense, income or transfer")
+	}
+	if req.GetCurrency() != "" {
+		if _, err := domain.NormalizeCurrency(req.GetCurrency()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if _, err := domain.NormalizeTags(req.GetTags()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	splits, err := splitsFromProto(req.GetSplits())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	tx := &domain.Transaction{
 		UserID:      req.GetUserId(),
-		Amount:      req.GetAmount(),
+		Kind:        req.GetKind(),
+		Amount:      amount,
+		Currency:    req.GetCurrency(),
 		Category:    req.GetCategory(),
 		Description: req.GetDescription(),
+		Tags:        req.GetTags(),
+		Splits:      splits,
+		AccountID:   idFromProto(req.GetAccountId()),
 	}
 	if req.GetDate() != nil {
 		tx.Date = req.GetDate().AsTime()
 	}
 
-	budgetWarning, err := s.ledgerService.AddTransaction(ctx, tx)
+	check, err := s.ledgerService.AddTransaction(ctx, tx)
 	if err != nil {
-		if errors.Is(err, service.ErrBudgetExceeded) {
+		if errors.Is(err, service.ErrInvalidTransaction) || errors.Is(err, service.ErrNoCategoryRule) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, service.ErrAccountNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, service.ErrBudgetExceeded) || errors.Is(err, domain.ErrExchangeRateNotFound) ||
+			errors.Is(err, service.ErrCategoryArchived) || isAccountConflict(err) {
 			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to add transaction: %v", err)
@@ -55,8 +87,8 @@ func (s *LedgerServer) AddTransaction(ctx context.Context, req *pb.AddTransactio
 
 	return &pb.AddTransactionResponse{
 		Transaction:    toProtoTransaction(tx),
-		BudgetExceeded: false,
-		BudgetWarning:  budgetWarning,
+		BudgetExceeded: check.Exceeded,
+		BudgetWarning:  check.Warning,
 	}, nil
 }
 
@@ -65,8 +97,37 @@ func (s *LedgerServer) GetTransactions(ctx context.Context, req *pb.GetTransacti
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
 
-	transactions, err := s.ledgerService.GetTransactions(ctx, req.GetUserId(),
-		timeFromProto(req.GetFrom()), timeFromProto(req.GetTo()), req.GetCategory())
+	filter := domain.TransactionFilter{
+		From:      timeFromProto(req.GetFrom()),
+		To:        timeFromProto(req.GetTo()),
+		Category:  req.GetCategory(),
+		Tags:      req.GetTags(),
+		TagMatch:  req.GetTagMatch(),
+		AccountID: req.GetAccountId(),
+		Query:     req.GetQuery(),
+		Currency:  req.GetCurrency(),
+		Sort:      req.GetSort(),
+		Limit:     int(req.GetPageSize()),
+	}
+	var err error
+	if filter.MinAmount, err = optionalMoneyFromProto(req.GetMinAmountDecimal(), req.GetMinAmount()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if filter.MaxAmount, err = optionalMoneyFromProto(req.GetMaxAmountDecimal(), req.GetMaxAmount()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetPageToken() != "" {
+		cursor, err := domain.ParseTransactionCursor(req.GetPageToken())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.After = cursor
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	transactions, nextPageToken, err := s.ledgerService.GetTransactions(ctx, req.GetUserId(), filter)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get transactions: %v", err)
 	}
@@ -77,10 +138,100 @@ func (s *LedgerServer) GetTransactions(ctx context.Context, req *pb.GetTransacti
 	}
 
 	return &pb.GetTransactionsResponse{
-		Transactions: protoTxs,
+		Transactions:  protoTxs,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *LedgerServer) UpdateTransaction(ctx context.Context, req *pb.UpdateTransactionRequest) (*pb.UpdateTransactionResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	amount, err := moneyFromProto(req.GetAmountDecimal(), req.GetAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if req.GetCategory() == "" && len(req.GetSplits()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+	if !domain.ValidKind(req.GetKind()) {
+		return nil, status.Error(codes.InvalidArgument, "kind must be expense, income or transfer")
+	}
+	if req.GetCurrency() != "" {
+		if _, err := domain.NormalizeCurrency(req.GetCurrency()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if _, err := domain.NormalizeTags(req.GetTags()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	splits, err := splitsFromProto(req.GetSplits())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tx := &domain.Transaction{
+		ID:          req.GetId(),
+		UserID:      req.GetUserId(),
+		Kind:        req.GetKind(),
+		Amount:      amount,
+		Currency:    req.GetCurrency(),
+		Category:    req.GetCategory(),
+		Description: req.GetDescription(),
+		Tags:        req.GetTags(),
+		Splits:      splits,
+		AccountID:   idFromProto(req.GetAccountId()),
+	}
+	if req.GetDate() != nil {
+		tx.Date = req.GetDate().AsTime()
+	}
+
+	check, err := s.ledgerService.UpdateTransaction(ctx, tx)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidTransaction) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, service.ErrTransactionNotFound) || errors.Is(err, service.ErrAccountNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, service.ErrBudgetExceeded) || errors.Is(err, domain.ErrExchangeRateNotFound) ||
+			errors.Is(err, service.ErrCategoryArchived) || isAccountConflict(err) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update transaction: %v", err)
+	}
+
+	return &pb.UpdateTransactionResponse{
+		Transaction:    toProtoTransaction(tx),
+		BudgetExceeded: check.Exceeded,
+		BudgetWarning:  check.Warning,
 	}, nil
 }
 
+func (s *LedgerServer) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionRequest) (*pb.DeleteTransactionResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.ledgerService.DeleteTransaction(ctx, req.GetId(), req.GetUserId()); err != nil {
+		if errors.Is(err, service.ErrTransactionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete transaction: %v", err)
+	}
+
+	return &pb.DeleteTransactionResponse{}, nil
+}
+
 func (s *LedgerServer) SetBudget(ctx context.Context, req *pb.SetBudgetRequest) (*pb.SetBudgetResponse, error) {
 	if req.GetUserId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
@@ -88,18 +239,33 @@ func (s *LedgerServer) SetBudget(ctx context.Context, req *pb.SetBudgetRequest)
 	if req.GetCategory() == "" {
 		return nil, status.Error(codes.InvalidArgument, "category is required")
 	}
-	if req.GetLimitAmount() <= 0 {
+	limit, err := moneyFromProto(req.GetLimitAmountDecimal(), req.GetLimitAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if limit <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "limit_amount must be positive")
 	}
 
 	budget := &domain.Budget{
 		UserID:      req.GetUserId(),
 		Category:    req.GetCategory(),
-		LimitAmount: req.GetLimitAmount(),
+		LimitAmount: limit,
+		Currency:    req.GetCurrency(),
 		Period:      req.GetPeriod(),
+		StartDay:    int(req.GetStartDay()),
+		Rollover:    req.GetRollover(),
+		Enforcement: req.GetEnforcement(),
+		Thresholds:  intsFromProto(req.GetWarningThresholds()),
+	}
+	if err := budget.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	if err := s.ledgerService.SetBudget(ctx, budget); err != nil {
+		if errors.Is(err, service.ErrCategoryArchived) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to set budget: %v", err)
 	}
 
@@ -128,6 +294,88 @@ func (s *LedgerServer) GetBudgets(ctx context.Context, req *pb.GetBudgetsRequest
 	}, nil
 }
 
+func (s *LedgerServer) GetBudgetHistory(ctx context.Context, req *pb.GetBudgetHistoryRequest) (*pb.GetBudgetHistoryResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetCategory() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	versions, err := s.ledgerService.GetBudgetHistory(ctx, req.GetUserId(), req.GetCategory())
+	if err != nil {
+		if errors.Is(err, service.ErrBudgetNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get budget history: %v", err)
+	}
+
+	protoVersions := make([]*pb.Budget, 0, len(versions))
+	for i := range versions {
+		protoVersions = append(protoVersions, toProtoBudget(&versions[i]))
+	}
+
+	return &pb.GetBudgetHistoryResponse{
+		Versions: protoVersions,
+	}, nil
+}
+
+func (s *LedgerServer) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.DeleteBudgetResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetCategory() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	if err := s.ledgerService.DeleteBudget(ctx, req.GetUserId(), req.GetCategory()); err != nil {
+		if errors.Is(err, service.ErrBudgetNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete budget: %v", err)
+	}
+
+	return &pb.DeleteBudgetResponse{}, nil
+}
+
+func (s *LedgerServer) GetBudgetStatus(ctx context.Context, req *pb.GetBudgetStatusRequest) (*pb.GetBudgetStatusResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	statuses, err := s.ledgerService.GetBudgetStatus(ctx, req.GetUserId(), time.Now())
+	if err != nil {
+		if errors.Is(err, domain.ErrExchangeRateNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get budget status: %v", err)
+	}
+
+	protoStatuses := make([]*pb.BudgetStatus, 0, len(statuses))
+	for i := range statuses {
+		st := &statuses[i]
+		protoStatuses = append(protoStatuses, &pb.BudgetStatus{
+			Budget:           toProtoBudget(&st.Budget),
+			Currency:         st.Currency,
+			PeriodFrom:       timestamppb.New(st.PeriodFrom),
+			PeriodTo:         timestamppb.New(st.PeriodTo),
+			Limit:            st.Limit.Float64(),
+			Spent:            st.Spent.Float64(),
+			Remaining:        st.Remaining.Float64(),
+			Percentage:       st.Percentage,
+			Projected:        st.Projected.Float64(),
+			LimitDecimal:     st.Limit.String(),
+			SpentDecimal:     st.Spent.String(),
+			RemainingDecimal: st.Remaining.String(),
+			ProjectedDecimal: st.Projected.String(),
+		})
+	}
+
+	return &pb.GetBudgetStatusResponse{
+		Statuses: protoStatuses,
+	}, nil
+}
+
 func (s *LedgerServer) GetReport(ctx context.Context, req *pb.GetReportRequest) (*pb.GetReportResponse, error) {
 	if req.GetUserId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
@@ -139,29 +387,90 @@ func (s *LedgerServer) GetReport(ctx context.Context, req *pb.GetReportRequest)
 	from := req.GetFrom().AsTime()
 	to := req.GetTo().AsTime()
 
-	categories, totalExpenses, err := s.ledgerService.GetReport(ctx, req.GetUserId(), from, to)
+	report, err := s.ledgerService.GetReport(ctx, req.GetUserId(), from, to)
 	if err != nil {
+		if errors.Is(err, domain.ErrExchangeRateNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get report: %v", err)
 	}
 
-	protoCategories := make([]*pb.CategorySummary, 0, len(categories))
-	for _, c := range categories {
+	protoCategories := make([]*pb.CategorySummary, 0, len(report.Categories))
+	for _, c := range report.Categories {
 		protoCategories = append(protoCategories, &pb.CategorySummary{
-			Category:         c.Category,
-			Total:            c.Total,
-			BudgetLimit:      c.BudgetLimit,
-			BudgetPercentage: c.BudgetPercentage,
+			Category:           c.Category,
+			Total:              c.Total.Float64(),
+			BudgetLimit:        c.BudgetLimit.Float64(),
+			BudgetPercentage:   c.BudgetPercentage,
+			Currencies:         toProtoCurrencyTotals(c.Currencies),
+			TotalDecimal:       c.Total.String(),
+			BudgetLimitDecimal: c.BudgetLimit.String(),
+			Parent:             c.Parent,
+			RollupTotal:        c.RollupTotal.Float64(),
+			RollupTotalDecimal: c.RollupTotal.String(),
 		})
 	}
 
 	return &pb.GetReportResponse{
-		Categories:    protoCategories,
-		TotalExpenses: totalExpenses,
-		From:          req.GetFrom(),
-		To:            req.GetTo(),
+		Categories:           protoCategories,
+		TotalExpenses:        report.TotalExpenses.Float64(),
+		From:                 req.GetFrom(),
+		To:                   req.GetTo(),
+		TotalIncome:          report.TotalIncome.Float64(),
+		NetBalance:           report.NetBalance.Float64(),
+		SavingsRate:          report.SavingsRate,
+		BaseCurrency:         report.BaseCurrency,
+		TotalExpensesDecimal: report.TotalExpenses.String(),
+		TotalIncomeDecimal:   report.TotalIncome.String(),
+		NetBalanceDecimal:    report.NetBalance.String(),
+	}, nil
+}
+
+func (s *LedgerServer) GetTagReport(ctx context.Context, req *pb.GetTagReportRequest) (*pb.GetTagReportResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetFrom() == nil || req.GetTo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to dates are required")
+	}
+	if _, err := domain.NormalizeTags(req.GetTags()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := s.ledgerService.GetTagReport(ctx, req.GetUserId(), req.GetFrom().AsTime(), req.GetTo().AsTime(), req.GetTags())
+	if err != nil {
+		if errors.Is(err, domain.ErrExchangeRateNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get tag report: %v", err)
+	}
+
+	protoTags := make([]*pb.TagSummary, 0, len(report.Tags))
+	for _, t := range report.Tags {
+		protoTags = append(protoTags, &pb.TagSummary{
+			Tag:              t.Tag,
+			Total:            t.Total.Float64(),
+			TransactionCount: t.TransactionCount,
+			Currencies:       toProtoCurrencyTotals(t.Currencies),
+			TotalDecimal:     t.Total.String(),
+		})
+	}
+
+	return &pb.GetTagReportResponse{
+		Tags:         protoTags,
+		From:         req.GetFrom(),
+		To:           req.GetTo(),
+		BaseCurrency: report.BaseCurrency,
 	}, nil
 }
 
+type importRequest interface {
+	GetUserId() int64
+	GetDuplicateMode() string
+	GetProfile() string
+	GetFormat() string
+}
+
 func (s *LedgerServer) ImportCSV(ctx context.Context, req *pb.ImportCSVRequest) (*pb.ImportCSVResponse, error) {
 	if req.GetUserId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
@@ -170,32 +479,116 @@ func (s *LedgerServer) ImportCSV(ctx context.Context, req *pb.ImportCSVRequest)
 		return nil, status.Error(codes.InvalidArgument, "csv_data is required")
 	}
 
-	transactions, parseErrors, err := csv.ParseCSV(req.GetCsvData(), req.GetUserId())
+	format, profile, rules, err := s.prepareImportRequest(ctx, req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to parse CSV: %v", err)
+		return nil, err
 	}
 
-	var importedCount int32
-	var skippedCount int32
-	var importErrors []string
+	rows, err := statement.Parse(format, req.GetCsvData(), req.GetUserId(), profile, rules)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse %s: %v", strings.ToUpper(format), err)
+	}
 
-	for i := range transactions {
-		_, err := s.ledgerService.AddTransaction(ctx, &transactions[i])
+	return s.importRows(ctx, req.GetUserId(), rows, domain.ImportOptions{
+		DuplicateMode: req.GetDuplicateMode(),
+		Preview:       req.GetPreview(),
+		Atomic:        req.GetAtomic(),
+	})
+}
+
+func (s *LedgerServer) prepareImportRequest(ctx context.Context, req importRequest) (string, *domain.ImportProfile, []domain.CategoryRule, error) {
+	if _, err := domain.NormalizeDuplicateMode(req.GetDuplicateMode()); err != nil {
+		return "", nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	format, err := domain.NormalizeImportFormat(req.GetFormat())
+	if err != nil {
+		return "", nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if format != domain.FormatCSV && req.GetProfile() != "" {
+		return "", nil, nil, status.Error(codes.InvalidArgument, "profile applies to csv format only")
+	}
+
+	var profile *domain.ImportProfile
+	if req.GetProfile() != "" {
+		found, err := s.ledgerService.GetImportProfile(ctx, req.GetUserId(), req.GetProfile())
 		if err != nil {
-			skippedCount++
-			importErrors = append(importErrors, err.Error())
-		} else {
-			importedCount++
+			return "", nil, nil, importProfileStatus(err, "failed to get import profile")
 		}
+		profile = found
 	}
 
-	importErrors = append(parseErrors, importErrors...)
+	rules, err := s.ledgerService.GetCategoryRules(ctx, req.GetUserId())
+	if err != nil {
+		return "", nil, nil, status.Errorf(codes.Internal, "failed to get category rules: %v", err)
+	}
+	return format, profile, rules, nil
+}
 
-	return &pb.ImportCSVResponse{
-		ImportedCount: importedCount,
-		SkippedCount:  skippedCount + int32(len(parseErrors)),
-		Errors:        importErrors,
-	}, nil
+func (s *LedgerServer) importRows(ctx context.Context, userID int64, rows []domain.ImportRow, options domain.ImportOptions) (*pb.ImportCSVResponse, error) {
+	result, err := s.ledgerService.ImportTransactions(ctx, userID, rows, options)
+	if err != nil {
+		if errors.Is(err, domain.ErrDuplicateTransaction) {
+			return nil, status.Error(codes.Aborted, "file is being imported concurrently, retry the import")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to import transactions: %v", err)
+	}
+
+	return toProtoImportResult(result, options.Preview), nil
+}
+
+func toProtoImportResult(result *domain.ImportResult, withRows bool) *pb.ImportCSVResponse {
+	resp := &pb.ImportCSVResponse{
+		ImportedCount: int32(result.Imported),
+		SkippedCount:  int32(result.Skipped()),
+		Errors:        result.Errors(),
+		RolledBack:    result.RolledBack,
+	}
+
+	for _, row := range result.Duplicates() {
+		resp.Duplicates = append(resp.Duplicates, &pb.ImportDuplicate{
+			Transaction:           toProtoTransaction(&row.Transaction),
+			ExistingTransactionId: row.ExistingID,
+			Probable:              row.Probable,
+			Imported:              row.Status == domain.RowImported,
+			Line:                  int32(row.Line),
+		})
+	}
+	resp.DuplicateCount = int32(len(resp.Duplicates))
+
+	if withRows {
+		for i := range result.Rows {
+			row := &result.Rows[i]
+			protoRow := &pb.ImportRow{
+				Line:                  int32(row.Line),
+				Status:                row.Status,
+				Error:                 row.Error,
+				Warning:               row.Warning,
+				ExistingTransactionId: row.ExistingID,
+			}
+			if row.Transaction.UserID != 0 {
+				protoRow.Transaction = toProtoTransaction(&row.Transaction)
+			}
+			resp.Rows = append(resp.Rows, protoRow)
+		}
+	}
+
+	for _, impact := range result.BudgetImpact {
+		resp.BudgetImpact = append(resp.BudgetImpact, &pb.BudgetImpact{
+			Category:        impact.Category,
+			Enforcement:     impact.Enforcement,
+			Currency:        impact.Currency,
+			PeriodFrom:      timestamppb.New(impact.PeriodFrom),
+			PeriodTo:        timestamppb.New(impact.PeriodTo),
+			Limit:           impact.Limit.Float64(),
+			Spent:           impact.Spent.Float64(),
+			Incoming:        impact.Incoming.Float64(),
+			Exceeded:        impact.Exceeded(),
+			LimitDecimal:    impact.Limit.String(),
+			SpentDecimal:    impact.Spent.String(),
+			IncomingDecimal: impact.Incoming.String(),
+		})
+	}
+	return resp
 }
 
 func (s *LedgerServer) ExportCSV(ctx context.Context, req *pb.ExportCSVRequest) (*pb.ExportCSVResponse, error) {
@@ -203,43 +596,155 @@ func (s *LedgerServer) ExportCSV(ctx context.Context, req *pb.ExportCSVRequest)
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
 
-	transactions, err := s.ledgerService.GetTransactions(ctx, req.GetUserId(),
-		timeFromProto(req.GetFrom()), timeFromProto(req.GetTo()), "")
+	transactions, _, err := s.ledgerService.GetTransactions(ctx, req.GetUserId(), domain.TransactionFilter{
+		From: timeFromProto(req.GetFrom()),
+		To:   timeFromProto(req.GetTo()),
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get transactions: %v", err)
 	}
 
-	csvData, err := csv.GenerateCSV(transactions)
+	exporter, err := export.Get(domain.FormatCSV)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate CSV: %v", err)
+	}
+	var buf bytes.Buffer
+	count, err := exporter.Export(&buf, transactions, export.Options{})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate CSV: %v", err)
 	}
 
 	return &pb.ExportCSVResponse{
-		CsvData:   csvData,
-		RowsCount: int32(len(transactions)),
+		CsvData:   buf.Bytes(),
+		RowsCount: int32(count),
 	}, nil
 }
 
 func toProtoTransaction(tx *domain.Transaction) *pb.Transaction {
-	return &pb.Transaction{
-		Id:          tx.ID,
-		UserId:      tx.UserID,
-		Amount:      tx.Amount,
-		Category:    tx.Category,
-		Description: tx.Description,
-		Date:        timestamppb.New(tx.Date),
-		CreatedAt:   timestamppb.New(tx.CreatedAt),
+	protoTx := &pb.Transaction{
+		Id:            tx.ID,
+		UserId:        tx.UserID,
+		Kind:          tx.Kind,
+		Amount:        tx.Amount.Float64(),
+		AmountDecimal: tx.Amount.String(),
+		Currency:      tx.Currency,
+		Category:      tx.Category,
+		Description:   tx.Description,
+		Date:          timestamppb.New(tx.Date),
+		OverBudget:    tx.OverBudget,
+		Tags:          tx.Tags,
+		Rank:          tx.Rank,
+		CreatedAt:     timestamppb.New(tx.CreatedAt),
+	}
+	if tx.RecurringRuleID != nil {
+		protoTx.RecurringRuleId = *tx.RecurringRuleID
+	}
+	if tx.AccountID != nil {
+		protoTx.AccountId = *tx.AccountID
+	}
+	if tx.TransferID != nil {
+		protoTx.TransferId = *tx.TransferID
+	}
+	for _, split := range tx.Splits {
+		protoTx.Splits = append(protoTx.Splits, &pb.TransactionSplit{
+			Category:      split.Category,
+			Amount:        split.Amount.Float64(),
+			AmountDecimal: split.Amount.String(),
+			Note:          split.Note,
+		})
+	}
+	return protoTx
+}
+
+func splitsFromProto(splits []*pb.TransactionSplit) ([]domain.TransactionSplit, error) {
+	if len(splits) == 0 {
+		return nil, nil
 	}
+	result := make([]domain.TransactionSplit, 0, len(splits))
+	for i, split := range splits {
+		amount, err := moneyFromProto(split.GetAmountDecimal(), split.GetAmount())
+		if err != nil {
+			return nil, fmt.Errorf("split %d: %w", i+1, err)
+		}
+		result = append(result, domain.TransactionSplit{
+			Category: split.GetCategory(),
+			Amount:   amount,
+			Note:     split.GetNote(),
+		})
+	}
+	return result, nil
+}
+
+func toProtoCurrencyTotals(totals []domain.CurrencyTotal) []*pb.CurrencyTotal {
+	currencies := make([]*pb.CurrencyTotal, 0, len(totals))
+	for _, ct := range totals {
+		currencies = append(currencies, &pb.CurrencyTotal{
+			Currency:         ct.Currency,
+			Amount:           ct.Amount.Float64(),
+			Converted:        ct.Converted.Float64(),
+			AmountDecimal:    ct.Amount.String(),
+			ConvertedDecimal: ct.Converted.String(),
+		})
+	}
+	return currencies
 }
 
 func toProtoBudget(b *domain.Budget) *pb.Budget {
-	return &pb.Budget{
-		Id:          b.ID,
-		UserId:      b.UserID,
-		Category:    b.Category,
-		LimitAmount: b.LimitAmount,
-		Period:      b.Period,
+	protoBudget := &pb.Budget{
+		Id:                 b.ID,
+		UserId:             b.UserID,
+		Category:           b.Category,
+		LimitAmount:        b.LimitAmount.Float64(),
+		LimitAmountDecimal: b.LimitAmount.String(),
+		Currency:           b.Currency,
+		Period:             b.Period,
+		StartDay:           int32(b.StartDay),
+		Rollover:           b.Rollover,
+		Enforcement:        b.Enforcement,
+		WarningThresholds:  intsToProto(b.Thresholds),
+		ValidFrom:          timestamppb.New(b.ValidFrom),
+	}
+	if b.ValidTo != nil {
+		protoBudget.ValidTo = timestamppb.New(*b.ValidTo)
+	}
+	return protoBudget
+}
+
+func moneyFromProto(decimal string, value float64) (domain.Money, error) {
+	if decimal != "" {
+		return domain.ParseMoney(decimal)
+	}
+	return domain.MoneyFromFloat(value), nil
+}
+
+func optionalMoneyFromProto(decimal string, value float64) (*domain.Money, error) {
+	if decimal == "" && value == 0 {
+		return nil, nil
+	}
+	m, err := moneyFromProto(decimal, value)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func intsFromProto(values []int32) []int {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make([]int, 0, len(values))
+	for _, v := range values {
+		result = append(result, int(v))
+	}
+	return result
+}
+
+func intsToProto(values []int) []int32 {
+	result := make([]int32, 0, len(values))
+	for _, v := range values {
+		result = append(result, int32(v))
 	}
+	return result
 }
 
 func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
@@ -0,0 +1,166 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+)
+
+type recurringRuleRequest interface {
+	GetUserId() int64
+	GetKind() string
+	GetAmount() float64
+	GetAmountDecimal() string
+	GetCurrency() string
+	GetCategory() string
+	GetDescription() string
+	GetFrequency() string
+	GetInterval() int32
+	GetStartDate() *timestamppb.Timestamp
+	GetEndDate() *timestamppb.Timestamp
+}
+
+func (s *LedgerServer) CreateRecurringRule(ctx context.Context, req *pb.CreateRecurringRuleRequest) (*pb.CreateRecurringRuleResponse, error) {
+	rule, err := recurringRuleFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ledgerService.CreateRecurringRule(ctx, rule); err != nil {
+		if errors.Is(err, service.ErrCategoryArchived) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create recurring rule: %v", err)
+	}
+
+	return &pb.CreateRecurringRuleResponse{
+		Rule: toProtoRecurringRule(rule),
+	}, nil
+}
+
+func (s *LedgerServer) GetRecurringRules(ctx context.Context, req *pb.GetRecurringRulesRequest) (*pb.GetRecurringRulesResponse, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	rules, err := s.ledgerService.GetRecurringRules(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get recurring rules: %v", err)
+	}
+
+	protoRules := make([]*pb.RecurringRule, 0, len(rules))
+	for i := range rules {
+		protoRules = append(protoRules, toProtoRecurringRule(&rules[i]))
+	}
+
+	return &pb.GetRecurringRulesResponse{
+		Rules: protoRules,
+	}, nil
+}
+
+func (s *LedgerServer) UpdateRecurringRule(ctx context.Context, req *pb.UpdateRecurringRuleRequest) (*pb.UpdateRecurringRuleResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	rule, err := recurringRuleFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = req.GetId()
+
+	if err := s.ledgerService.UpdateRecurringRule(ctx, rule); err != nil {
+		if errors.Is(err, service.ErrRecurringRuleNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, service.ErrCategoryArchived) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update recurring rule: %v", err)
+	}
+
+	return &pb.UpdateRecurringRuleResponse{
+		Rule: toProtoRecurringRule(rule),
+	}, nil
+}
+
+func (s *LedgerServer) DeleteRecurringRule(ctx context.Context, req *pb.DeleteRecurringRuleRequest) (*pb.DeleteRecurringRuleResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.ledgerService.DeleteRecurringRule(ctx, req.GetId(), req.GetUserId()); err != nil {
+		if errors.Is(err, service.ErrRecurringRuleNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete recurring rule: %v", err)
+	}
+
+	return &pb.DeleteRecurringRuleResponse{}, nil
+}
+
+func recurringRuleFromProto(req recurringRuleRequest) (*domain.RecurringRule, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetStartDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date is required")
+	}
+
+	amount, err := moneyFromProto(req.GetAmountDecimal(), req.GetAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rule := &domain.RecurringRule{
+		UserID:      req.GetUserId(),
+		Kind:        req.GetKind(),
+		Amount:      amount,
+		Currency:    req.GetCurrency(),
+		Category:    req.GetCategory(),
+		Description: req.GetDescription(),
+		Frequency:   req.GetFrequency(),
+		Interval:    int(req.GetInterval()),
+		StartDate:   req.GetStartDate().AsTime(),
+		EndDate:     timeFromProto(req.GetEndDate()),
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return rule, nil
+}
+
+func toProtoRecurringRule(rule *domain.RecurringRule) *pb.RecurringRule {
+	protoRule := &pb.RecurringRule{
+		Id:            rule.ID,
+		UserId:        rule.UserID,
+		Kind:          rule.Kind,
+		Amount:        rule.Amount.Float64(),
+		AmountDecimal: rule.Amount.String(),
+		Currency:      rule.Currency,
+		Category:      rule.Category,
+		Description:   rule.Description,
+		Frequency:     rule.Frequency,
+		Interval:      int32(rule.Interval),
+		StartDate:     timestamppb.New(rule.StartDate),
+		LastError:     rule.LastError,
+		CreatedAt:     timestamppb.New(rule.CreatedAt),
+	}
+	if rule.EndDate != nil {
+		protoRule.EndDate = timestamppb.New(*rule.EndDate)
+	}
+	if rule.NextDate != nil {
+		protoRule.NextDate = timestamppb.New(*rule.NextDate)
+	}
+	return protoRule
+}
@@ -0,0 +1,178 @@
+package grpcserver
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/csv"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/export"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/statement"
+)
+
+const exportChunkSize = 64 << 10
+
+type importStreamReader struct {
+	stream pb.LedgerService_ImportStreamServer
+	chunk  []byte
+	size   int
+	err    error
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				r.err = err
+			}
+			return 0, err
+		}
+		r.chunk = req.GetChunk()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	r.size += n
+	return n, nil
+}
+
+type exportStreamWriter struct {
+	stream      pb.LedgerService_ExportStreamServer
+	contentType string
+	filename    string
+	sent        bool
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	resp := &pb.ExportStreamResponse{Chunk: p}
+	if !w.sent {
+		resp.ContentType = w.contentType
+		resp.Filename = w.filename
+	}
+	if err := w.stream.Send(resp); err != nil {
+		return 0, err
+	}
+	w.sent = true
+	return len(p), nil
+}
+
+func (s *LedgerServer) ImportStream(stream pb.LedgerService_ImportStreamServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err != nil {
+		return err
+	}
+	if first.GetUserId() <= 0 {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	ctx := stream.Context()
+	format, profile, rules, err := s.prepareImportRequest(ctx, first)
+	if err != nil {
+		return err
+	}
+
+	reader := &importStreamReader{stream: stream, chunk: first.GetChunk()}
+	var rows []domain.ImportRow
+	if format == domain.FormatCSV {
+		rows, err = csv.NewReader(reader, first.GetUserId(), profile, rules).ReadAll()
+	} else {
+		var data []byte
+		if data, err = io.ReadAll(reader); err == nil && len(data) > 0 {
+			rows, err = statement.Parse(format, data, first.GetUserId(), profile, rules)
+		}
+	}
+	if reader.err != nil {
+		return reader.err
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to parse %s: %v", strings.ToUpper(format), err)
+	}
+	if reader.size == 0 {
+		return status.Error(codes.InvalidArgument, "chunk is required")
+	}
+
+	resp, err := s.importRows(ctx, first.GetUserId(), rows, domain.ImportOptions{
+		DuplicateMode: first.GetDuplicateMode(),
+		Preview:       first.GetPreview(),
+		Atomic:        first.GetAtomic(),
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+func (s *LedgerServer) ExportStream(req *pb.ExportTransactionsRequest, stream pb.LedgerService_ExportStreamServer) error {
+	exporter, filter, options, err := exportFromProto(req)
+	if err != nil {
+		return err
+	}
+
+	writer := &exportStreamWriter{
+		stream:      stream,
+		contentType: exporter.ContentType(),
+		filename:    export.Filename(exporter, options.From, options.To),
+	}
+	buffered := bufio.NewWriterSize(writer, exportChunkSize)
+
+	if streamer, ok := exporter.(export.StreamExporter); ok {
+		err = s.streamTransactions(stream.Context(), req.GetUserId(), filter, streamer, buffered, options)
+	} else {
+		transactions, _, getErr := s.ledgerService.GetTransactions(stream.Context(), req.GetUserId(), filter)
+		if getErr != nil {
+			return status.Errorf(codes.Internal, "failed to get transactions: %v", getErr)
+		}
+		_, err = exporter.Export(buffered, transactions, options)
+	}
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to export transactions: %v", err)
+	}
+
+	if !writer.sent {
+		if _, err := writer.Write(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *LedgerServer) streamTransactions(ctx context.Context, userID int64, filter domain.TransactionFilter, exporter export.StreamExporter, w io.Writer, options export.Options) error {
+	rows, err := exporter.NewRowWriter(w, options)
+	if err != nil {
+		return err
+	}
+
+	filter.Limit = domain.MaxPageSize
+	for {
+		transactions, nextPageToken, err := s.ledgerService.GetTransactions(ctx, userID, filter)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get transactions: %v", err)
+		}
+		for i := range transactions {
+			if _, err := rows.Write(&transactions[i]); err != nil {
+				return err
+			}
+		}
+		if nextPageToken == "" {
+			return rows.Flush()
+		}
+		if filter.After, err = domain.ParseTransactionCursor(nextPageToken); err != nil {
+			return err
+		}
+	}
+}
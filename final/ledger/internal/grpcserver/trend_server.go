@@ -0,0 +1,76 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	pb "github.com/mikhailmogilnikov/go/final/ledger/internal/pb/ledger/v1"
+)
+
+func (s *LedgerServer) GetTrends(ctx context.Context, req *pb.GetTrendsRequest) (*pb.GetTrendsResponse, error) {
+	if req.GetFrom() == nil || req.GetTo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to dates are required")
+	}
+	query := domain.TrendQuery{
+		UserID:      req.GetUserId(),
+		From:        req.GetFrom().AsTime(),
+		To:          req.GetTo().AsTime(),
+		Granularity: req.GetGranularity(),
+		ByCategory:  req.GetByCategory(),
+		Window:      int(req.GetWindow()),
+	}
+	if err := query.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	trend, err := s.ledgerService.GetTrends(ctx, query)
+	if err != nil {
+		if errors.Is(err, domain.ErrExchangeRateNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get trends: %v", err)
+	}
+
+	protoCategories := make([]*pb.TrendSeries, 0, len(trend.Categories))
+	for _, series := range trend.Categories {
+		protoCategories = append(protoCategories, &pb.TrendSeries{
+			Category:     series.Category,
+			Total:        series.Total.Float64(),
+			TotalDecimal: series.Total.String(),
+			Buckets:      toProtoTrendBuckets(series.Buckets),
+		})
+	}
+
+	return &pb.GetTrendsResponse{
+		Buckets:      toProtoTrendBuckets(trend.Buckets),
+		Categories:   protoCategories,
+		From:         req.GetFrom(),
+		To:           req.GetTo(),
+		Granularity:  trend.Granularity,
+		Window:       int32(trend.Window),
+		BaseCurrency: trend.BaseCurrency,
+		Total:        trend.Total.Float64(),
+		TotalDecimal: trend.Total.String(),
+	}, nil
+}
+
+func toProtoTrendBuckets(buckets []domain.TrendBucket) []*pb.TrendBucket {
+	protoBuckets := make([]*pb.TrendBucket, 0, len(buckets))
+	for _, b := range buckets {
+		protoBuckets = append(protoBuckets, &pb.TrendBucket{
+			Period:               timestamppb.New(b.Period),
+			Total:                b.Total.Float64(),
+			RunningTotal:         b.RunningTotal.Float64(),
+			MovingAverage:        b.MovingAverage.Float64(),
+			TotalDecimal:         b.Total.String(),
+			RunningTotalDecimal:  b.RunningTotal.String(),
+			MovingAverageDecimal: b.MovingAverage.String(),
+		})
+	}
+	return protoBuckets
+}
@@ -0,0 +1,177 @@
+package ofx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type statementTransaction struct {
+	line     int
+	fitID    string
+	posted   string
+	amount   string
+	name     string
+	memo     string
+	currency string
+}
+
+func ParseOFX(data []byte, userID int64, rules []domain.CategoryRule) ([]domain.ImportRow, error) {
+	start := bytes.Index(data, []byte("<OFX>"))
+	if start < 0 {
+		start = bytes.Index(data, []byte("<ofx>"))
+	}
+	if start < 0 {
+		return nil, errors.New("not an OFX file: <OFX> element not found")
+	}
+
+	body, err := decode(string(data[:start]), data[start:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode OFX: %w", err)
+	}
+
+	var rows []domain.ImportRow
+	var account, currency string
+	var current *statementTransaction
+	line := bytes.Count(data[:start], []byte("\n")) + 1
+
+	for pos := 0; pos < len(body); {
+		open := strings.IndexByte(body[pos:], '<')
+		if open < 0 {
+			break
+		}
+		open += pos
+		line += strings.Count(body[pos:open], "\n")
+
+		end := strings.IndexByte(body[open:], '>')
+		if end < 0 {
+			return nil, fmt.Errorf("line %d: unterminated tag", line)
+		}
+		end += open
+		next := strings.IndexByte(body[end+1:], '<')
+		if next < 0 {
+			next = len(body)
+		} else {
+			next += end + 1
+		}
+		tag := strings.ToUpper(strings.TrimSpace(body[open+1 : end]))
+		text := strings.TrimSpace(html.UnescapeString(body[end+1 : next]))
+		line += strings.Count(body[open:end], "\n")
+		pos = end + 1
+
+		switch {
+		case tag == "" || tag[0] == '?' || tag[0] == '!':
+		case tag == "STMTTRN":
+			current = &statementTransaction{line: line}
+		case tag == "/STMTTRN":
+			if current != nil {
+				rows = append(rows, current.row(userID, account, currency, rules))
+				current = nil
+			}
+		case tag[0] == '/':
+		case current != nil:
+			current.set(tag, text)
+		case tag == "ACCTID":
+			account = text
+		case tag == "CURDEF":
+			currency = text
+		}
+	}
+
+	return rows, nil
+}
+
+func (t *statementTransaction) set(tag, value string) {
+	switch tag {
+	case "FITID":
+		t.fitID = value
+	case "DTPOSTED":
+		t.posted = value
+	case "TRNAMT":
+		t.amount = value
+	case "NAME":
+		if t.name == "" {
+			t.name = value
+		}
+	case "MEMO":
+		t.memo = value
+	case "CURSYM":
+		t.currency = value
+	}
+}
+
+func (t *statementTransaction) row(userID int64, account, currency string, rules []domain.CategoryRule) domain.ImportRow {
+	row := domain.ImportRow{Line: t.line, Status: domain.RowReady}
+	if t.fitID != "" {
+		row.ExternalID = account + ":" + t.fitID
+	}
+
+	amount, err := domain.ParseMoney(strings.ReplaceAll(t.amount, ",", "."))
+	if err != nil {
+		row.Fail(fmt.Errorf("invalid amount '%s'", t.amount))
+		return row
+	}
+	date, err := parseDate(t.posted)
+	if err != nil {
+		row.Fail(fmt.Errorf("invalid date '%s'", t.posted))
+		return row
+	}
+
+	kind := domain.KindIncome
+	if amount < 0 {
+		kind = domain.KindExpense
+		amount = -amount
+	}
+	if t.currency != "" {
+		currency = t.currency
+	}
+
+	row.Transaction = domain.Transaction{
+		UserID:      userID,
+		Kind:        kind,
+		Amount:      amount,
+		Currency:    currency,
+		Description: description(t.name, t.memo),
+		Date:        date,
+	}
+	row.Categorize(rules)
+	return row
+}
+
+func parseDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, errors.New("date too short")
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+func description(name, memo string) string {
+	if memo == "" || strings.EqualFold(memo, name) {
+		return name
+	}
+	if name == "" {
+		return memo
+	}
+	return name + " / " + memo
+}
+
+func decode(header string, body []byte) (string, error) {
+	header = strings.ToUpper(header)
+	switch {
+	case strings.Contains(header, "UTF-8"):
+	case strings.Contains(header, "CHARSET:1251"), strings.Contains(header, "WINDOWS-1251"):
+		decoded, err := charmap.Windows1251.NewDecoder().Bytes(body)
+		return string(decoded), err
+	case strings.Contains(header, "CHARSET:1252"), strings.Contains(header, "WINDOWS-1252"):
+		decoded, err := charmap.Windows1252.NewDecoder().Bytes(body)
+		return string(decoded), err
+	}
+	return string(body), nil
+}
@@ -0,0 +1,141 @@
+package ofx
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+ENCODING:USASCII
+CHARSET:1251
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>RUB
+<BANKACCTFROM><ACCTID>40817810</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20250314120000[+3:MSK]
+<TRNAMT>-1350.50
+<FITID>A1
+<NAME>Кофе Хауз
+<MEMO>Кофе Хауз
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20250315
+<TRNAMT>120000,00
+<FITID>A2
+<NAME>Зарплата
+<MEMO>Март
+<CURRENCY><CURSYM>USD</CURRENCY>
+</STMTTRN>
+<STMTTRN>
+<DTPOSTED>2025
+<TRNAMT>10.00
+<FITID>A3
+<NAME>Аптека
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+func TestParseOFX_SGML(t *testing.T) {
+	data, err := charmap.Windows1251.NewEncoder().Bytes([]byte(sgmlStatement))
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	rules := []domain.CategoryRule{
+		{UserID: 1, Pattern: "кофе", Category: "cafe"},
+		{UserID: 1, Pattern: "зарплата", Category: "salary"},
+	}
+	for i := range rules {
+		if err := rules[i].Validate(); err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+	}
+
+	rows, err := ParseOFX(data, 1, rules)
+	if err != nil {
+		t.Fatalf("ParseOFX() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("len(rows) = %d, want 3", len(rows))
+	}
+
+	want := []struct {
+		externalID string
+		tx         domain.Transaction
+	}{
+		{
+			externalID: "40817810:A1",
+			tx: domain.Transaction{UserID: 1, Kind: domain.KindExpense, Amount: 135050, Currency: "RUB", Category: "cafe",
+				Description: "Кофе Хауз", Date: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			externalID: "40817810:A2",
+			tx: domain.Transaction{UserID: 1, Kind: domain.KindIncome, Amount: 12000000, Currency: "USD", Category: "salary",
+				Description: "Зарплата / Март", Date: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	for i, w := range want {
+		if rows[i].Status != domain.RowReady {
+			t.Fatalf("rows[%d].Status = %q (%s), want ready", i, rows[i].Status, rows[i].Error)
+		}
+		if rows[i].ExternalID != w.externalID {
+			t.Errorf("rows[%d].ExternalID = %q, want %q", i, rows[i].ExternalID, w.externalID)
+		}
+		if !reflect.DeepEqual(rows[i].Transaction, w.tx) {
+			t.Errorf("rows[%d].Transaction = %+v, want %+v", i, rows[i].Transaction, w.tx)
+		}
+	}
+	if rows[2].Status != domain.RowFailed || rows[2].Error != "invalid date '2025'" {
+		t.Errorf("rows[2] = %s (%s), want failed with invalid date", rows[2].Status, rows[2].Error)
+	}
+	if rows[0].Line != 12 {
+		t.Errorf("rows[0].Line = %d, want 12", rows[0].Line)
+	}
+}
+
+func TestParseOFX_XML(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>EUR</CURDEF>
+<BANKACCTFROM><ACCTID>DE001</ACCTID></BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><DTPOSTED>20250301</DTPOSTED><TRNAMT>-9.99</TRNAMT><FITID>X1</FITID><NAME>Coffee &amp; Co</NAME></STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>`)
+	rules := []domain.CategoryRule{{UserID: 1, Pattern: "coffee", Category: "cafe"}}
+	if err := rules[0].Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	rows, err := ParseOFX(data, 1, rules)
+	if err != nil {
+		t.Fatalf("ParseOFX() error = %v", err)
+	}
+	if len(rows) != 1 || rows[0].Status != domain.RowReady {
+		t.Fatalf("rows = %+v, want one ready row", rows)
+	}
+	tx := rows[0].Transaction
+	if rows[0].ExternalID != "DE001:X1" || tx.Amount != 999 || tx.Kind != domain.KindExpense || tx.Currency != "EUR" || tx.Description != "Coffee & Co" {
+		t.Errorf("row = %q %+v", rows[0].ExternalID, tx)
+	}
+}
+
+func TestParseOFX_NotOFX(t *testing.T) {
+	if _, err := ParseOFX([]byte("amount,category\n1,food\n"), 1, nil); err == nil {
+		t.Error("ParseOFX() error = nil for CSV input")
+	}
+}
@@ -11,23 +11,111 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LedgerService_AddTransaction_FullMethodName  = "/ledger.v1.LedgerService/AddTransaction"
-	LedgerService_GetTransactions_FullMethodName = "/ledger.v1.LedgerService/GetTransactions"
-	LedgerService_SetBudget_FullMethodName       = "/ledger.v1.LedgerService/SetBudget"
-	LedgerService_GetBudgets_FullMethodName      = "/ledger.v1.LedgerService/GetBudgets"
-	LedgerService_GetReport_FullMethodName       = "/ledger.v1.LedgerService/GetReport"
-	LedgerService_ImportCSV_FullMethodName       = "/ledger.v1.LedgerService/ImportCSV"
-	LedgerService_ExportCSV_FullMethodName       = "/ledger.v1.LedgerService/ExportCSV"
+	LedgerService_AddTransaction_FullMethodName      = "/ledger.v1.LedgerService/AddTransaction"
+	LedgerService_GetTransactions_FullMethodName     = "/ledger.v1.LedgerService/GetTransactions"
+	LedgerService_UpdateTransaction_FullMethodName   = "/ledger.v1.LedgerService/UpdateTransaction"
+	LedgerService_DeleteTransaction_FullMethodName   = "/ledger.v1.LedgerService/DeleteTransaction"
+	LedgerService_SetBudget_FullMethodName           = "/ledger.v1.LedgerService/SetBudget"
+	LedgerService_GetBudgets_FullMethodName          = "/ledger.v1.LedgerService/GetBudgets"
+	LedgerService_GetBudgetHistory_FullMethodName    = "/ledger.v1.LedgerService/GetBudgetHistory"
+	LedgerService_DeleteBudget_FullMethodName        = "/ledger.v1.LedgerService/DeleteBudget"
+	LedgerService_GetBudgetStatus_FullMethodName     = "/ledger.v1.LedgerService/GetBudgetStatus"
+	LedgerService_GetReport_FullMethodName           = "/ledger.v1.LedgerService/GetReport"
+	LedgerService_GetTagReport_FullMethodName        = "/ledger.v1.LedgerService/GetTagReport"
+	LedgerService_CompareReports_FullMethodName      = "/ledger.v1.LedgerService/CompareReports"
+	LedgerService_GetTrends_FullMethodName           = "/ledger.v1.LedgerService/GetTrends"
+	LedgerService_GetSettings_FullMethodName         = "/ledger.v1.LedgerService/GetSettings"
+	LedgerService_SetBaseCurrency_FullMethodName     = "/ledger.v1.LedgerService/SetBaseCurrency"
+	LedgerService_SetExchangeRates_FullMethodName    = "/ledger.v1.LedgerService/SetExchangeRates"
+	LedgerService_ImportExchangeRates_FullMethodName = "/ledger.v1.LedgerService/ImportExchangeRates"
+	LedgerService_GetExchangeRates_FullMethodName    = "/ledger.v1.LedgerService/GetExchangeRates"
+	LedgerService_CreateRecurringRule_FullMethodName = "/ledger.v1.LedgerService/CreateRecurringRule"
+	LedgerService_GetRecurringRules_FullMethodName   = "/ledger.v1.LedgerService/GetRecurringRules"
+	LedgerService_UpdateRecurringRule_FullMethodName = "/ledger.v1.LedgerService/UpdateRecurringRule"
+	LedgerService_DeleteRecurringRule_FullMethodName = "/ledger.v1.LedgerService/DeleteRecurringRule"
+	LedgerService_CreateCategory_FullMethodName      = "/ledger.v1.LedgerService/CreateCategory"
+	LedgerService_GetCategories_FullMethodName       = "/ledger.v1.LedgerService/GetCategories"
+	LedgerService_UpdateCategory_FullMethodName      = "/ledger.v1.LedgerService/UpdateCategory"
+	LedgerService_DeleteCategory_FullMethodName      = "/ledger.v1.LedgerService/DeleteCategory"
+	LedgerService_MergeCategories_FullMethodName     = "/ledger.v1.LedgerService/MergeCategories"
+	LedgerService_CreateAccount_FullMethodName       = "/ledger.v1.LedgerService/CreateAccount"
+	LedgerService_GetAccounts_FullMethodName         = "/ledger.v1.LedgerService/GetAccounts"
+	LedgerService_UpdateAccount_FullMethodName       = "/ledger.v1.LedgerService/UpdateAccount"
+	LedgerService_DeleteAccount_FullMethodName       = "/ledger.v1.LedgerService/DeleteAccount"
+	LedgerService_CreateTransfer_FullMethodName      = "/ledger.v1.LedgerService/CreateTransfer"
+	LedgerService_GetAccountBalances_FullMethodName  = "/ledger.v1.LedgerService/GetAccountBalances"
+	LedgerService_CreateCategoryRule_FullMethodName  = "/ledger.v1.LedgerService/CreateCategoryRule"
+	LedgerService_GetCategoryRules_FullMethodName    = "/ledger.v1.LedgerService/GetCategoryRules"
+	LedgerService_UpdateCategoryRule_FullMethodName  = "/ledger.v1.LedgerService/UpdateCategoryRule"
+	LedgerService_DeleteCategoryRule_FullMethodName  = "/ledger.v1.LedgerService/DeleteCategoryRule"
+	LedgerService_TestCategoryRule_FullMethodName    = "/ledger.v1.LedgerService/TestCategoryRule"
+	LedgerService_ImportCSV_FullMethodName           = "/ledger.v1.LedgerService/ImportCSV"
+	LedgerService_CreateImportProfile_FullMethodName = "/ledger.v1.LedgerService/CreateImportProfile"
+	LedgerService_GetImportProfiles_FullMethodName   = "/ledger.v1.LedgerService/GetImportProfiles"
+	LedgerService_UpdateImportProfile_FullMethodName = "/ledger.v1.LedgerService/UpdateImportProfile"
+	LedgerService_DeleteImportProfile_FullMethodName = "/ledger.v1.LedgerService/DeleteImportProfile"
+	LedgerService_ExportCSV_FullMethodName           = "/ledger.v1.LedgerService/ExportCSV"
+	LedgerService_ExportTransactions_FullMethodName  = "/ledger.v1.LedgerService/ExportTransactions"
+	LedgerService_ImportStream_FullMethodName        = "/ledger.v1.LedgerService/ImportStream"
+	LedgerService_ExportStream_FullMethodName        = "/ledger.v1.LedgerService/ExportStream"
+	LedgerService_SubmitImport_FullMethodName        = "/ledger.v1.LedgerService/SubmitImport"
+	LedgerService_GetImport_FullMethodName           = "/ledger.v1.LedgerService/GetImport"
+	LedgerService_CancelImport_FullMethodName        = "/ledger.v1.LedgerService/CancelImport"
+	LedgerService_WatchImport_FullMethodName         = "/ledger.v1.LedgerService/WatchImport"
 )
 
 type LedgerServiceClient interface {
 	AddTransaction(ctx context.Context, in *AddTransactionRequest, opts ...grpc.CallOption) (*AddTransactionResponse, error)
 	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*UpdateTransactionResponse, error)
+	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error)
 	SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error)
 	GetBudgets(ctx context.Context, in *GetBudgetsRequest, opts ...grpc.CallOption) (*GetBudgetsResponse, error)
+	GetBudgetHistory(ctx context.Context, in *GetBudgetHistoryRequest, opts ...grpc.CallOption) (*GetBudgetHistoryResponse, error)
+	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error)
+	GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error)
 	GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error)
+	GetTagReport(ctx context.Context, in *GetTagReportRequest, opts ...grpc.CallOption) (*GetTagReportResponse, error)
+	CompareReports(ctx context.Context, in *CompareReportsRequest, opts ...grpc.CallOption) (*CompareReportsResponse, error)
+	GetTrends(ctx context.Context, in *GetTrendsRequest, opts ...grpc.CallOption) (*GetTrendsResponse, error)
+	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error)
+	SetBaseCurrency(ctx context.Context, in *SetBaseCurrencyRequest, opts ...grpc.CallOption) (*SetBaseCurrencyResponse, error)
+	SetExchangeRates(ctx context.Context, in *SetExchangeRatesRequest, opts ...grpc.CallOption) (*SetExchangeRatesResponse, error)
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error)
+	GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error)
+	CreateRecurringRule(ctx context.Context, in *CreateRecurringRuleRequest, opts ...grpc.CallOption) (*CreateRecurringRuleResponse, error)
+	GetRecurringRules(ctx context.Context, in *GetRecurringRulesRequest, opts ...grpc.CallOption) (*GetRecurringRulesResponse, error)
+	UpdateRecurringRule(ctx context.Context, in *UpdateRecurringRuleRequest, opts ...grpc.CallOption) (*UpdateRecurringRuleResponse, error)
+	DeleteRecurringRule(ctx context.Context, in *DeleteRecurringRuleRequest, opts ...grpc.CallOption) (*DeleteRecurringRuleResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*MergeCategoriesResponse, error)
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error)
+	GetAccountBalances(ctx context.Context, in *GetAccountBalancesRequest, opts ...grpc.CallOption) (*GetAccountBalancesResponse, error)
+	CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CreateCategoryRuleResponse, error)
+	GetCategoryRules(ctx context.Context, in *GetCategoryRulesRequest, opts ...grpc.CallOption) (*GetCategoryRulesResponse, error)
+	UpdateCategoryRule(ctx context.Context, in *UpdateCategoryRuleRequest, opts ...grpc.CallOption) (*UpdateCategoryRuleResponse, error)
+	DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*DeleteCategoryRuleResponse, error)
+	TestCategoryRule(ctx context.Context, in *TestCategoryRuleRequest, opts ...grpc.CallOption) (*TestCategoryRuleResponse, error)
 	ImportCSV(ctx context.Context, in *ImportCSVRequest, opts ...grpc.CallOption) (*ImportCSVResponse, error)
+	CreateImportProfile(ctx context.Context, in *CreateImportProfileRequest, opts ...grpc.CallOption) (*CreateImportProfileResponse, error)
+	GetImportProfiles(ctx context.Context, in *GetImportProfilesRequest, opts ...grpc.CallOption) (*GetImportProfilesResponse, error)
+	UpdateImportProfile(ctx context.Context, in *UpdateImportProfileRequest, opts ...grpc.CallOption) (*UpdateImportProfileResponse, error)
+	DeleteImportProfile(ctx context.Context, in *DeleteImportProfileRequest, opts ...grpc.CallOption) (*DeleteImportProfileResponse, error)
 	ExportCSV(ctx context.Context, in *ExportCSVRequest, opts ...grpc.CallOption) (*ExportCSVResponse, error)
+	ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (*ExportTransactionsResponse, error)
+	ImportStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportStreamRequest, ImportCSVResponse], error)
+	ExportStream(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportStreamResponse], error)
+	SubmitImport(ctx context.Context, in *SubmitImportRequest, opts ...grpc.CallOption) (*SubmitImportResponse, error)
+	GetImport(ctx context.Context, in *GetImportRequest, opts ...grpc.CallOption) (*GetImportResponse, error)
+	CancelImport(ctx context.Context, in *CancelImportRequest, opts ...grpc.CallOption) (*CancelImportResponse, error)
+	WatchImport(ctx context.Context, in *WatchImportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchImportResponse], error)
 }
 
 type ledgerServiceClient struct {
@@ -58,6 +146,26 @@ func (c *ledgerServiceClient) GetTransactions(ctx context.Context, in *GetTransa
 	return out, nil
 }
 
+func (c *ledgerServiceClient) UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*UpdateTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ledgerServiceClient) SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetBudgetResponse)
@@ -75,213 +183,1591 @@ func (c *ledgerServiceClient) GetBudgets(ctx context.Context, in *GetBudgetsRequ
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetBudgetHistory(ctx context.Context, in *GetBudgetHistoryRequest, opts ...grpc.CallOption) (*GetBudgetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBudgetHistoryResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetBudgetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBudgetResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBudgetStatusResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetBudgetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetTagReport(ctx context.Context, in *GetTagReportRequest, opts ...grpc.CallOption) (*GetTagReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagReportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetTagReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CompareReports(ctx context.Context, in *CompareReportsRequest, opts ...grpc.CallOption) (*CompareReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareReportsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CompareReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetTrends(ctx context.Context, in *GetTrendsRequest, opts ...grpc.CallOption) (*GetTrendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetTrends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSettingsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetBaseCurrency(ctx context.Context, in *SetBaseCurrencyRequest, opts ...grpc.CallOption) (*SetBaseCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBaseCurrencyResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SetBaseCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetExchangeRates(ctx context.Context, in *SetExchangeRatesRequest, opts ...grpc.CallOption) (*SetExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ImportExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateRecurringRule(ctx context.Context, in *CreateRecurringRuleRequest, opts ...grpc.CallOption) (*CreateRecurringRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRecurringRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateRecurringRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetRecurringRules(ctx context.Context, in *GetRecurringRulesRequest, opts ...grpc.CallOption) (*GetRecurringRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecurringRulesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetRecurringRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateRecurringRule(ctx context.Context, in *UpdateRecurringRuleRequest, opts ...grpc.CallOption) (*UpdateRecurringRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRecurringRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateRecurringRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteRecurringRule(ctx context.Context, in *DeleteRecurringRuleRequest, opts ...grpc.CallOption) (*DeleteRecurringRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecurringRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteRecurringRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*MergeCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCategoriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_MergeCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTransferResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAccountBalances(ctx context.Context, in *GetAccountBalancesRequest, opts ...grpc.CallOption) (*GetAccountBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetAccountBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CreateCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetCategoryRules(ctx context.Context, in *GetCategoryRulesRequest, opts ...grpc.CallOption) (*GetCategoryRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateCategoryRule(ctx context.Context, in *UpdateCategoryRuleRequest, opts ...grpc.CallOption) (*UpdateCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*DeleteCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) TestCategoryRule(ctx context.Context, in *TestCategoryRuleRequest, opts ...grpc.CallOption) (*TestCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerService_TestCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ImportCSV(ctx context.Context, in *ImportCSVRequest, opts ...grpc.CallOption) (*ImportCSVResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCSVResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ImportCSV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateImportProfile(ctx context.Context, in *CreateImportProfileRequest, opts ...grpc.CallOption) (*CreateImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateImportProfileResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetImportProfiles(ctx context.Context, in *GetImportProfilesRequest, opts ...grpc.CallOption) (*GetImportProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImportProfilesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetImportProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateImportProfile(ctx context.Context, in *UpdateImportProfileRequest, opts ...grpc.CallOption) (*UpdateImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateImportProfileResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteImportProfile(ctx context.Context, in *DeleteImportProfileRequest, opts ...grpc.CallOption) (*DeleteImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteImportProfileResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ExportCSV(ctx context.Context, in *ExportCSVRequest, opts ...grpc.CallOption) (*ExportCSVResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCSVResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ExportCSV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (*ExportTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportTransactionsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ExportTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ImportStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportStreamRequest, ImportCSVResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LedgerService_ServiceDesc.Streams[0], LedgerService_ImportStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportStreamRequest, ImportCSVResponse]{ClientStream: stream}
+	return x, nil
+}
+
+type LedgerService_ImportStreamClient = grpc.ClientStreamingClient[ImportStreamRequest, ImportCSVResponse]
+
+func (c *ledgerServiceClient) ExportStream(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LedgerService_ServiceDesc.Streams[1], LedgerService_ExportStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTransactionsRequest, ExportStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LedgerService_ExportStreamClient = grpc.ServerStreamingClient[ExportStreamResponse]
+
+func (c *ledgerServiceClient) SubmitImport(ctx context.Context, in *SubmitImportRequest, opts ...grpc.CallOption) (*SubmitImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitImportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SubmitImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetImport(ctx context.Context, in *GetImportRequest, opts ...grpc.CallOption) (*GetImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CancelImport(ctx context.Context, in *CancelImportRequest, opts ...grpc.CallOption) (*CancelImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelImportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CancelImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) WatchImport(ctx context.Context, in *WatchImportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchImportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LedgerService_ServiceDesc.Streams[2], LedgerService_WatchImport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchImportRequest, WatchImportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LedgerService_WatchImportClient = grpc.ServerStreamingClient[WatchImportResponse]
+
+type LedgerServiceServer interface {
+	AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error)
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error)
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
+	SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error)
+	GetBudgets(context.Context, *GetBudgetsRequest) (*GetBudgetsResponse, error)
+	GetBudgetHistory(context.Context, *GetBudgetHistoryRequest) (*GetBudgetHistoryResponse, error)
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error)
+	GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error)
+	GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error)
+	GetTagReport(context.Context, *GetTagReportRequest) (*GetTagReportResponse, error)
+	CompareReports(context.Context, *CompareReportsRequest) (*CompareReportsResponse, error)
+	GetTrends(context.Context, *GetTrendsRequest) (*GetTrendsResponse, error)
+	GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error)
+	SetBaseCurrency(context.Context, *SetBaseCurrencyRequest) (*SetBaseCurrencyResponse, error)
+	SetExchangeRates(context.Context, *SetExchangeRatesRequest) (*SetExchangeRatesResponse, error)
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error)
+	GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error)
+	CreateRecurringRule(context.Context, *CreateRecurringRuleRequest) (*CreateRecurringRuleResponse, error)
+	GetRecurringRules(context.Context, *GetRecurringRulesRequest) (*GetRecurringRulesResponse, error)
+	UpdateRecurringRule(context.Context, *UpdateRecurringRuleRequest) (*UpdateRecurringRuleResponse, error)
+	DeleteRecurringRule(context.Context, *DeleteRecurringRuleRequest) (*DeleteRecurringRuleResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	MergeCategories(context.Context, *MergeCategoriesRequest) (*MergeCategoriesResponse, error)
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error)
+	GetAccountBalances(context.Context, *GetAccountBalancesRequest) (*GetAccountBalancesResponse, error)
+	CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CreateCategoryRuleResponse, error)
+	GetCategoryRules(context.Context, *GetCategoryRulesRequest) (*GetCategoryRulesResponse, error)
+	UpdateCategoryRule(context.Context, *UpdateCategoryRuleRequest) (*UpdateCategoryRuleResponse, error)
+	DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*DeleteCategoryRuleResponse, error)
+	TestCategoryRule(context.Context, *TestCategoryRuleRequest) (*TestCategoryRuleResponse, error)
+	ImportCSV(context.Context, *ImportCSVRequest) (*ImportCSVResponse, error)
+	CreateImportProfile(context.Context, *CreateImportProfileRequest) (*CreateImportProfileResponse, error)
+	GetImportProfiles(context.Context, *GetImportProfilesRequest) (*GetImportProfilesResponse, error)
+	UpdateImportProfile(context.Context, *UpdateImportProfileRequest) (*UpdateImportProfileResponse, error)
+	DeleteImportProfile(context.Context, *DeleteImportProfileRequest) (*DeleteImportProfileResponse, error)
+	ExportCSV(context.Context, *ExportCSVRequest) (*ExportCSVResponse, error)
+	ExportTransactions(context.Context, *ExportTransactionsRequest) (*ExportTransactionsResponse, error)
+	ImportStream(grpc.ClientStreamingServer[ImportStreamRequest, ImportCSVResponse]) error
+	ExportStream(*ExportTransactionsRequest, grpc.ServerStreamingServer[ExportStreamResponse]) error
+	SubmitImport(context.Context, *SubmitImportRequest) (*SubmitImportResponse, error)
+	GetImport(context.Context, *GetImportRequest) (*GetImportResponse, error)
+	CancelImport(context.Context, *CancelImportRequest) (*CancelImportResponse, error)
+	WatchImport(*WatchImportRequest, grpc.ServerStreamingServer[WatchImportResponse]) error
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+type UnimplementedLedgerServiceServer struct{}
+
+func (UnimplementedLedgerServiceServer) AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTransaction not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTransaction not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetBudgets(context.Context, *GetBudgetsRequest) (*GetBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgets not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetBudgetHistory(context.Context, *GetBudgetHistoryRequest) (*GetBudgetHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgetHistory not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgetStatus not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetTagReport(context.Context, *GetTagReportRequest) (*GetTagReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTagReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) CompareReports(context.Context, *CompareReportsRequest) (*CompareReportsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareReports not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetTrends(context.Context, *GetTrendsRequest) (*GetTrendsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrends not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetBaseCurrency(context.Context, *SetBaseCurrencyRequest) (*SetBaseCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBaseCurrency not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetExchangeRates(context.Context, *SetExchangeRatesRequest) (*SetExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+func (UnimplementedLedgerServiceServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateRecurringRule(context.Context, *CreateRecurringRuleRequest) (*CreateRecurringRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRecurringRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetRecurringRules(context.Context, *GetRecurringRulesRequest) (*GetRecurringRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecurringRules not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateRecurringRule(context.Context, *UpdateRecurringRuleRequest) (*UpdateRecurringRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRecurringRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteRecurringRule(context.Context, *DeleteRecurringRuleRequest) (*DeleteRecurringRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRecurringRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategories not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedLedgerServiceServer) MergeCategories(context.Context, *MergeCategoriesRequest) (*MergeCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeCategories not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAccountBalances(context.Context, *GetAccountBalancesRequest) (*GetAccountBalancesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountBalances not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CreateCategoryRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCategoryRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetCategoryRules(context.Context, *GetCategoryRulesRequest) (*GetCategoryRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryRules not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateCategoryRule(context.Context, *UpdateCategoryRuleRequest) (*UpdateCategoryRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCategoryRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*DeleteCategoryRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategoryRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) TestCategoryRule(context.Context, *TestCategoryRuleRequest) (*TestCategoryRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestCategoryRule not implemented")
+}
+func (UnimplementedLedgerServiceServer) ImportCSV(context.Context, *ImportCSVRequest) (*ImportCSVResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportCSV not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateImportProfile(context.Context, *CreateImportProfileRequest) (*CreateImportProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateImportProfile not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetImportProfiles(context.Context, *GetImportProfilesRequest) (*GetImportProfilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetImportProfiles not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateImportProfile(context.Context, *UpdateImportProfileRequest) (*UpdateImportProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateImportProfile not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteImportProfile(context.Context, *DeleteImportProfileRequest) (*DeleteImportProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteImportProfile not implemented")
+}
+func (UnimplementedLedgerServiceServer) ExportCSV(context.Context, *ExportCSVRequest) (*ExportCSVResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportCSV not implemented")
+}
+func (UnimplementedLedgerServiceServer) ExportTransactions(context.Context, *ExportTransactionsRequest) (*ExportTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportTransactions not implemented")
+}
+func (UnimplementedLedgerServiceServer) ImportStream(grpc.ClientStreamingServer[ImportStreamRequest, ImportCSVResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportStream not implemented")
+}
+func (UnimplementedLedgerServiceServer) ExportStream(*ExportTransactionsRequest, grpc.ServerStreamingServer[ExportStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportStream not implemented")
+}
+func (UnimplementedLedgerServiceServer) SubmitImport(context.Context, *SubmitImportRequest) (*SubmitImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitImport not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetImport(context.Context, *GetImportRequest) (*GetImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetImport not implemented")
+}
+func (UnimplementedLedgerServiceServer) CancelImport(context.Context, *CancelImportRequest) (*CancelImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelImport not implemented")
+}
+func (UnimplementedLedgerServiceServer) WatchImport(*WatchImportRequest, grpc.ServerStreamingServer[WatchImportResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchImport not implemented")
+}
+func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
+func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
+
+type UnsafeLedgerServiceServer interface {
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LedgerService_ServiceDesc, srv)
+}
+
+func _LedgerService_AddTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).AddTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_AddTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).AddTransaction(ctx, req.(*AddTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateTransaction(ctx, req.(*UpdateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetBudget(ctx, req.(*SetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetBudgets(ctx, req.(*GetBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetBudgetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetBudgetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetBudgetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetBudgetHistory(ctx, req.(*GetBudgetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetBudgetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetBudgetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetBudgetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetBudgetStatus(ctx, req.(*GetBudgetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetReport(ctx, req.(*GetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetTagReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetTagReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetTagReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetTagReport(ctx, req.(*GetTagReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CompareReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CompareReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CompareReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CompareReports(ctx, req.(*CompareReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetTrends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetTrends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetTrends(ctx, req.(*GetTrendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetSettings(ctx, req.(*GetSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetBaseCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBaseCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetBaseCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetBaseCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetBaseCurrency(ctx, req.(*SetBaseCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetExchangeRates(ctx, req.(*SetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetExchangeRates(ctx, req.(*GetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateRecurringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateRecurringRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateRecurringRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateRecurringRule(ctx, req.(*CreateRecurringRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetRecurringRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetRecurringRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetRecurringRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetRecurringRules(ctx, req.(*GetRecurringRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateRecurringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateRecurringRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateRecurringRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateRecurringRule(ctx, req.(*UpdateRecurringRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteRecurringRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteRecurringRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteRecurringRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteRecurringRule(ctx, req.(*DeleteRecurringRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetCategories(ctx, req.(*GetCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_MergeCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).MergeCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_MergeCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).MergeCategories(ctx, req.(*MergeCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAccountBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAccountBalances(ctx, req.(*GetAccountBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateCategoryRule(ctx, req.(*CreateCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetCategoryRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetCategoryRules(ctx, req.(*GetCategoryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateCategoryRule(ctx, req.(*UpdateCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ledgerServiceClient) GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetReportResponse)
-	err := c.cc.Invoke(ctx, LedgerService_GetReport_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _LedgerService_DeleteCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRuleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteCategoryRule(ctx, req.(*DeleteCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ledgerServiceClient) ImportCSV(ctx context.Context, in *ImportCSVRequest, opts ...grpc.CallOption) (*ImportCSVResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ImportCSVResponse)
-	err := c.cc.Invoke(ctx, LedgerService_ImportCSV_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _LedgerService_TestCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCategoryRuleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).TestCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_TestCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).TestCategoryRule(ctx, req.(*TestCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *ledgerServiceClient) ExportCSV(ctx context.Context, in *ExportCSVRequest, opts ...grpc.CallOption) (*ExportCSVResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ExportCSVResponse)
-	err := c.cc.Invoke(ctx, LedgerService_ExportCSV_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _LedgerService_ImportCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCSVRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-type LedgerServiceServer interface {
-	AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error)
-	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
-	SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error)
-	GetBudgets(context.Context, *GetBudgetsRequest) (*GetBudgetsResponse, error)
-	GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error)
-	ImportCSV(context.Context, *ImportCSVRequest) (*ImportCSVResponse, error)
-	ExportCSV(context.Context, *ExportCSVRequest) (*ExportCSVResponse, error)
-	mustEmbedUnimplementedLedgerServiceServer()
-}
-
-type UnimplementedLedgerServiceServer struct{}
-
-func (UnimplementedLedgerServiceServer) AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddTransaction not implemented")
-}
-func (UnimplementedLedgerServiceServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTransactions not implemented")
-}
-func (UnimplementedLedgerServiceServer) SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetBudget not implemented")
-}
-func (UnimplementedLedgerServiceServer) GetBudgets(context.Context, *GetBudgetsRequest) (*GetBudgetsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBudgets not implemented")
-}
-func (UnimplementedLedgerServiceServer) GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetReport not implemented")
-}
-func (UnimplementedLedgerServiceServer) ImportCSV(context.Context, *ImportCSVRequest) (*ImportCSVResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ImportCSV not implemented")
-}
-func (UnimplementedLedgerServiceServer) ExportCSV(context.Context, *ExportCSVRequest) (*ExportCSVResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ExportCSV not implemented")
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ImportCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ImportCSV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ImportCSV(ctx, req.(*ImportCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
-func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
 
-type UnsafeLedgerServiceServer interface {
-	mustEmbedUnimplementedLedgerServiceServer()
+func _LedgerService_CreateImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateImportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateImportProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateImportProfile(ctx, req.(*CreateImportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _LedgerService_GetImportProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&LedgerService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetImportProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetImportProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetImportProfiles(ctx, req.(*GetImportProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_AddTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTransactionRequest)
+func _LedgerService_UpdateImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateImportProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).AddTransaction(ctx, in)
+		return srv.(LedgerServiceServer).UpdateImportProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_AddTransaction_FullMethodName,
+		FullMethod: LedgerService_UpdateImportProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).AddTransaction(ctx, req.(*AddTransactionRequest))
+		return srv.(LedgerServiceServer).UpdateImportProfile(ctx, req.(*UpdateImportProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsRequest)
+func _LedgerService_DeleteImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImportProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).GetTransactions(ctx, in)
+		return srv.(LedgerServiceServer).DeleteImportProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_GetTransactions_FullMethodName,
+		FullMethod: LedgerService_DeleteImportProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+		return srv.(LedgerServiceServer).DeleteImportProfile(ctx, req.(*DeleteImportProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetBudgetRequest)
+func _LedgerService_ExportCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCSVRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).SetBudget(ctx, in)
+		return srv.(LedgerServiceServer).ExportCSV(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_SetBudget_FullMethodName,
+		FullMethod: LedgerService_ExportCSV_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).SetBudget(ctx, req.(*SetBudgetRequest))
+		return srv.(LedgerServiceServer).ExportCSV(ctx, req.(*ExportCSVRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_GetBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBudgetsRequest)
+func _LedgerService_ExportTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).GetBudgets(ctx, in)
+		return srv.(LedgerServiceServer).ExportTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_GetBudgets_FullMethodName,
+		FullMethod: LedgerService_ExportTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).GetBudgets(ctx, req.(*GetBudgetsRequest))
+		return srv.(LedgerServiceServer).ExportTransactions(ctx, req.(*ExportTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReportRequest)
+func _LedgerService_ImportStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LedgerServiceServer).ImportStream(&grpc.GenericServerStream[ImportStreamRequest, ImportCSVResponse]{ServerStream: stream})
+}
+
+type LedgerService_ImportStreamServer = grpc.ClientStreamingServer[ImportStreamRequest, ImportCSVResponse]
+
+func _LedgerService_ExportStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LedgerServiceServer).ExportStream(m, &grpc.GenericServerStream[ExportTransactionsRequest, ExportStreamResponse]{ServerStream: stream})
+}
+
+type LedgerService_ExportStreamServer = grpc.ServerStreamingServer[ExportStreamResponse]
+
+func _LedgerService_SubmitImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).GetReport(ctx, in)
+		return srv.(LedgerServiceServer).SubmitImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_GetReport_FullMethodName,
+		FullMethod: LedgerService_SubmitImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).GetReport(ctx, req.(*GetReportRequest))
+		return srv.(LedgerServiceServer).SubmitImport(ctx, req.(*SubmitImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_ImportCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportCSVRequest)
+func _LedgerService_GetImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).ImportCSV(ctx, in)
+		return srv.(LedgerServiceServer).GetImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_ImportCSV_FullMethodName,
+		FullMethod: LedgerService_GetImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).ImportCSV(ctx, req.(*ImportCSVRequest))
+		return srv.(LedgerServiceServer).GetImport(ctx, req.(*GetImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_ExportCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportCSVRequest)
+func _LedgerService_CancelImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).ExportCSV(ctx, in)
+		return srv.(LedgerServiceServer).CancelImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_ExportCSV_FullMethodName,
+		FullMethod: LedgerService_CancelImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).ExportCSV(ctx, req.(*ExportCSVRequest))
+		return srv.(LedgerServiceServer).CancelImport(ctx, req.(*CancelImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerService_WatchImport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchImportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LedgerServiceServer).WatchImport(m, &grpc.GenericServerStream[WatchImportRequest, WatchImportResponse]{ServerStream: stream})
+}
+
+type LedgerService_WatchImportServer = grpc.ServerStreamingServer[WatchImportResponse]
+
 var LedgerService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ledger.v1.LedgerService",
 	HandlerType: (*LedgerServiceServer)(nil),
@@ -294,6 +1780,14 @@ var LedgerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactions",
 			Handler:    _LedgerService_GetTransactions_Handler,
 		},
+		{
+			MethodName: "UpdateTransaction",
+			Handler:    _LedgerService_UpdateTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteTransaction",
+			Handler:    _LedgerService_DeleteTransaction_Handler,
+		},
 		{
 			MethodName: "SetBudget",
 			Handler:    _LedgerService_SetBudget_Handler,
@@ -302,19 +1796,191 @@ var LedgerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBudgets",
 			Handler:    _LedgerService_GetBudgets_Handler,
 		},
+		{
+			MethodName: "GetBudgetHistory",
+			Handler:    _LedgerService_GetBudgetHistory_Handler,
+		},
+		{
+			MethodName: "DeleteBudget",
+			Handler:    _LedgerService_DeleteBudget_Handler,
+		},
+		{
+			MethodName: "GetBudgetStatus",
+			Handler:    _LedgerService_GetBudgetStatus_Handler,
+		},
 		{
 			MethodName: "GetReport",
 			Handler:    _LedgerService_GetReport_Handler,
 		},
+		{
+			MethodName: "GetTagReport",
+			Handler:    _LedgerService_GetTagReport_Handler,
+		},
+		{
+			MethodName: "CompareReports",
+			Handler:    _LedgerService_CompareReports_Handler,
+		},
+		{
+			MethodName: "GetTrends",
+			Handler:    _LedgerService_GetTrends_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _LedgerService_GetSettings_Handler,
+		},
+		{
+			MethodName: "SetBaseCurrency",
+			Handler:    _LedgerService_SetBaseCurrency_Handler,
+		},
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _LedgerService_SetExchangeRates_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _LedgerService_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _LedgerService_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "CreateRecurringRule",
+			Handler:    _LedgerService_CreateRecurringRule_Handler,
+		},
+		{
+			MethodName: "GetRecurringRules",
+			Handler:    _LedgerService_GetRecurringRules_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringRule",
+			Handler:    _LedgerService_UpdateRecurringRule_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringRule",
+			Handler:    _LedgerService_DeleteRecurringRule_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _LedgerService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "GetCategories",
+			Handler:    _LedgerService_GetCategories_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _LedgerService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _LedgerService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "MergeCategories",
+			Handler:    _LedgerService_MergeCategories_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _LedgerService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _LedgerService_GetAccounts_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _LedgerService_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _LedgerService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _LedgerService_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "GetAccountBalances",
+			Handler:    _LedgerService_GetAccountBalances_Handler,
+		},
+		{
+			MethodName: "CreateCategoryRule",
+			Handler:    _LedgerService_CreateCategoryRule_Handler,
+		},
+		{
+			MethodName: "GetCategoryRules",
+			Handler:    _LedgerService_GetCategoryRules_Handler,
+		},
+		{
+			MethodName: "UpdateCategoryRule",
+			Handler:    _LedgerService_UpdateCategoryRule_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryRule",
+			Handler:    _LedgerService_DeleteCategoryRule_Handler,
+		},
+		{
+			MethodName: "TestCategoryRule",
+			Handler:    _LedgerService_TestCategoryRule_Handler,
+		},
 		{
 			MethodName: "ImportCSV",
 			Handler:    _LedgerService_ImportCSV_Handler,
 		},
+		{
+			MethodName: "CreateImportProfile",
+			Handler:    _LedgerService_CreateImportProfile_Handler,
+		},
+		{
+			MethodName: "GetImportProfiles",
+			Handler:    _LedgerService_GetImportProfiles_Handler,
+		},
+		{
+			MethodName: "UpdateImportProfile",
+			Handler:    _LedgerService_UpdateImportProfile_Handler,
+		},
+		{
+			MethodName: "DeleteImportProfile",
+			Handler:    _LedgerService_DeleteImportProfile_Handler,
+		},
 		{
 			MethodName: "ExportCSV",
 			Handler:    _LedgerService_ExportCSV_Handler,
 		},
+		{
+			MethodName: "ExportTransactions",
+			Handler:    _LedgerService_ExportTransactions_Handler,
+		},
+		{
+			MethodName: "SubmitImport",
+			Handler:    _LedgerService_SubmitImport_Handler,
+		},
+		{
+			MethodName: "GetImport",
+			Handler:    _LedgerService_GetImport_Handler,
+		},
+		{
+			MethodName: "CancelImport",
+			Handler:    _LedgerService_CancelImport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportStream",
+			Handler:       _LedgerService_ImportStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportStream",
+			Handler:       _LedgerService_ExportStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchImport",
+			Handler:       _LedgerService_WatchImport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ledger.proto",
 }
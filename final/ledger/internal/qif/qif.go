@@ -0,0 +1,217 @@
+package qif
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+var dateLayouts = []string{"1/2/2006", "1/2/06", "2006-01-02", "2.1.2006", "2.1.06"}
+
+var accountTypes = map[string]bool{
+	"bank":  true,
+	"cash":  true,
+	"ccard": true,
+	"oth a": true,
+	"oth l": true,
+}
+
+type split struct {
+	category string
+	memo     string
+	amount   string
+}
+
+type record struct {
+	line     int
+	date     string
+	amount   string
+	payee    string
+	memo     string
+	category string
+	splits   []split
+}
+
+func ParseQIF(data []byte, userID int64, rules []domain.CategoryRule) ([]domain.ImportRow, error) {
+	text, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode QIF: %w", err)
+	}
+
+	var rows []domain.ImportRow
+	var current record
+	typed := false
+	supported := false
+
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if line[0] == '!' {
+			header := strings.ToLower(line)
+			switch {
+			case strings.HasPrefix(header, "!type:"):
+				typed = true
+				supported = accountTypes[strings.TrimSpace(header[len("!type:"):])]
+			case header == "!account":
+				supported = false
+			}
+			current = record{}
+			continue
+		}
+
+		if line[0] == '^' {
+			if supported && current.line != 0 {
+				rows = append(rows, current.row(userID, rules))
+			}
+			current = record{}
+			continue
+		}
+
+		if current.line == 0 {
+			current.line = i + 1
+		}
+		current.set(line[0], strings.TrimSpace(line[1:]))
+	}
+	if !typed {
+		return nil, errors.New("not a QIF file: !Type header not found")
+	}
+	if supported && current.line != 0 {
+		rows = append(rows, current.row(userID, rules))
+	}
+
+	return rows, nil
+}
+
+func (r *record) set(code byte, value string) {
+	switch code {
+	case 'D':
+		r.date = value
+	case 'T':
+		r.amount = value
+	case 'U':
+		if r.amount == "" {
+			r.amount = value
+		}
+	case 'P':
+		r.payee = value
+	case 'M':
+		r.memo = value
+	case 'L':
+		r.category = value
+	case 'S':
+		r.splits = append(r.splits, split{category: value})
+	case 'E':
+		if len(r.splits) > 0 {
+			r.splits[len(r.splits)-1].memo = value
+		}
+	case '$':
+		if len(r.splits) > 0 {
+			r.splits[len(r.splits)-1].amount = value
+		}
+	}
+}
+
+func (r *record) row(userID int64, rules []domain.CategoryRule) domain.ImportRow {
+	row := domain.ImportRow{Line: r.line, Status: domain.RowReady}
+
+	amount, err := parseAmount(r.amount)
+	if err != nil {
+		row.Fail(fmt.Errorf("invalid amount '%s'", r.amount))
+		return row
+	}
+	date, err := parseDate(r.date)
+	if err != nil {
+		row.Fail(fmt.Errorf("invalid date '%s'", r.date))
+		return row
+	}
+
+	kind := domain.KindIncome
+	if amount < 0 {
+		kind = domain.KindExpense
+		amount = -amount
+	}
+
+	tx := domain.Transaction{
+		UserID:      userID,
+		Kind:        kind,
+		Amount:      amount,
+		Category:    category(r.category),
+		Description: description(r.payee, r.memo),
+		Date:        date,
+	}
+	if len(r.splits) > 1 {
+		for i, s := range r.splits {
+			splitAmount, err := parseAmount(s.amount)
+			if err != nil {
+				row.Fail(fmt.Errorf("split %d: invalid amount '%s'", i+1, s.amount))
+				return row
+			}
+			if splitAmount < 0 {
+				splitAmount = -splitAmount
+			}
+			tx.Splits = append(tx.Splits, domain.TransactionSplit{
+				Category: category(s.category),
+				Amount:   splitAmount,
+				Note:     s.memo,
+			})
+		}
+	}
+
+	row.Transaction = tx
+	row.Categorize(rules)
+	return row
+}
+
+func parseAmount(value string) (domain.Money, error) {
+	return domain.ParseMoney(strings.NewReplacer(",", "", " ", "").Replace(value))
+}
+
+func parseDate(value string) (time.Time, error) {
+	value = strings.ReplaceAll(strings.ReplaceAll(value, " ", ""), "'", "/")
+	for _, layout := range dateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, errors.New("unknown date format")
+}
+
+func category(value string) string {
+	if strings.HasPrefix(value, "[") {
+		return ""
+	}
+	value, _, _ = strings.Cut(value, "/")
+	if i := strings.LastIndex(value, ":"); i >= 0 {
+		value = value[i+1:]
+	}
+	return strings.TrimSpace(value)
+}
+
+func description(payee, memo string) string {
+	if memo == "" || strings.EqualFold(memo, payee) {
+		return payee
+	}
+	if payee == "" {
+		return memo
+	}
+	return payee + " / " + memo
+}
+
+func decode(data []byte) (string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(data) {
+		return string(data), nil
+	}
+	decoded, err := charmap.Windows1251.NewDecoder().Bytes(data)
+	return string(decoded), err
+}
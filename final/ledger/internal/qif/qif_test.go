@@ -0,0 +1,111 @@
+package qif
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func TestParseQIF(t *testing.T) {
+	data := []byte(`!Type:Bank
+D03/14/2025
+T-1,350.50
+PКофе Хауз
+LЕда:Кафе
+^
+D15.3.2025
+T120000.00
+PЗарплата
+MМарт
+LДоход:Зарплата
+^
+D03/16'25
+T-100.00
+PАшан
+SПродукты
+$-60.00
+EОвощи
+SХозтовары
+$-40.00
+^
+D03/17/2025
+T-500.00
+PПеревод
+L[Накопления]
+^
+DXX
+T-1.00
+^
+!Account
+NНакопления
+^
+`)
+
+	rows, err := ParseQIF(data, 1, nil)
+	if err != nil {
+		t.Fatalf("ParseQIF() error = %v", err)
+	}
+	if len(rows) != 5 {
+		t.Fatalf("len(rows) = %d, want 5", len(rows))
+	}
+
+	want := []domain.Transaction{
+		{UserID: 1, Kind: domain.KindExpense, Amount: 135050, Category: "Кафе", Description: "Кофе Хауз", Date: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{UserID: 1, Kind: domain.KindIncome, Amount: 12000000, Category: "Зарплата", Description: "Зарплата / Март", Date: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{UserID: 1, Kind: domain.KindExpense, Amount: 10000, Category: "Продукты", Description: "Ашан", Date: time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC),
+			Splits: []domain.TransactionSplit{
+				{Category: "Продукты", Amount: 6000, Note: "Овощи"},
+				{Category: "Хозтовары", Amount: 4000},
+			}},
+	}
+	for i, tx := range want {
+		if rows[i].Status != domain.RowReady {
+			t.Fatalf("rows[%d].Status = %q (%s), want ready", i, rows[i].Status, rows[i].Error)
+		}
+		if !reflect.DeepEqual(rows[i].Transaction, tx) {
+			t.Errorf("rows[%d].Transaction = %+v, want %+v", i, rows[i].Transaction, tx)
+		}
+	}
+	if rows[3].Status != domain.RowFailed {
+		t.Errorf("transfer without rule: Status = %q, want failed", rows[3].Status)
+	}
+	if rows[4].Status != domain.RowFailed || rows[4].Error != "invalid date 'XX'" {
+		t.Errorf("rows[4] = %s (%s), want failed with invalid date", rows[4].Status, rows[4].Error)
+	}
+	if rows[1].Line != 7 {
+		t.Errorf("rows[1].Line = %d, want 7", rows[1].Line)
+	}
+}
+
+func TestParseQIF_Windows1251(t *testing.T) {
+	data, err := charmap.Windows1251.NewEncoder().Bytes([]byte("!Type:Cash\nD2025-03-14\nT-350\nPКофе\nLКафе\n^\n"))
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	rows, err := ParseQIF(data, 1, nil)
+	if err != nil {
+		t.Fatalf("ParseQIF() error = %v", err)
+	}
+	if len(rows) != 1 || rows[0].Transaction.Category != "Кафе" || rows[0].Transaction.Description != "Кофе" {
+		t.Errorf("rows = %+v, want one row with Кафе / Кофе", rows)
+	}
+}
+
+func TestParseQIF_Unsupported(t *testing.T) {
+	if _, err := ParseQIF([]byte("amount,category\n1,food\n"), 1, nil); err == nil {
+		t.Error("ParseQIF() error = nil without !Type header")
+	}
+
+	rows, err := ParseQIF([]byte("!Type:Invst\nD03/14/2025\nT-100\nLКафе\n^\n"), 1, nil)
+	if err != nil {
+		t.Fatalf("ParseQIF() error = %v", err)
+	}
+	if len(rows) != 0 {
+		t.Errorf("len(rows) = %d for investment account, want 0", len(rows))
+	}
+}
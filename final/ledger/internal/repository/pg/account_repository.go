@@ -0,0 +1,195 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type AccountRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAccountRepository(db *pgxpool.Pool) *AccountRepository {
+	return &AccountRepository{db: db}
+}
+
+const accountEntries = `(
+		SELECT t.account_id, t.date,
+			CASE
+				WHEN t.kind = 'income' THEN t.amount
+				WHEN t.kind = 'transfer' AND tf.to_account_id = t.account_id THEN t.amount
+				ELSE -t.amount
+			END AS amount
+		FROM transactions t
+		LEFT JOIN transfers tf ON tf.id = t.transfer_id
+		WHERE t.account_id IS NOT NULL
+	) e`
+
+const accountColumns = `a.id, a.user_id, a.name, a.type, a.currency, a.opening_balance, a.archived, a.created_at,
+	a.opening_balance + COALESCE((SELECT SUM(e.amount) FROM ` + accountEntries + ` WHERE e.account_id = a.id), 0)`
+
+func scanAccount(row pgx.Row, a *domain.Account) error {
+	return row.Scan(&a.ID, &a.UserID, &a.Name, &a.Type, &a.Currency, &a.OpeningBalance, &a.Archived, &a.CreatedAt, &a.Balance)
+}
+
+func accountError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrDuplicateAccount
+	}
+	return err
+}
+
+func (r *AccountRepository) Create(ctx context.Context, account *domain.Account) error {
+	query := `
+		INSERT INTO accounts (user_id, name, type, currency, opening_balance, archived)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRow(ctx, query,
+		account.UserID, account.Name, account.Type, account.Currency, account.OpeningBalance, account.Archived,
+	).Scan(&account.ID, &account.CreatedAt)
+	if err != nil {
+		return accountError(err)
+	}
+	account.Balance = account.OpeningBalance
+	return nil
+}
+
+func (r *AccountRepository) GetByID(ctx context.Context, id, userID int64) (*domain.Account, error) {
+	query := `
+		SELECT ` + accountColumns + `
+		FROM accounts a
+		WHERE a.id = $1 AND a.user_id = $2
+	`
+	var a domain.Account
+	if err := scanAccount(r.db.QueryRow(ctx, query, id, userID), &a); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *AccountRepository) GetByUserID(ctx context.Context, userID int64, includeArchived bool) ([]domain.Account, error) {
+	query := `
+		SELECT ` + accountColumns + `
+		FROM accounts a
+		WHERE a.user_id = $1 AND (a.archived = FALSE OR $2)
+		ORDER BY lower(a.name)
+	`
+	rows, err := r.db.Query(ctx, query, userID, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []domain.Account
+	for rows.Next() {
+		var a domain.Account
+		if err := scanAccount(rows, &a); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	return accounts, rows.Err()
+}
+
+func (r *AccountRepository) Update(ctx context.Context, account *domain.Account) error {
+	query := `
+		UPDATE accounts a
+		SET name = $3, type = $4, currency = $5, opening_balance = $6, archived = $7
+		WHERE a.id = $1 AND a.user_id = $2
+		RETURNING ` + accountColumns
+	err := scanAccount(r.db.QueryRow(ctx, query,
+		account.ID, account.UserID, account.Name, account.Type, account.Currency, account.OpeningBalance, account.Archived,
+	), account)
+	return accountError(err)
+}
+
+func (r *AccountRepository) Delete(ctx context.Context, id, userID int64) (bool, error) {
+	query := `
+		DELETE FROM accounts
+		WHERE id = $1 AND user_id = $2
+	`
+	tag, err := r.db.Exec(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *AccountRepository) InUse(ctx context.Context, id, userID int64) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM transactions WHERE user_id = $2 AND account_id = $1)
+			OR EXISTS (SELECT 1 FROM transfers WHERE user_id = $2 AND (from_account_id = $1 OR to_account_id = $1))
+	`
+	var used bool
+	err := r.db.QueryRow(ctx, query, id, userID).Scan(&used)
+	return used, err
+}
+
+func (r *AccountRepository) CreateTransfer(ctx context.Context, transfer *domain.Transfer, out, in *domain.Transaction) error {
+	dbTx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback(ctx)
+
+	query := `
+		INSERT INTO transfers (user_id, from_account_id, to_account_id, amount, to_amount, description, date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+	err = dbTx.QueryRow(ctx, query,
+		transfer.UserID, transfer.FromAccountID, transfer.ToAccountID, transfer.Amount, transfer.ToAmount, transfer.Description, transfer.Date,
+	).Scan(&transfer.ID, &transfer.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range []*domain.Transaction{out, in} {
+		tx.TransferID = &transfer.ID
+		if err := insertTransaction(ctx, dbTx, tx); err != nil {
+			return err
+		}
+	}
+
+	return dbTx.Commit(ctx)
+}
+
+func (r *AccountRepository) GetBalances(ctx context.Context, id, userID int64, dates []time.Time) ([]domain.BalancePoint, error) {
+	query := `
+		SELECT d.date, a.opening_balance + COALESCE((
+			SELECT SUM(e.amount) FROM ` + accountEntries + `
+			WHERE e.account_id = a.id AND e.date <= d.date
+		), 0)
+		FROM accounts a
+		CROSS JOIN unnest($3::date[]) AS d(date)
+		WHERE a.id = $1 AND a.user_id = $2
+		ORDER BY d.date
+	`
+	rows, err := r.db.Query(ctx, query, id, userID, dates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []domain.BalancePoint
+	for rows.Next() {
+		var p domain.BalancePoint
+		if err := rows.Scan(&p.Date, &p.Balance); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
@@ -3,6 +3,7 @@ package pg
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -18,55 +19,145 @@ func NewBudgetRepository(db *pgxpool.Pool) *BudgetRepository {
 	return &BudgetRepository{db: db}
 }
 
+const budgetColumns = `id, user_id, category, limit_amount, currency, period, start_day, rollover, rollover_from, enforcement, warning_thresholds, valid_from, valid_to`
+
+func scanBudget(row pgx.Row, b *domain.Budget) error {
+	return row.Scan(&b.ID, &b.UserID, &b.Category, &b.LimitAmount, &b.Currency, &b.Period,
+		&b.StartDay, &b.Rollover, &b.RolloverFrom, &b.Enforcement, &b.Thresholds, &b.ValidFrom, &b.ValidTo)
+}
+
 func (r *BudgetRepository) Upsert(ctx context.Context, budget *domain.Budget) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM budgets
+		WHERE user_id = $1 AND category = $2 AND valid_from > $3
+	`, budget.UserID, budget.Category, budget.ValidFrom)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE budgets SET valid_to = $3
+		WHERE user_id = $1 AND category = $2 AND valid_from < $3 AND (valid_to IS NULL OR valid_to > $3)
+	`, budget.UserID, budget.Category, budget.ValidFrom)
+	if err != nil {
+		return err
+	}
+
 	query := `
-		INSERT INTO budgets (user_id, category, limit_amount, period)
-		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (user_id, category) DO UPDATE SET
+		INSERT INTO budgets (user_id, category, limit_amount, currency, period, start_day, rollover, rollover_from,
+			enforcement, warning_thresholds, valid_from)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (user_id, category, valid_from) DO UPDATE SET
 			limit_amount = EXCLUDED.limit_amount,
-			period = EXCLUDED.period
+			currency = EXCLUDED.currency,
+			period = EXCLUDED.period,
+			start_day = EXCLUDED.start_day,
+			rollover = EXCLUDED.rollover,
+			rollover_from = EXCLUDED.rollover_from,
+			enforcement = EXCLUDED.enforcement,
+			warning_thresholds = EXCLUDED.warning_thresholds,
+			valid_to = NULL
 		RETURNING id
 	`
-	return r.db.QueryRow(ctx, query,
-		budget.UserID, budget.Category, budget.LimitAmount, budget.Period,
+	err = tx.QueryRow(ctx, query,
+		budget.UserID, budget.Category, budget.LimitAmount, budget.Currency, budget.Period,
+		budget.StartDay, budget.Rollover, budget.RolloverFrom, budget.Enforcement, budget.Thresholds, budget.ValidFrom,
 	).Scan(&budget.ID)
+	if err != nil {
+		return err
+	}
+	budget.ValidTo = nil
+
+	return tx.Commit(ctx)
+}
+
+func (r *BudgetRepository) Delete(ctx context.Context, userID int64, category string, from time.Time) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	deleted, err := tx.Exec(ctx, `
+		DELETE FROM budgets
+		WHERE user_id = $1 AND category = $2 AND valid_from >= $3
+	`, userID, category, from)
+	if err != nil {
+		return false, err
+	}
+
+	closed, err := tx.Exec(ctx, `
+		UPDATE budgets SET valid_to = $3
+		WHERE user_id = $1 AND category = $2 AND valid_from < $3 AND (valid_to IS NULL OR valid_to > $3)
+	`, userID, category, from)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return deleted.RowsAffected()+closed.RowsAffected() > 0, nil
 }
 
 func (r *BudgetRepository) GetByUserID(ctx context.Context, userID int64) ([]domain.Budget, error) {
 	query := `
-		SELECT id, user_id, category, limit_amount, period
+		SELECT ` + budgetColumns + `
 		FROM budgets
-		WHERE user_id = $1
+		WHERE user_id = $1 AND valid_to IS NULL
 		ORDER BY category
 	`
-	rows, err := r.db.Query(ctx, query, userID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var budgets []domain.Budget
-	for rows.Next() {
-		var b domain.Budget
-		err := rows.Scan(&b.ID, &b.UserID, &b.Category, &b.LimitAmount, &b.Period)
-		if err != nil {
-			return nil, err
-		}
-		budgets = append(budgets, b)
-	}
-	return budgets, rows.Err()
+	return r.queryBudgets(ctx, query, userID)
 }
 
 func (r *BudgetRepository) GetByCategory(ctx context.Context, userID int64, category string) (*domain.Budget, error) {
 	query := `
-		SELECT id, user_id, category, limit_amount, period
+		SELECT ` + budgetColumns + `
+		FROM budgets
+		WHERE user_id = $1 AND category = $2 AND valid_to IS NULL
+	`
+	return r.queryBudget(ctx, query, userID, category)
+}
+
+func (r *BudgetRepository) GetInForce(ctx context.Context, userID int64, category string, date time.Time) (*domain.Budget, error) {
+	query := `
+		SELECT ` + budgetColumns + `
 		FROM budgets
 		WHERE user_id = $1 AND category = $2
+			AND valid_from <= $3::date AND (valid_to IS NULL OR valid_to > $3::date)
 	`
+	return r.queryBudget(ctx, query, userID, category, date)
+}
+
+func (r *BudgetRepository) GetHistory(ctx context.Context, userID int64, category string) ([]domain.Budget, error) {
+	query := `
+		SELECT ` + budgetColumns + `
+		FROM budgets
+		WHERE user_id = $1 AND category = $2
+		ORDER BY valid_from DESC
+	`
+	return r.queryBudgets(ctx, query, userID, category)
+}
+
+func (r *BudgetRepository) GetHistoryByUserID(ctx context.Context, userID int64) ([]domain.Budget, error) {
+	query := `
+		SELECT ` + budgetColumns + `
+		FROM budgets
+		WHERE user_id = $1
+		ORDER BY category, valid_from DESC
+	`
+	return r.queryBudgets(ctx, query, userID)
+}
+
+func (r *BudgetRepository) queryBudget(ctx context.Context, query string, args ...interface{}) (*domain.Budget, error) {
 	var b domain.Budget
-	err := r.db.QueryRow(ctx, query, userID, category).
-		Scan(&b.ID, &b.UserID, &b.Category, &b.LimitAmount, &b.Period)
-	if err != nil {
+	if err := scanBudget(r.db.QueryRow(ctx, query, args...), &b); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
@@ -75,5 +166,20 @@ func (r *BudgetRepository) GetByCategory(ctx context.Context, userID int64, cate
 	return &b, nil
 }
 
+func (r *BudgetRepository) queryBudgets(ctx context.Context, query string, args ...interface{}) ([]domain.Budget, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-
+	var budgets []domain.Budget
+	for rows.Next() {
+		var b domain.Budget
+		if err := scanBudget(rows, &b); err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, b)
+	}
+	return budgets, rows.Err()
+}
@@ -0,0 +1,281 @@
+package pg
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type CategoryRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCategoryRepository(db *pgxpool.Pool) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+const categoryColumns = `id, user_id, name, parent_id, archived, created_at`
+
+func scanCategory(row pgx.Row, c *domain.Category) error {
+	return row.Scan(&c.ID, &c.UserID, &c.Name, &c.ParentID, &c.Archived, &c.CreatedAt)
+}
+
+func categoryError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrDuplicateCategory
+	}
+	return err
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, category *domain.Category) error {
+	query := `
+		INSERT INTO categories (user_id, name, parent_id, archived)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRow(ctx, query, category.UserID, category.Name, category.ParentID, category.Archived).
+		Scan(&category.ID, &category.CreatedAt)
+	return categoryError(err)
+}
+
+func (r *CategoryRepository) Ensure(ctx context.Context, userID int64, name string) (*domain.Category, error) {
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO categories (user_id, name)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, lower(name)) DO NOTHING
+	`, userID, name)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetByName(ctx, userID, name)
+}
+
+func (r *CategoryRepository) GetByID(ctx context.Context, id, userID int64) (*domain.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE id = $1 AND user_id = $2
+	`
+	return r.queryOne(ctx, query, id, userID)
+}
+
+func (r *CategoryRepository) GetByName(ctx context.Context, userID int64, name string) (*domain.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE user_id = $1 AND lower(name) = lower($2)
+	`
+	return r.queryOne(ctx, query, userID, name)
+}
+
+func (r *CategoryRepository) GetByUserID(ctx context.Context, userID int64, includeArchived bool) ([]domain.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE user_id = $1 AND (archived = FALSE OR $2)
+		ORDER BY lower(name)
+	`
+	rows, err := r.db.Query(ctx, query, userID, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []domain.Category
+	for rows.Next() {
+		var c domain.Category
+		if err := scanCategory(rows, &c); err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+	return categories, rows.Err()
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, category *domain.Category, previousName string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE categories
+		SET name = $3, parent_id = $4, archived = $5
+		WHERE id = $1 AND user_id = $2
+		RETURNING created_at
+	`
+	err = tx.QueryRow(ctx, query, category.ID, category.UserID, category.Name, category.ParentID, category.Archived).
+		Scan(&category.CreatedAt)
+	if err != nil {
+		return categoryError(err)
+	}
+
+	if category.Name != previousName {
+		if _, err := renameCategoryUsages(ctx, tx, category.UserID, previousName, category.Name); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, id, userID int64) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		UPDATE categories child
+		SET parent_id = deleted.parent_id
+		FROM categories deleted
+		WHERE deleted.id = $1 AND deleted.user_id = $2 AND child.parent_id = deleted.id
+	`, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := tx.Exec(ctx, `
+		DELETE FROM categories
+		WHERE id = $1 AND user_id = $2
+	`, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *CategoryRepository) InUse(ctx context.Context, userID int64, name string) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM transactions WHERE user_id = $1 AND category = $2)
+			OR EXISTS (SELECT 1 FROM budgets WHERE user_id = $1 AND category = $2)
+			OR EXISTS (SELECT 1 FROM recurring_rules WHERE user_id = $1 AND category = $2)
+			OR EXISTS (SELECT 1 FROM category_rules WHERE user_id = $1 AND category = $2)
+			OR EXISTS (
+				SELECT 1 FROM transaction_splits sp JOIN transactions t ON t.id = sp.transaction_id
+				WHERE t.user_id = $1 AND sp.category = $2
+			)
+	`
+	var used bool
+	err := r.db.QueryRow(ctx, query, userID, name).Scan(&used)
+	return used, err
+}
+
+func (r *CategoryRepository) Merge(ctx context.Context, source, target *domain.Category) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	moved, err := renameCategoryUsages(ctx, tx, source.UserID, source.Name, target.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE categories SET parent_id = $3
+		WHERE id = $1 AND user_id = $2
+	`, target.ID, target.UserID, target.ParentID)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE categories SET parent_id = $3
+		WHERE user_id = $1 AND parent_id = $2 AND id <> $3
+	`, source.UserID, source.ID, target.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM categories
+		WHERE id = $1 AND user_id = $2
+	`, source.ID, source.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return moved, nil
+}
+
+func renameCategoryUsages(ctx context.Context, tx pgx.Tx, userID int64, from, to string) (int64, error) {
+	tag, err := tx.Exec(ctx, `
+		UPDATE transactions SET category = $3
+		WHERE user_id = $1 AND category = $2
+	`, userID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE transaction_splits sp SET category = $3
+		FROM transactions t
+		WHERE t.id = sp.transaction_id AND t.user_id = $1 AND sp.category = $2
+	`, userID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE recurring_rules SET category = $3
+		WHERE user_id = $1 AND category = $2
+	`, userID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE category_rules SET category = $3
+		WHERE user_id = $1 AND category = $2
+	`, userID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM budgets
+		WHERE user_id = $1 AND category = $2
+			AND EXISTS (SELECT 1 FROM budgets WHERE user_id = $1 AND category = $3)
+	`, userID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE budgets SET category = $3
+		WHERE user_id = $1 AND category = $2
+	`, userID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *CategoryRepository) queryOne(ctx context.Context, query string, args ...interface{}) (*domain.Category, error) {
+	var c domain.Category
+	if err := scanCategory(r.db.QueryRow(ctx, query, args...), &c); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,98 @@
+package pg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func TestCategoryRepository_RenameUpdatesRules(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	categories := NewCategoryRepository(pool)
+	rules := NewCategoryRuleRepository(pool)
+
+	category := &domain.Category{UserID: 1, Name: "cafe"}
+	if err := categories.Create(ctx, category); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	rule := &domain.CategoryRule{UserID: 1, MatchType: domain.MatchContains, Pattern: "кофе", Category: "cafe"}
+	if err := rules.Create(ctx, rule); err != nil {
+		t.Fatalf("Create(rule) error = %v", err)
+	}
+
+	category.Name = "coffee"
+	if err := categories.Update(ctx, category, "cafe"); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	assertRuleCategories(t, rules, "coffee")
+	if used, err := categories.InUse(ctx, 1, "cafe"); err != nil || used {
+		t.Errorf("InUse(old name) = %v, %v, want false", used, err)
+	}
+}
+
+func TestCategoryRepository_MergeUpdatesRules(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	categories := NewCategoryRepository(pool)
+	rules := NewCategoryRuleRepository(pool)
+
+	source := &domain.Category{UserID: 1, Name: "coffee"}
+	target := &domain.Category{UserID: 1, Name: "cafe"}
+	for _, category := range []*domain.Category{source, target} {
+		if err := categories.Create(ctx, category); err != nil {
+			t.Fatalf("Create(%s) error = %v", category.Name, err)
+		}
+	}
+	for _, pattern := range []string{"кофе", "starbucks"} {
+		rule := &domain.CategoryRule{UserID: 1, MatchType: domain.MatchContains, Pattern: pattern, Category: "coffee"}
+		if err := rules.Create(ctx, rule); err != nil {
+			t.Fatalf("Create(rule) error = %v", err)
+		}
+	}
+
+	if _, err := categories.Merge(ctx, source, target); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+
+	assertRuleCategories(t, rules, "cafe", "cafe")
+	if found, err := categories.GetByName(ctx, 1, "coffee"); err != nil || found != nil {
+		t.Errorf("GetByName(source) = %v, %v, want nil", found, err)
+	}
+}
+
+func TestCategoryRepository_InUseByRule(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	categories := NewCategoryRepository(pool)
+	rules := NewCategoryRuleRepository(pool)
+
+	if used, err := categories.InUse(ctx, 1, "cafe"); err != nil || used {
+		t.Fatalf("InUse() before rule = %v, %v, want false", used, err)
+	}
+	rule := &domain.CategoryRule{UserID: 1, MatchType: domain.MatchContains, Pattern: "кофе", Category: "cafe"}
+	if err := rules.Create(ctx, rule); err != nil {
+		t.Fatalf("Create(rule) error = %v", err)
+	}
+	if used, err := categories.InUse(ctx, 1, "cafe"); err != nil || !used {
+		t.Errorf("InUse() with rule = %v, %v, want true", used, err)
+	}
+}
+
+func assertRuleCategories(t *testing.T, rules *CategoryRuleRepository, want ...string) {
+	t.Helper()
+	got, err := rules.GetByUserID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetByUserID() error = %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("len(rules) = %d, want %d", len(got), len(want))
+	}
+	for i, rule := range got {
+		if rule.Category != want[i] {
+			t.Errorf("rules[%d].Category = %q, want %q", i, rule.Category, want[i])
+		}
+	}
+}
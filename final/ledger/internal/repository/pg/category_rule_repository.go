@@ -0,0 +1,96 @@
+package pg
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type CategoryRuleRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCategoryRuleRepository(db *pgxpool.Pool) *CategoryRuleRepository {
+	return &CategoryRuleRepository{db: db}
+}
+
+const categoryRuleColumns = `id, user_id, match_type, pattern, min_amount, max_amount, category, tags, priority, created_at`
+
+func scanCategoryRule(row pgx.Row, rule *domain.CategoryRule) error {
+	return row.Scan(&rule.ID, &rule.UserID, &rule.MatchType, &rule.Pattern, &rule.MinAmount, &rule.MaxAmount,
+		&rule.Category, &rule.Tags, &rule.Priority, &rule.CreatedAt)
+}
+
+func (r *CategoryRuleRepository) Create(ctx context.Context, rule *domain.CategoryRule) error {
+	query := `
+		INSERT INTO category_rules (user_id, match_type, pattern, min_amount, max_amount, category, tags, priority)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+	return r.db.QueryRow(ctx, query,
+		rule.UserID, rule.MatchType, rule.Pattern, rule.MinAmount, rule.MaxAmount, rule.Category, ruleTags(rule.Tags), rule.Priority,
+	).Scan(&rule.ID, &rule.CreatedAt)
+}
+
+func (r *CategoryRuleRepository) GetByUserID(ctx context.Context, userID int64) ([]domain.CategoryRule, error) {
+	query := `
+		SELECT ` + categoryRuleColumns + `
+		FROM category_rules
+		WHERE user_id = $1
+		ORDER BY priority, id
+	`
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []domain.CategoryRule
+	for rows.Next() {
+		var rule domain.CategoryRule
+		if err := scanCategoryRule(rows, &rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func (r *CategoryRuleRepository) Update(ctx context.Context, rule *domain.CategoryRule) (bool, error) {
+	query := `
+		UPDATE category_rules
+		SET match_type = $3, pattern = $4, min_amount = $5, max_amount = $6, category = $7, tags = $8, priority = $9
+		WHERE id = $1 AND user_id = $2
+		RETURNING created_at
+	`
+	err := r.db.QueryRow(ctx, query,
+		rule.ID, rule.UserID, rule.MatchType, rule.Pattern, rule.MinAmount, rule.MaxAmount, rule.Category, ruleTags(rule.Tags), rule.Priority,
+	).Scan(&rule.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *CategoryRuleRepository) Delete(ctx context.Context, id, userID int64) (bool, error) {
+	query := `
+		DELETE FROM category_rules
+		WHERE id = $1 AND user_id = $2
+	`
+	tag, err := r.db.Exec(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func ruleTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
@@ -0,0 +1,124 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type ExchangeRateRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewExchangeRateRepository(db *pgxpool.Pool) *ExchangeRateRepository {
+	return &ExchangeRateRepository{db: db}
+}
+
+func rateJoin(currencyParam int) string {
+	p := "$" + strconv.Itoa(currencyParam)
+	return `
+		LEFT JOIN LATERAL (
+			SELECT CASE WHEN t.currency = ` + p + ` THEN 1 ELSE (
+				SELECT x.rate FROM (
+					(SELECT er.rate, er.date FROM exchange_rates er
+					 WHERE er.user_id = t.user_id AND er.base_currency = t.currency
+					   AND er.quote_currency = ` + p + ` AND er.date <= t.date
+					 ORDER BY er.date DESC LIMIT 1)
+					UNION ALL
+					(SELECT 1 / er.rate, er.date FROM exchange_rates er
+					 WHERE er.user_id = t.user_id AND er.base_currency = ` + p + `
+					   AND er.quote_currency = t.currency AND er.date <= t.date
+					 ORDER BY er.date DESC LIMIT 1)
+				) x ORDER BY x.date DESC LIMIT 1
+			) END AS rate
+		) r ON true
+	`
+}
+
+func missingRateError(from, to string) error {
+	return fmt.Errorf("%w: %s -> %s", domain.ErrExchangeRateNotFound, from, to)
+}
+
+func (r *ExchangeRateRepository) Upsert(ctx context.Context, rates []domain.ExchangeRate) error {
+	query := `
+		INSERT INTO exchange_rates (user_id, base_currency, quote_currency, date, rate)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, base_currency, quote_currency, date) DO UPDATE SET
+			rate = EXCLUDED.rate
+	`
+	batch := &pgx.Batch{}
+	for _, rate := range rates {
+		batch.Queue(query, rate.UserID, rate.BaseCurrency, rate.QuoteCurrency, rate.Date, rate.Rate)
+	}
+	return r.db.SendBatch(ctx, batch).Close()
+}
+
+func (r *ExchangeRateRepository) GetRate(ctx context.Context, userID int64, base, quote string, date time.Time) (float64, error) {
+	if base == quote {
+		return 1, nil
+	}
+	query := `
+		SELECT x.rate FROM (
+			(SELECT rate, date FROM exchange_rates
+			 WHERE user_id = $1 AND base_currency = $2 AND quote_currency = $3 AND date <= $4
+			 ORDER BY date DESC LIMIT 1)
+			UNION ALL
+			(SELECT 1 / rate, date FROM exchange_rates
+			 WHERE user_id = $1 AND base_currency = $3 AND quote_currency = $2 AND date <= $4
+			 ORDER BY date DESC LIMIT 1)
+		) x
+		ORDER BY x.date DESC
+		LIMIT 1
+	`
+	var rate float64
+	err := r.db.QueryRow(ctx, query, userID, base, quote, date).Scan(&rate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, missingRateError(base, quote)
+		}
+		return 0, err
+	}
+	return rate, nil
+}
+
+func (r *ExchangeRateRepository) List(ctx context.Context, userID int64, from, to *time.Time) ([]domain.ExchangeRate, error) {
+	query := `
+		SELECT user_id, base_currency, quote_currency, date, rate
+		FROM exchange_rates
+		WHERE user_id = $1
+	`
+	args := []interface{}{userID}
+	if from != nil {
+		args = append(args, *from)
+		query += ` AND date >= $` + strconv.Itoa(len(args))
+	}
+	if to != nil {
+		args = append(args, *to)
+		query += ` AND date <= $` + strconv.Itoa(len(args))
+	}
+	query += ` ORDER BY date DESC, base_currency, quote_currency`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []domain.ExchangeRate
+	for rows.Next() {
+		var rate domain.ExchangeRate
+		err := rows.Scan(&rate.UserID, &rate.BaseCurrency, &rate.QuoteCurrency, &rate.Date, &rate.Rate)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, rows.Err()
+}
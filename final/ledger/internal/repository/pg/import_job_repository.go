@@ -0,0 +1,204 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type ImportJobRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewImportJobRepository(db *pgxpool.Pool) *ImportJobRepository {
+	return &ImportJobRepository{db: db}
+}
+
+const importJobColumns = `id, user_id, status, format, profile, duplicate_mode, atomic, total_rows, processed_rows,
+	imported_rows, duplicate_rows, failed_rows, error, created_at, updated_at, started_at, finished_at`
+
+func importJobFields(j *domain.ImportJob) []interface{} {
+	return []interface{}{&j.ID, &j.UserID, &j.Status, &j.Format, &j.Profile, &j.DuplicateMode, &j.Atomic, &j.TotalRows, &j.ProcessedRows,
+		&j.ImportedRows, &j.DuplicateRows, &j.FailedRows, &j.Error, &j.CreatedAt, &j.UpdatedAt, &j.StartedAt, &j.FinishedAt}
+}
+
+func (r *ImportJobRepository) Create(ctx context.Context, job *domain.ImportJob) error {
+	query := `
+		INSERT INTO import_jobs (user_id, format, profile, duplicate_mode, atomic, data)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + importJobColumns + `
+	`
+	return r.db.QueryRow(ctx, query,
+		job.UserID, job.Format, job.Profile, job.DuplicateMode, job.Atomic, job.Data,
+	).Scan(importJobFields(job)...)
+}
+
+func (r *ImportJobRepository) GetByID(ctx context.Context, id, userID int64) (*domain.ImportJob, error) {
+	query := `
+		SELECT ` + importJobColumns + `
+		FROM import_jobs
+		WHERE id = $1 AND user_id = $2
+	`
+	var job domain.ImportJob
+	if err := r.db.QueryRow(ctx, query, id, userID).Scan(importJobFields(&job)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *ImportJobRepository) GetErrors(ctx context.Context, jobID int64, limit int) ([]domain.ImportJobError, error) {
+	query := `
+		SELECT line, message
+		FROM import_job_errors
+		WHERE job_id = $1
+		ORDER BY line
+		LIMIT $2
+	`
+	rows, err := r.db.Query(ctx, query, jobID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rowErrors []domain.ImportJobError
+	for rows.Next() {
+		var rowError domain.ImportJobError
+		if err := rows.Scan(&rowError.Line, &rowError.Message); err != nil {
+			return nil, err
+		}
+		rowErrors = append(rowErrors, rowError)
+	}
+	return rowErrors, rows.Err()
+}
+
+func (r *ImportJobRepository) Claim(ctx context.Context) (*domain.ImportJob, error) {
+	query := `
+		UPDATE import_jobs
+		SET status = 'running', started_at = COALESCE(started_at, NOW()), updated_at = NOW()
+		WHERE id = (
+			SELECT id FROM import_jobs
+			WHERE status = 'queued'
+			ORDER BY id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + importJobColumns + `, data
+	`
+	var job domain.ImportJob
+	if err := r.db.QueryRow(ctx, query).Scan(append(importJobFields(&job), &job.Data)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *ImportJobRepository) SaveBatch(ctx context.Context, job *domain.ImportJob, transactions []domain.Transaction, rowErrors []domain.ImportJobError) (bool, error) {
+	dbTx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer dbTx.Rollback(ctx)
+
+	query := `
+		UPDATE import_jobs
+		SET total_rows = $2, processed_rows = $3, imported_rows = $4, duplicate_rows = $5, failed_rows = $6, updated_at = NOW()
+		WHERE id = $1 AND status = 'running'
+		RETURNING updated_at
+	`
+	err = dbTx.QueryRow(ctx, query,
+		job.ID, job.TotalRows, job.ProcessedRows, job.ImportedRows, job.DuplicateRows, job.FailedRows,
+	).Scan(&job.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if len(transactions) > 0 {
+		if err := copyTransactions(ctx, dbTx, transactions); err != nil {
+			return false, err
+		}
+	}
+	if len(rowErrors) > 0 {
+		_, err = dbTx.CopyFrom(ctx, pgx.Identifier{"import_job_errors"}, []string{"job_id", "line", "message"},
+			pgx.CopyFromSlice(len(rowErrors), func(i int) ([]interface{}, error) {
+				return []interface{}{job.ID, rowErrors[i].Line, rowErrors[i].Message}, nil
+			}),
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := dbTx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *ImportJobRepository) Finish(ctx context.Context, job *domain.ImportJob) (bool, error) {
+	query := `
+		UPDATE import_jobs
+		SET status = $2, error = $3, total_rows = $4, processed_rows = $5, imported_rows = $6, duplicate_rows = $7,
+			failed_rows = $8, data = NULL, updated_at = NOW(), finished_at = NOW()
+		WHERE id = $1 AND status = 'running'
+		RETURNING updated_at, finished_at
+	`
+	err := r.db.QueryRow(ctx, query,
+		job.ID, job.Status, job.Error, job.TotalRows, job.ProcessedRows, job.ImportedRows, job.DuplicateRows, job.FailedRows,
+	).Scan(&job.UpdatedAt, &job.FinishedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *ImportJobRepository) Touch(ctx context.Context, id int64) (bool, error) {
+	query := `
+		UPDATE import_jobs
+		SET updated_at = NOW()
+		WHERE id = $1 AND status = 'running'
+	`
+	tag, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *ImportJobRepository) Cancel(ctx context.Context, id, userID int64) (bool, error) {
+	query := `
+		UPDATE import_jobs
+		SET status = 'canceled', data = NULL, updated_at = NOW(), finished_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND status IN ('queued', 'running')
+	`
+	tag, err := r.db.Exec(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *ImportJobRepository) RequeueStale(ctx context.Context, before time.Time) (int64, error) {
+	query := `
+		UPDATE import_jobs
+		SET status = 'queued', updated_at = NOW()
+		WHERE status = 'running' AND updated_at < $1
+	`
+	tag, err := r.db.Exec(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
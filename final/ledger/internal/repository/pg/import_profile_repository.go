@@ -0,0 +1,117 @@
+package pg
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type ImportProfileRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewImportProfileRepository(db *pgxpool.Pool) *ImportProfileRepository {
+	return &ImportProfileRepository{db: db}
+}
+
+const importProfileColumns = `id, user_id, name, delimiter, decimal_comma, date_layouts, encoding, sign_convention, columns, created_at`
+
+func scanImportProfile(row pgx.Row, p *domain.ImportProfile) error {
+	return row.Scan(&p.ID, &p.UserID, &p.Name, &p.Delimiter, &p.DecimalComma, &p.DateLayouts, &p.Encoding,
+		&p.SignConvention, &p.Columns, &p.CreatedAt)
+}
+
+func importProfileError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrDuplicateImportProfile
+	}
+	return err
+}
+
+func (r *ImportProfileRepository) Create(ctx context.Context, profile *domain.ImportProfile) error {
+	query := `
+		INSERT INTO import_profiles (user_id, name, delimiter, decimal_comma, date_layouts, encoding, sign_convention, columns)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRow(ctx, query,
+		profile.UserID, profile.Name, profile.Delimiter, profile.DecimalComma, profile.DateLayouts, profile.Encoding,
+		profile.SignConvention, profile.Columns,
+	).Scan(&profile.ID, &profile.CreatedAt)
+	return importProfileError(err)
+}
+
+func (r *ImportProfileRepository) GetByUserID(ctx context.Context, userID int64) ([]domain.ImportProfile, error) {
+	query := `
+		SELECT ` + importProfileColumns + `
+		FROM import_profiles
+		WHERE user_id = $1
+		ORDER BY lower(name)
+	`
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []domain.ImportProfile
+	for rows.Next() {
+		var profile domain.ImportProfile
+		if err := scanImportProfile(rows, &profile); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, rows.Err()
+}
+
+func (r *ImportProfileRepository) GetByName(ctx context.Context, userID int64, name string) (*domain.ImportProfile, error) {
+	query := `
+		SELECT ` + importProfileColumns + `
+		FROM import_profiles
+		WHERE user_id = $1 AND lower(name) = lower($2)
+	`
+	var profile domain.ImportProfile
+	if err := scanImportProfile(r.db.QueryRow(ctx, query, userID, name), &profile); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *ImportProfileRepository) Update(ctx context.Context, profile *domain.ImportProfile) (bool, error) {
+	query := `
+		UPDATE import_profiles
+		SET name = $3, delimiter = $4, decimal_comma = $5, date_layouts = $6, encoding = $7, sign_convention = $8, columns = $9
+		WHERE id = $1 AND user_id = $2
+		RETURNING created_at
+	`
+	err := r.db.QueryRow(ctx, query,
+		profile.ID, profile.UserID, profile.Name, profile.Delimiter, profile.DecimalComma, profile.DateLayouts, profile.Encoding,
+		profile.SignConvention, profile.Columns,
+	).Scan(&profile.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, importProfileError(err)
+}
+
+func (r *ImportProfileRepository) Delete(ctx context.Context, id, userID int64) (bool, error) {
+	query := `
+		DELETE FROM import_profiles
+		WHERE id = $1 AND user_id = $2
+	`
+	tag, err := r.db.Exec(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
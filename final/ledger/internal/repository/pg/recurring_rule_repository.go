@@ -0,0 +1,131 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type RecurringRuleRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRecurringRuleRepository(db *pgxpool.Pool) *RecurringRuleRepository {
+	return &RecurringRuleRepository{db: db}
+}
+
+const recurringRuleColumns = `id, user_id, kind, amount, currency, category, description, frequency, interval_count, start_date, end_date, next_date, last_error, created_at`
+
+func scanRecurringRule(row pgx.Row, rule *domain.RecurringRule) error {
+	return row.Scan(&rule.ID, &rule.UserID, &rule.Kind, &rule.Amount, &rule.Currency, &rule.Category, &rule.Description,
+		&rule.Frequency, &rule.Interval, &rule.StartDate, &rule.EndDate, &rule.NextDate, &rule.LastError, &rule.CreatedAt)
+}
+
+func (r *RecurringRuleRepository) Create(ctx context.Context, rule *domain.RecurringRule) error {
+	query := `
+		INSERT INTO recurring_rules (user_id, kind, amount, currency, category, description, frequency, interval_count, start_date, end_date, next_date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, created_at
+	`
+	return r.db.QueryRow(ctx, query,
+		rule.UserID, rule.Kind, rule.Amount, rule.Currency, rule.Category, rule.Description,
+		rule.Frequency, rule.Interval, rule.StartDate, rule.EndDate, rule.NextDate,
+	).Scan(&rule.ID, &rule.CreatedAt)
+}
+
+func (r *RecurringRuleRepository) GetByID(ctx context.Context, id, userID int64) (*domain.RecurringRule, error) {
+	query := `
+		SELECT ` + recurringRuleColumns + `
+		FROM recurring_rules
+		WHERE id = $1 AND user_id = $2
+	`
+	var rule domain.RecurringRule
+	err := scanRecurringRule(r.db.QueryRow(ctx, query, id, userID), &rule)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *RecurringRuleRepository) GetByUserID(ctx context.Context, userID int64) ([]domain.RecurringRule, error) {
+	query := `
+		SELECT ` + recurringRuleColumns + `
+		FROM recurring_rules
+		WHERE user_id = $1
+		ORDER BY id
+	`
+	return r.query(ctx, query, userID)
+}
+
+func (r *RecurringRuleRepository) Update(ctx context.Context, rule *domain.RecurringRule) error {
+	query := `
+		UPDATE recurring_rules
+		SET kind = $3, amount = $4, currency = $5, category = $6, description = $7,
+			frequency = $8, interval_count = $9, start_date = $10, end_date = $11, next_date = $12, last_error = $13
+		WHERE id = $1 AND user_id = $2
+		RETURNING created_at
+	`
+	return r.db.QueryRow(ctx, query,
+		rule.ID, rule.UserID, rule.Kind, rule.Amount, rule.Currency, rule.Category, rule.Description,
+		rule.Frequency, rule.Interval, rule.StartDate, rule.EndDate, rule.NextDate, rule.LastError,
+	).Scan(&rule.CreatedAt)
+}
+
+func (r *RecurringRuleRepository) Delete(ctx context.Context, id, userID int64) (bool, error) {
+	query := `
+		DELETE FROM recurring_rules
+		WHERE id = $1 AND user_id = $2
+	`
+	tag, err := r.db.Exec(ctx, query, id, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *RecurringRuleRepository) ListDue(ctx context.Context, date time.Time, limit int) ([]domain.RecurringRule, error) {
+	query := `
+		SELECT ` + recurringRuleColumns + `
+		FROM recurring_rules
+		WHERE next_date IS NOT NULL AND next_date <= $1
+		ORDER BY next_date, id
+		LIMIT $2
+	`
+	return r.query(ctx, query, date, limit)
+}
+
+func (r *RecurringRuleRepository) UpdateSchedule(ctx context.Context, rule *domain.RecurringRule) error {
+	query := `
+		UPDATE recurring_rules
+		SET next_date = $2, last_error = $3
+		WHERE id = $1
+	`
+	_, err := r.db.Exec(ctx, query, rule.ID, rule.NextDate, rule.LastError)
+	return err
+}
+
+func (r *RecurringRuleRepository) query(ctx context.Context, query string, args ...any) ([]domain.RecurringRule, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []domain.RecurringRule
+	for rows.Next() {
+		var rule domain.RecurringRule
+		if err := scanRecurringRule(rows, &rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
@@ -2,8 +2,15 @@ package pg
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"strconv"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
@@ -17,41 +24,456 @@ func NewTransactionRepository(db *pgxpool.Pool) *TransactionRepository {
 	return &TransactionRepository{db: db}
 }
 
+const transactionColumns = `id, user_id, kind, amount, currency, category, description, date, recurring_rule_id, over_budget, account_id, transfer_id, created_at,
+	ARRAY(
+		SELECT tg.name FROM transaction_tags tt JOIN tags tg ON tg.id = tt.tag_id
+		WHERE tt.transaction_id = transactions.id ORDER BY tg.name
+	)`
+
+const transactionLines = `(
+		SELECT tr.id, tr.user_id, tr.kind, tr.currency, tr.date,
+			COALESCE(sp.category, tr.category) AS category, COALESCE(sp.amount, tr.amount) AS amount
+		FROM transactions tr
+		LEFT JOIN transaction_splits sp ON sp.transaction_id = tr.id
+	) t`
+
+const uniqueViolation = "23505"
+
+func scanTransaction(row pgx.Row, tx *domain.Transaction, extra ...interface{}) error {
+	dest := []interface{}{&tx.ID, &tx.UserID, &tx.Kind, &tx.Amount, &tx.Currency, &tx.Category, &tx.Description, &tx.Date, &tx.RecurringRuleID, &tx.OverBudget, &tx.AccountID, &tx.TransferID, &tx.CreatedAt, &tx.Tags}
+	return row.Scan(append(dest, extra...)...)
+}
+
 func (r *TransactionRepository) Create(ctx context.Context, tx *domain.Transaction) error {
+	dbTx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback(ctx)
+
+	if err := insertTransaction(ctx, dbTx, tx); err != nil {
+		return err
+	}
+
+	return dbTx.Commit(ctx)
+}
+
+func (r *TransactionRepository) CreateBatch(ctx context.Context, transactions []domain.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	dbTx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback(ctx)
+
+	if err := copyTransactions(ctx, dbTx, transactions); err != nil {
+		return err
+	}
+	return dbTx.Commit(ctx)
+}
+
+func copyTransactions(ctx context.Context, dbTx pgx.Tx, transactions []domain.Transaction) error {
+	rows, err := dbTx.Query(ctx, `
+		SELECT nextval(pg_get_serial_sequence('transactions', 'id')), NOW()
+		FROM generate_series(1, $1)
+	`, len(transactions))
+	if err != nil {
+		return err
+	}
+	for i := 0; rows.Next(); i++ {
+		if err := rows.Scan(&transactions[i].ID, &transactions[i].CreatedAt); err != nil {
+			rows.Close()
+			return err
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	_, err = dbTx.CopyFrom(ctx, pgx.Identifier{"transactions"},
+		[]string{"id", "user_id", "kind", "amount", "currency", "category", "description", "date", "recurring_rule_id", "over_budget", "account_id", "transfer_id", "fingerprint", "created_at"},
+		pgx.CopyFromSlice(len(transactions), func(i int) ([]interface{}, error) {
+			tx := &transactions[i]
+			var fingerprint *string
+			if tx.Fingerprint != "" {
+				fingerprint = &tx.Fingerprint
+			}
+			return []interface{}{tx.ID, tx.UserID, tx.Kind, numeric(tx.Amount), tx.Currency, tx.Category, tx.Description, tx.Date,
+				tx.RecurringRuleID, tx.OverBudget, tx.AccountID, tx.TransferID, fingerprint, tx.CreatedAt}, nil
+		}),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrDuplicateTransaction
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := copyTransactionTags(ctx, dbTx, transactions); err != nil {
+		return err
+	}
+
+	var splits [][]interface{}
+	for _, tx := range transactions {
+		for _, split := range tx.Splits {
+			splits = append(splits, []interface{}{tx.ID, split.Category, numeric(split.Amount), split.Note})
+		}
+	}
+	if len(splits) > 0 {
+		_, err = dbTx.CopyFrom(ctx, pgx.Identifier{"transaction_splits"},
+			[]string{"transaction_id", "category", "amount", "note"}, pgx.CopyFromRows(splits))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyTransactionTags(ctx context.Context, dbTx pgx.Tx, transactions []domain.Transaction) error {
+	seen := make(map[string]bool)
+	var names []string
+	for _, tx := range transactions {
+		for _, tag := range tx.Tags {
+			if !seen[tag] {
+				seen[tag] = true
+				names = append(names, tag)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	userID := transactions[0].UserID
+	_, err := dbTx.Exec(ctx, `
+		INSERT INTO tags (user_id, name)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT (user_id, name) DO NOTHING
+	`, userID, names)
+	if err != nil {
+		return err
+	}
+
+	rows, err := dbTx.Query(ctx, `
+		SELECT id, name FROM tags
+		WHERE user_id = $1 AND name = ANY($2)
+	`, userID, names)
+	if err != nil {
+		return err
+	}
+	tagIDs := make(map[string]int64)
+	for rows.Next() {
+		var id int64
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			rows.Close()
+			return err
+		}
+		tagIDs[name] = id
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var links [][]interface{}
+	for _, tx := range transactions {
+		for _, tag := range tx.Tags {
+			links = append(links, []interface{}{tx.ID, tagIDs[tag]})
+		}
+	}
+	_, err = dbTx.CopyFrom(ctx, pgx.Identifier{"transaction_tags"}, []string{"transaction_id", "tag_id"}, pgx.CopyFromRows(links))
+	return err
+}
+
+func numeric(m domain.Money) pgtype.Numeric {
+	return pgtype.Numeric{Int: big.NewInt(int64(m)), Exp: -2, Valid: true}
+}
+
+func insertTransaction(ctx context.Context, dbTx pgx.Tx, tx *domain.Transaction) error {
 	query := `
-		INSERT INTO transactions (user_id, amount, category, description, date)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO transactions (user_id, kind, amount, currency, category, description, date, recurring_rule_id, over_budget, account_id, transfer_id, fingerprint)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, ''))
 		RETURNING id, created_at
 	`
-	return r.db.QueryRow(ctx, query,
-		tx.UserID, tx.Amount, tx.Category, tx.Description, tx.Date,
+	err := dbTx.QueryRow(ctx, query,
+		tx.UserID, tx.Kind, tx.Amount, tx.Currency, tx.Category, tx.Description, tx.Date, tx.RecurringRuleID, tx.OverBudget, tx.AccountID, tx.TransferID, tx.Fingerprint,
 	).Scan(&tx.ID, &tx.CreatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && tx.RecurringRuleID != nil {
+		return domain.ErrDuplicateOccurrence
+	}
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && tx.Fingerprint != "" {
+		return domain.ErrDuplicateTransaction
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := setTransactionTags(ctx, dbTx, tx.UserID, tx.ID, tx.Tags); err != nil {
+		return err
+	}
+	return setTransactionSplits(ctx, dbTx, tx.ID, tx.Splits)
 }
 
-func (r *TransactionRepository) GetByUserID(ctx context.Context, userID int64, from, to *time.Time, category string) ([]domain.Transaction, error) {
+func (r *TransactionRepository) GetByID(ctx context.Context, id, userID int64) (*domain.Transaction, error) {
 	query := `
-		SELECT id, user_id, amount, category, description, date, created_at
+		SELECT ` + transactionColumns + `
 		FROM transactions
-		WHERE user_id = $1
+		WHERE id = $1 AND user_id = $2
+	`
+	var tx domain.Transaction
+	err := scanTransaction(r.db.QueryRow(ctx, query, id, userID), &tx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	transactions := []domain.Transaction{tx}
+	if err := r.loadSplits(ctx, transactions); err != nil {
+		return nil, err
+	}
+	return &transactions[0], nil
+}
+
+func (r *TransactionRepository) Update(ctx context.Context, tx *domain.Transaction) (bool, error) {
+	dbTx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer dbTx.Rollback(ctx)
+
+	query := `
+		UPDATE transactions
+		SET kind = $3, amount = $4, currency = $5, category = $6, description = $7, date = $8, over_budget = $9, account_id = $10
+		WHERE id = $1 AND user_id = $2
+		RETURNING recurring_rule_id, transfer_id, created_at
+	`
+	err = dbTx.QueryRow(ctx, query,
+		tx.ID, tx.UserID, tx.Kind, tx.Amount, tx.Currency, tx.Category, tx.Description, tx.Date, tx.OverBudget, tx.AccountID,
+	).Scan(&tx.RecurringRuleID, &tx.TransferID, &tx.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := setTransactionTags(ctx, dbTx, tx.UserID, tx.ID, tx.Tags); err != nil {
+		return false, err
+	}
+	if err := setTransactionSplits(ctx, dbTx, tx.ID, tx.Splits); err != nil {
+		return false, err
+	}
+
+	if err := dbTx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func setTransactionTags(ctx context.Context, dbTx pgx.Tx, userID, transactionID int64, tags []string) error {
+	_, err := dbTx.Exec(ctx, `
+		DELETE FROM transaction_tags
+		WHERE transaction_id = $1
+	`, transactionID)
+	if err != nil || len(tags) == 0 {
+		return err
+	}
+
+	_, err = dbTx.Exec(ctx, `
+		INSERT INTO tags (user_id, name)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT (user_id, name) DO NOTHING
+	`, userID, tags)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbTx.Exec(ctx, `
+		INSERT INTO transaction_tags (transaction_id, tag_id)
+		SELECT $1, id FROM tags
+		WHERE user_id = $2 AND name = ANY($3)
+	`, transactionID, userID, tags)
+	return err
+}
+
+func setTransactionSplits(ctx context.Context, dbTx pgx.Tx, transactionID int64, splits []domain.TransactionSplit) error {
+	_, err := dbTx.Exec(ctx, `
+		DELETE FROM transaction_splits
+		WHERE transaction_id = $1
+	`, transactionID)
+	if err != nil {
+		return err
+	}
+
+	for _, split := range splits {
+		_, err := dbTx.Exec(ctx, `
+			INSERT INTO transaction_splits (transaction_id, category, amount, note)
+			VALUES ($1, $2, $3, $4)
+		`, transactionID, split.Category, split.Amount, split.Note)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *TransactionRepository) loadSplits(ctx context.Context, transactions []domain.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	index := make(map[int64]int, len(transactions))
+	ids := make([]int64, 0, len(transactions))
+	for i := range transactions {
+		index[transactions[i].ID] = i
+		ids = append(ids, transactions[i].ID)
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT transaction_id, category, amount, note
+		FROM transaction_splits
+		WHERE transaction_id = ANY($1)
+		ORDER BY transaction_id, id
+	`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var transactionID int64
+		var split domain.TransactionSplit
+		if err := rows.Scan(&transactionID, &split.Category, &split.Amount, &split.Note); err != nil {
+			return err
+		}
+		tx := &transactions[index[transactionID]]
+		tx.Splits = append(tx.Splits, split)
+	}
+	return rows.Err()
+}
+
+func (r *TransactionRepository) Delete(ctx context.Context, id, userID int64) (bool, error) {
+	dbTx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer dbTx.Rollback(ctx)
+
+	query := `
+		DELETE FROM transactions
+		WHERE id = $1 AND user_id = $2
+		RETURNING transfer_id
 	`
+	var transferID *int64
+	if err := dbTx.QueryRow(ctx, query, id, userID).Scan(&transferID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if transferID != nil {
+		_, err := dbTx.Exec(ctx, `
+			DELETE FROM transfers
+			WHERE id = $1 AND user_id = $2
+		`, *transferID, userID)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, dbTx.Commit(ctx)
+}
+
+func (r *TransactionRepository) GetByUserID(ctx context.Context, userID int64, filter domain.TransactionFilter) ([]domain.Transaction, error) {
 	args := []interface{}{userID}
-	argNum := 2
+	rank, search := "0::real", ""
+	if filter.Query != "" {
+		args = append(args, filter.Query)
+		tsquery := `(websearch_to_tsquery('russian', $2) || websearch_to_tsquery('english', $2))`
+		rank = `ts_rank(search, ` + tsquery + `)`
+		search = ` AND search @@ ` + tsquery
+	}
+
+	query := `
+		SELECT ` + transactionColumns + `, ` + rank + `
+		FROM transactions
+		WHERE user_id = $1
+	` + search
+
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		query += ` AND date >= $` + strconv.Itoa(len(args))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		query += ` AND date <= $` + strconv.Itoa(len(args))
+	}
+	if filter.Category != "" {
+		args = append(args, filter.Category)
+		n := strconv.Itoa(len(args))
+		query += ` AND (lower(category) = lower($` + n + `) OR EXISTS (
+			SELECT 1 FROM transaction_splits sp
+			WHERE sp.transaction_id = transactions.id AND lower(sp.category) = lower($` + n + `)))`
+	}
+	if filter.Currency != "" {
+		args = append(args, filter.Currency)
+		query += ` AND currency = $` + strconv.Itoa(len(args))
+	}
+	if filter.MinAmount != nil {
+		args = append(args, *filter.MinAmount)
+		query += ` AND amount >= $` + strconv.Itoa(len(args))
+	}
+	if filter.MaxAmount != nil {
+		args = append(args, *filter.MaxAmount)
+		query += ` AND amount <= $` + strconv.Itoa(len(args))
+	}
+	if filter.AccountID > 0 {
+		args = append(args, filter.AccountID)
+		query += ` AND account_id = $` + strconv.Itoa(len(args))
+	}
+	if len(filter.Tags) > 0 {
+		args = append(args, filter.Tags)
+		tagged := `
+			FROM transaction_tags tt JOIN tags tg ON tg.id = tt.tag_id
+			WHERE tt.transaction_id = transactions.id AND tg.name = ANY($` + strconv.Itoa(len(args)) + `)`
+		if filter.TagMatch == domain.TagMatchAll {
+			args = append(args, len(filter.Tags))
+			query += ` AND (SELECT count(*) ` + tagged + `) = $` + strconv.Itoa(len(args))
+		} else {
+			query += ` AND EXISTS (SELECT 1 ` + tagged + `)`
+		}
+	}
 
-	if from != nil {
-		query += ` AND date >= $` + string(rune('0'+argNum))
-		args = append(args, *from)
-		argNum++
+	direction, cmp := "DESC", "<"
+	if filter.Sort == domain.SortDateAsc {
+		direction, cmp = "ASC", ">"
 	}
-	if to != nil {
-		query += ` AND date <= $` + string(rune('0'+argNum))
-		args = append(args, *to)
-		argNum++
+	if filter.Sort == domain.SortRelevance {
+		if filter.After != nil {
+			args = append(args, *filter.After.Rank, filter.After.Date, filter.After.ID)
+			query += ` AND (` + rank + `, date, id) < ($` + strconv.Itoa(len(args)-2) + `::real, $` + strconv.Itoa(len(args)-1) + `, $` + strconv.Itoa(len(args)) + `)`
+		}
+		query += ` ORDER BY ` + rank + ` DESC, date DESC, id DESC`
+	} else {
+		if filter.After != nil {
+			args = append(args, filter.After.Date, filter.After.ID)
+			query += ` AND (date, id) ` + cmp + ` ($` + strconv.Itoa(len(args)-1) + `, $` + strconv.Itoa(len(args)) + `)`
+		}
+		query += ` ORDER BY date ` + direction + `, id ` + direction
 	}
-	if category != "" {
-		query += ` AND category = $` + string(rune('0'+argNum))
-		args = append(args, category)
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += ` LIMIT $` + strconv.Itoa(len(args))
 	}
-	query += ` ORDER BY date DESC`
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
@@ -62,35 +484,75 @@ func (r *TransactionRepository) GetByUserID(ctx context.Context, userID int64, f
 	var transactions []domain.Transaction
 	for rows.Next() {
 		var tx domain.Transaction
-		err := rows.Scan(&tx.ID, &tx.UserID, &tx.Amount, &tx.Category, &tx.Description, &tx.Date, &tx.CreatedAt)
-		if err != nil {
+		if err := scanTransaction(rows, &tx, &tx.Rank); err != nil {
 			return nil, err
 		}
 		transactions = append(transactions, tx)
 	}
-	return transactions, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err := r.loadSplits(ctx, transactions); err != nil {
+		return nil, err
+	}
+	return transactions, nil
 }
 
-func (r *TransactionRepository) SumByCategory(ctx context.Context, userID int64, category string, from, to time.Time) (float64, error) {
+func (r *TransactionRepository) GetByFingerprints(ctx context.Context, userID int64, fingerprints []string) (map[string]int64, error) {
 	query := `
-		SELECT COALESCE(SUM(amount), 0)
+		SELECT fingerprint, id
 		FROM transactions
-		WHERE user_id = $1 AND category = $2 AND date >= $3 AND date <= $4
+		WHERE user_id = $1 AND fingerprint = ANY($2)
 	`
-	var sum float64
-	err := r.db.QueryRow(ctx, query, userID, category, from, to).Scan(&sum)
-	return sum, err
+	rows, err := r.db.Query(ctx, query, userID, fingerprints)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	existing := make(map[string]int64)
+	for rows.Next() {
+		var fingerprint string
+		var id int64
+		if err := rows.Scan(&fingerprint, &id); err != nil {
+			return nil, err
+		}
+		existing[fingerprint] = id
+	}
+	return existing, rows.Err()
 }
 
-func (r *TransactionRepository) GetReportSummary(ctx context.Context, userID int64, from, to time.Time) ([]domain.CategorySummary, error) {
+func (r *TransactionRepository) SumByCategory(ctx context.Context, userID int64, category string, from, to time.Time, currency string) (domain.Money, error) {
 	query := `
-		SELECT category, SUM(amount) as total
-		FROM transactions
-		WHERE user_id = $1 AND date >= $2 AND date <= $3
-		GROUP BY category
-		ORDER BY total DESC
+		SELECT COALESCE(SUM(t.amount * r.rate), 0), COALESCE(MIN(t.currency) FILTER (WHERE r.rate IS NULL), '')
+		FROM ` + transactionLines + `
+	` + rateJoin(5) + `
+		WHERE t.user_id = $1 AND t.category = $2 AND t.date >= $3 AND t.date <= $4
+			AND t.kind = 'expense'
+	`
+	var sum domain.Money
+	var missing string
+	if err := r.db.QueryRow(ctx, query, userID, category, from, to, currency).Scan(&sum, &missing); err != nil {
+		return 0, err
+	}
+	if missing != "" {
+		return 0, missingRateError(missing, currency)
+	}
+	return sum, nil
+}
+
+func (r *TransactionRepository) GetReportSummary(ctx context.Context, userID int64, from, to time.Time, currency string) ([]domain.CategorySummary, error) {
+	query := `
+		SELECT t.category, t.currency, SUM(t.amount), SUM(t.amount * r.rate), bool_or(r.rate IS NULL)
+		FROM ` + transactionLines + `
+	` + rateJoin(4) + `
+		WHERE t.user_id = $1 AND t.date >= $2 AND t.date <= $3 AND t.kind = 'expense'
+		GROUP BY t.category, t.currency
+		ORDER BY t.category, t.currency
 	`
-	rows, err := r.db.Query(ctx, query, userID, from, to)
+	rows, err := r.db.Query(ctx, query, userID, from, to, currency)
 	if err != nil {
 		return nil, err
 	}
@@ -98,15 +560,140 @@ func (r *TransactionRepository) GetReportSummary(ctx context.Context, userID int
 
 	var summaries []domain.CategorySummary
 	for rows.Next() {
-		var s domain.CategorySummary
-		err := rows.Scan(&s.Category, &s.Total)
-		if err != nil {
+		var category string
+		var total domain.CurrencyTotal
+		var converted *domain.Money
+		var missing bool
+		if err := rows.Scan(&category, &total.Currency, &total.Amount, &converted, &missing); err != nil {
 			return nil, err
 		}
-		summaries = append(summaries, s)
+		if missing || converted == nil {
+			return nil, missingRateError(total.Currency, currency)
+		}
+		total.Converted = *converted
+
+		if n := len(summaries); n == 0 || summaries[n-1].Category != category {
+			summaries = append(summaries, domain.CategorySummary{Category: category})
+		}
+		s := &summaries[len(summaries)-1]
+		s.Total += total.Converted
+		s.Currencies = append(s.Currencies, total)
 	}
-	return summaries, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].Total > summaries[j].Total
+	})
+	return summaries, nil
 }
 
+func (r *TransactionRepository) GetSpendingTrend(ctx context.Context, trend domain.TrendQuery, currency string) ([]domain.TrendPoint, error) {
+	query := `
+		SELECT date_trunc($5::text, t.date::timestamp)::date AS period,
+			CASE WHEN $6::boolean THEN t.category ELSE '' END AS category,
+			SUM(t.amount * r.rate), COALESCE(MIN(t.currency) FILTER (WHERE r.rate IS NULL), '')
+		FROM ` + transactionLines + `
+	` + rateJoin(4) + `
+		WHERE t.user_id = $1 AND t.date >= $2 AND t.date <= $3 AND t.kind = 'expense'
+		GROUP BY 1, 2
+		ORDER BY 1, 2
+	`
+	rows, err := r.db.Query(ctx, query, trend.UserID, trend.From, trend.To, currency, trend.Granularity, trend.ByCategory)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
+	var points []domain.TrendPoint
+	for rows.Next() {
+		var point domain.TrendPoint
+		var total *domain.Money
+		var missing string
+		if err := rows.Scan(&point.Period, &point.Category, &total, &missing); err != nil {
+			return nil, err
+		}
+		if missing != "" || total == nil {
+			return nil, missingRateError(missing, currency)
+		}
+		point.Total = *total
+		points = append(points, point)
+	}
+	return points, rows.Err()
+}
+
+func (r *TransactionRepository) GetTagSummary(ctx context.Context, userID int64, from, to time.Time, currency string, tags []string) ([]domain.TagSummary, error) {
+	query := `
+		SELECT tg.name, t.currency, SUM(t.amount), SUM(t.amount * r.rate), bool_or(r.rate IS NULL), COUNT(*)
+		FROM transactions t
+		JOIN transaction_tags tt ON tt.transaction_id = t.id
+		JOIN tags tg ON tg.id = tt.tag_id
+	` + rateJoin(4) + `
+		WHERE t.user_id = $1 AND t.date >= $2 AND t.date <= $3 AND t.kind = 'expense'
+			AND (cardinality($5::text[]) = 0 OR tg.name = ANY($5))
+		GROUP BY tg.name, t.currency
+		ORDER BY tg.name, t.currency
+	`
+	if tags == nil {
+		tags = []string{}
+	}
+	rows, err := r.db.Query(ctx, query, userID, from, to, currency, tags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []domain.TagSummary
+	for rows.Next() {
+		var tag string
+		var total domain.CurrencyTotal
+		var converted *domain.Money
+		var missing bool
+		var count int64
+		if err := rows.Scan(&tag, &total.Currency, &total.Amount, &converted, &missing, &count); err != nil {
+			return nil, err
+		}
+		if missing || converted == nil {
+			return nil, missingRateError(total.Currency, currency)
+		}
+		total.Converted = *converted
 
+		if n := len(summaries); n == 0 || summaries[n-1].Tag != tag {
+			summaries = append(summaries, domain.TagSummary{Tag: tag})
+		}
+		s := &summaries[len(summaries)-1]
+		s.Total += total.Converted
+		s.TransactionCount += count
+		s.Currencies = append(s.Currencies, total)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].Total > summaries[j].Total
+	})
+	return summaries, nil
+}
+
+func (r *TransactionRepository) GetCashFlow(ctx context.Context, userID int64, from, to time.Time, currency string) (domain.Money, domain.Money, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(t.amount * r.rate) FILTER (WHERE t.kind = 'income'), 0),
+			COALESCE(SUM(t.amount * r.rate) FILTER (WHERE t.kind = 'expense'), 0),
+			COALESCE(MIN(t.currency) FILTER (WHERE r.rate IS NULL AND t.kind <> 'transfer'), '')
+		FROM transactions t
+	` + rateJoin(4) + `
+		WHERE t.user_id = $1 AND t.date >= $2 AND t.date <= $3
+	`
+	var income, expenses domain.Money
+	var missing string
+	if err := r.db.QueryRow(ctx, query, userID, from, to, currency).Scan(&income, &expenses, &missing); err != nil {
+		return 0, 0, err
+	}
+	if missing != "" {
+		return 0, 0, missingRateError(missing, currency)
+	}
+	return income, expenses, nil
+}
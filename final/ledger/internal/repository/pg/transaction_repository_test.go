@@ -0,0 +1,35 @@
+package pg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func TestTransactionRepository_UpdateDeleted(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	transactions := NewTransactionRepository(pool)
+
+	tx := &domain.Transaction{
+		UserID: 1, Kind: domain.KindExpense, Amount: 35000, Currency: "RUB", Category: "cafe",
+		Date: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+	}
+	if err := transactions.Create(ctx, tx); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tx.Amount = 42000
+	if updated, err := transactions.Update(ctx, tx); err != nil || !updated {
+		t.Fatalf("Update() = %v, %v, want true", updated, err)
+	}
+
+	if deleted, err := transactions.Delete(ctx, tx.ID, tx.UserID); err != nil || !deleted {
+		t.Fatalf("Delete() = %v, %v, want true", deleted, err)
+	}
+	if updated, err := transactions.Update(ctx, tx); err != nil || updated {
+		t.Errorf("Update() after delete = %v, %v, want false, nil", updated, err)
+	}
+}
@@ -0,0 +1,48 @@
+package pg
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type UserSettingsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewUserSettingsRepository(db *pgxpool.Pool) *UserSettingsRepository {
+	return &UserSettingsRepository{db: db}
+}
+
+func (r *UserSettingsRepository) Get(ctx context.Context, userID int64) (*domain.UserSettings, error) {
+	query := `
+		SELECT user_id, base_currency
+		FROM user_settings
+		WHERE user_id = $1
+	`
+	var settings domain.UserSettings
+	err := r.db.QueryRow(ctx, query, userID).Scan(&settings.UserID, &settings.BaseCurrency)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (r *UserSettingsRepository) Upsert(ctx context.Context, settings *domain.UserSettings) error {
+	query := `
+		INSERT INTO user_settings (user_id, base_currency)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			base_currency = EXCLUDED.base_currency,
+			updated_at = NOW()
+	`
+	_, err := r.db.Exec(ctx, query, settings.UserID, settings.BaseCurrency)
+	return err
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func (s *LedgerService) CreateAccount(ctx context.Context, account *domain.Account) error {
+	if err := account.Validate(); err != nil {
+		return err
+	}
+	if account.Currency == "" {
+		base, err := s.baseCurrency(ctx, account.UserID)
+		if err != nil {
+			return err
+		}
+		account.Currency = base
+	}
+	return s.accountRepo.Create(ctx, account)
+}
+
+func (s *LedgerService) GetAccounts(ctx context.Context, userID int64, includeArchived bool) ([]domain.Account, error) {
+	return s.accountRepo.GetByUserID(ctx, userID, includeArchived)
+}
+
+func (s *LedgerService) UpdateAccount(ctx context.Context, account *domain.Account) error {
+	if err := account.Validate(); err != nil {
+		return err
+	}
+
+	existing, err := s.accountRepo.GetByID(ctx, account.ID, account.UserID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrAccountNotFound
+	}
+
+	if account.Currency == "" {
+		account.Currency = existing.Currency
+	}
+	if account.Currency != existing.Currency {
+		used, err := s.accountRepo.InUse(ctx, account.ID, account.UserID)
+		if err != nil {
+			return err
+		}
+		if used {
+			return fmt.Errorf("%w: currency cannot be changed", ErrAccountInUse)
+		}
+	}
+
+	return s.accountRepo.Update(ctx, account)
+}
+
+func (s *LedgerService) DeleteAccount(ctx context.Context, id, userID int64) error {
+	used, err := s.accountRepo.InUse(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if used {
+		return ErrAccountInUse
+	}
+
+	deleted, err := s.accountRepo.Delete(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrAccountNotFound
+	}
+
+	return nil
+}
+
+func (s *LedgerService) CreateTransfer(ctx context.Context, transfer *domain.Transfer) ([]domain.Transaction, error) {
+	if err := transfer.Validate(); err != nil {
+		return nil, err
+	}
+
+	from, err := s.activeAccount(ctx, transfer.FromAccountID, transfer.UserID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.activeAccount(ctx, transfer.ToAccountID, transfer.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if transfer.Date.IsZero() {
+		transfer.Date = time.Now()
+	}
+	if transfer.ToAmount == 0 {
+		transfer.ToAmount, err = s.convert(ctx, transfer.UserID, transfer.Amount, from.Currency, to.Currency, transfer.Date)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if from.Currency == to.Currency && transfer.ToAmount != transfer.Amount {
+		return nil, fmt.Errorf("%w: to_amount must equal amount for accounts in the same currency", ErrCurrencyMismatch)
+	}
+
+	out := transferEntry(transfer, from, transfer.Amount)
+	in := transferEntry(transfer, to, transfer.ToAmount)
+	if err := s.accountRepo.CreateTransfer(ctx, transfer, &out, &in); err != nil {
+		return nil, err
+	}
+
+	s.invalidateSpending(ctx, transfer.UserID)
+
+	return []domain.Transaction{out, in}, nil
+}
+
+func (s *LedgerService) GetAccountBalances(ctx context.Context, userID, accountID int64, from, to time.Time, interval string) (*domain.Account, []domain.BalancePoint, error) {
+	dates, err := domain.BalanceDates(from, to, interval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account == nil {
+		return nil, nil, ErrAccountNotFound
+	}
+
+	points, err := s.accountRepo.GetBalances(ctx, accountID, userID, dates)
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, points, nil
+}
+
+func (s *LedgerService) activeAccount(ctx context.Context, id, userID int64) (*domain.Account, error) {
+	account, err := s.accountRepo.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, ErrAccountNotFound
+	}
+	if account.Archived {
+		return nil, fmt.Errorf("%w: %s", ErrAccountArchived, account.Name)
+	}
+	return account, nil
+}
+
+func (s *LedgerService) resolveTransactionAccount(ctx context.Context, tx *domain.Transaction, existing *domain.Transaction) error {
+	if tx.AccountID == nil {
+		return nil
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, *tx.AccountID, tx.UserID)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return ErrAccountNotFound
+	}
+	unchanged := existing != nil && existing.AccountID != nil && *existing.AccountID == account.ID
+	if account.Archived && !unchanged {
+		return fmt.Errorf("%w: %s", ErrAccountArchived, account.Name)
+	}
+
+	if tx.Currency == "" {
+		tx.Currency = account.Currency
+	}
+	if tx.Currency != account.Currency {
+		return fmt.Errorf("%w: %s account holds %s", ErrCurrencyMismatch, account.Name, account.Currency)
+	}
+	return nil
+}
+
+func transferEntry(transfer *domain.Transfer, account *domain.Account, amount domain.Money) domain.Transaction {
+	accountID := account.ID
+	return domain.Transaction{
+		UserID:      transfer.UserID,
+		Kind:        domain.KindTransfer,
+		Amount:      amount,
+		Currency:    account.Currency,
+		Category:    domain.TransferCategory,
+		Description: transfer.Description,
+		Date:        transfer.Date,
+		AccountID:   &accountID,
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func (s *LedgerService) DeleteBudget(ctx context.Context, userID int64, category string) error {
+	_, category, err := s.canonicalCategory(ctx, userID, category)
+	if err != nil {
+		return err
+	}
+
+	budget, err := s.budgetRepo.GetByCategory(ctx, userID, category)
+	if err != nil {
+		return err
+	}
+	if budget == nil {
+		return ErrBudgetNotFound
+	}
+
+	from, _ := s.getBudgetPeriod(budget, time.Now())
+	deleted, err := s.budgetRepo.Delete(ctx, userID, category, from)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrBudgetNotFound
+	}
+
+	s.invalidateSpending(ctx, userID)
+
+	return nil
+}
+
+func (s *LedgerService) GetBudgetStatus(ctx context.Context, userID int64, now time.Time) ([]domain.BudgetStatus, error) {
+	if s.cache != nil {
+		if cached, err := s.cache.GetBudgetStatus(ctx, userID, now); err == nil && cached != nil {
+			return cached, nil
+		}
+	}
+
+	base, err := s.baseCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := s.budgetRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]domain.BudgetStatus, 0, len(budgets))
+	for i := range budgets {
+		budget := &budgets[i]
+		from, to := s.getBudgetPeriod(budget, now)
+		spent, err := s.txRepo.SumByCategory(ctx, userID, budget.Category, from, to, base)
+		if err != nil {
+			return nil, err
+		}
+		limit, err := s.effectiveLimit(ctx, budget, base, now)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, domain.NewBudgetStatus(*budget, base, from, to, limit, spent, now))
+	}
+
+	if s.cache != nil {
+		s.cache.SetBudgetStatus(ctx, userID, now, statuses)
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+const ruleTestSamples = 20
+
+func (s *LedgerService) CreateCategoryRule(ctx context.Context, rule *domain.CategoryRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	return s.ruleRepo.Create(ctx, rule)
+}
+
+func (s *LedgerService) GetCategoryRules(ctx context.Context, userID int64) ([]domain.CategoryRule, error) {
+	return s.ruleRepo.GetByUserID(ctx, userID)
+}
+
+func (s *LedgerService) UpdateCategoryRule(ctx context.Context, rule *domain.CategoryRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	updated, err := s.ruleRepo.Update(ctx, rule)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return ErrCategoryRuleNotFound
+	}
+	return nil
+}
+
+func (s *LedgerService) DeleteCategoryRule(ctx context.Context, id, userID int64) error {
+	deleted, err := s.ruleRepo.Delete(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrCategoryRuleNotFound
+	}
+	return nil
+}
+
+func (s *LedgerService) TestCategoryRule(ctx context.Context, rule *domain.CategoryRule, from, to *time.Time) (*domain.RuleTestResult, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.txRepo.GetByUserID(ctx, rule.UserID, domain.TransactionFilter{
+		From:      from,
+		To:        to,
+		MinAmount: rule.MinAmount,
+		MaxAmount: rule.MaxAmount,
+		Sort:      domain.SortDateDesc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.RuleTestResult{}
+	for i := range transactions {
+		if !rule.Matches(&transactions[i]) {
+			continue
+		}
+		result.Matched++
+		if domain.CategoryKey(transactions[i].Category) != domain.CategoryKey(rule.Category) {
+			result.Changed++
+		}
+		if len(result.Samples) < ruleTestSamples {
+			result.Samples = append(result.Samples, transactions[i])
+		}
+	}
+	return result, nil
+}
+
+func (s *LedgerService) ApplyCategoryRules(ctx context.Context, tx *domain.Transaction) error {
+	if tx.Category != "" || len(tx.Splits) > 0 {
+		return nil
+	}
+	rules, err := s.ruleRepo.GetByUserID(ctx, tx.UserID)
+	if err != nil {
+		return err
+	}
+	domain.ApplyCategoryRules(rules, tx)
+	return nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func (s *LedgerService) CreateCategory(ctx context.Context, category *domain.Category) error {
+	if err := category.Validate(); err != nil {
+		return err
+	}
+	if err := s.checkCategoryParent(ctx, category); err != nil {
+		return err
+	}
+	return s.categoryRepo.Create(ctx, category)
+}
+
+func (s *LedgerService) GetCategories(ctx context.Context, userID int64, includeArchived bool) ([]domain.Category, error) {
+	return s.categoryRepo.GetByUserID(ctx, userID, includeArchived)
+}
+
+func (s *LedgerService) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	if err := category.Validate(); err != nil {
+		return err
+	}
+
+	existing, err := s.categoryRepo.GetByID(ctx, category.ID, category.UserID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrCategoryNotFound
+	}
+
+	if err := s.checkCategoryParent(ctx, category); err != nil {
+		return err
+	}
+
+	if err := s.categoryRepo.Update(ctx, category, existing.Name); err != nil {
+		return err
+	}
+
+	s.invalidateSpending(ctx, category.UserID)
+
+	return nil
+}
+
+func (s *LedgerService) DeleteCategory(ctx context.Context, id, userID int64) error {
+	existing, err := s.categoryRepo.GetByID(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrCategoryNotFound
+	}
+
+	used, err := s.categoryRepo.InUse(ctx, userID, existing.Name)
+	if err != nil {
+		return err
+	}
+	if used {
+		return ErrCategoryInUse
+	}
+
+	deleted, err := s.categoryRepo.Delete(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrCategoryNotFound
+	}
+
+	s.invalidateSpending(ctx, userID)
+
+	return nil
+}
+
+func (s *LedgerService) MergeCategories(ctx context.Context, userID, sourceID, targetID int64) (*domain.Category, int64, error) {
+	source, err := s.categoryRepo.GetByID(ctx, sourceID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	target, err := s.categoryRepo.GetByID(ctx, targetID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if source == nil || target == nil {
+		return nil, 0, ErrCategoryNotFound
+	}
+
+	categories, err := s.categoryRepo.GetByUserID(ctx, userID, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	if target.ParentID != nil && domain.CreatesCycle(categories, source.ID, target.ID) {
+		target.ParentID = source.ParentID
+	}
+
+	moved, err := s.categoryRepo.Merge(ctx, source, target)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	s.invalidateSpending(ctx, userID)
+
+	return target, moved, nil
+}
+
+func (s *LedgerService) checkCategoryParent(ctx context.Context, category *domain.Category) error {
+	if category.ParentID == nil {
+		return nil
+	}
+
+	parent, err := s.categoryRepo.GetByID(ctx, *category.ParentID, category.UserID)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return fmt.Errorf("%w: parent category not found", ErrInvalidCategoryParent)
+	}
+	if category.ID == 0 {
+		return nil
+	}
+
+	categories, err := s.categoryRepo.GetByUserID(ctx, category.UserID, true)
+	if err != nil {
+		return err
+	}
+	if domain.CreatesCycle(categories, category.ID, parent.ID) {
+		return fmt.Errorf("%w: category cannot be nested under its own subcategory", ErrInvalidCategoryParent)
+	}
+	return nil
+}
+
+func (s *LedgerService) canonicalCategory(ctx context.Context, userID int64, name string) (*domain.Category, string, error) {
+	name = domain.NormalizeCategoryName(name)
+	category, err := s.categoryRepo.GetByName(ctx, userID, name)
+	if err != nil {
+		return nil, "", err
+	}
+	if category != nil {
+		name = category.Name
+	}
+	return category, name, nil
+}
+
+func (s *LedgerService) resolveCategory(ctx context.Context, userID int64, name string, allowArchived bool) (string, error) {
+	category, name, err := s.canonicalCategory(ctx, userID, name)
+	if err != nil {
+		return "", err
+	}
+	if category == nil {
+		category, err = s.categoryRepo.Ensure(ctx, userID, name)
+		if err != nil {
+			return "", err
+		}
+		if category == nil {
+			return name, nil
+		}
+	}
+	if category.Archived && !allowArchived {
+		return "", fmt.Errorf("%w: %s", ErrCategoryArchived, category.Name)
+	}
+	return category.Name, nil
+}
+
+func (s *LedgerService) resolveTransactionCategories(ctx context.Context, tx *domain.Transaction, existing *domain.Transaction) error {
+	used := make(map[string]bool)
+	if existing != nil {
+		for _, line := range existing.Lines() {
+			used[domain.CategoryKey(line.Category)] = true
+		}
+	}
+
+	for i := range tx.Splits {
+		category, err := s.resolveCategory(ctx, tx.UserID, tx.Splits[i].Category, used[domain.CategoryKey(tx.Splits[i].Category)])
+		if err != nil {
+			return err
+		}
+		tx.Splits[i].Category = category
+	}
+
+	category, err := s.resolveCategory(ctx, tx.UserID, tx.Category, used[domain.CategoryKey(tx.Category)])
+	if err != nil {
+		return err
+	}
+	tx.Category = category
+	return nil
+}
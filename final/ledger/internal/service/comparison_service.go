@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func (s *LedgerService) CompareReports(ctx context.Context, userID int64, from, to time.Time, baseline string) (*domain.Comparison, error) {
+	baseline, err := domain.NormalizeBaseline(baseline)
+	if err != nil {
+		return nil, err
+	}
+	baselineFrom, baselineTo, err := domain.BaselinePeriod(from, to, baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.GetReport(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := s.GetReport(ctx, userID, baselineFrom, baselineTo)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := domain.CompareReports(current, previous)
+	comparison.Baseline = baseline
+	comparison.BaselineFrom = baselineFrom
+	comparison.BaselineTo = baselineTo
+	return comparison, nil
+}
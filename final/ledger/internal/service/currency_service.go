@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func (s *LedgerService) GetSettings(ctx context.Context, userID int64) (*domain.UserSettings, error) {
+	base, err := s.baseCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.UserSettings{UserID: userID, BaseCurrency: base}, nil
+}
+
+func (s *LedgerService) SetBaseCurrency(ctx context.Context, userID int64, currency string) (*domain.UserSettings, error) {
+	currency, err := domain.NormalizeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &domain.UserSettings{UserID: userID, BaseCurrency: currency}
+	if err := s.settingsRepo.Upsert(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	s.invalidateSpending(ctx, userID)
+
+	return settings, nil
+}
+
+func (s *LedgerService) SetExchangeRates(ctx context.Context, userID int64, rates []domain.ExchangeRate) error {
+	for i := range rates {
+		rates[i].UserID = userID
+		if err := rates[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.rateRepo.Upsert(ctx, rates); err != nil {
+		return err
+	}
+
+	s.invalidateSpending(ctx, userID)
+
+	return nil
+}
+
+func (s *LedgerService) GetExchangeRates(ctx context.Context, userID int64, from, to *time.Time) ([]domain.ExchangeRate, error) {
+	return s.rateRepo.List(ctx, userID, from, to)
+}
+
+func (s *LedgerService) baseCurrency(ctx context.Context, userID int64) (string, error) {
+	settings, err := s.settingsRepo.Get(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if settings == nil || settings.BaseCurrency == "" {
+		return domain.DefaultCurrency, nil
+	}
+	return settings.BaseCurrency, nil
+}
+
+func (s *LedgerService) fillCurrency(ctx context.Context, tx *domain.Transaction) error {
+	if tx.Currency != "" {
+		return nil
+	}
+	base, err := s.baseCurrency(ctx, tx.UserID)
+	if err != nil {
+		return err
+	}
+	tx.Currency = base
+	return nil
+}
+
+func (s *LedgerService) convert(ctx context.Context, userID int64, amount domain.Money, from, to string, date time.Time) (domain.Money, error) {
+	if from == "" || from == to {
+		return amount, nil
+	}
+	rate, err := s.rateRepo.GetRate(ctx, userID, from, to, date)
+	if err != nil {
+		return 0, err
+	}
+	return amount.MulRate(rate), nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+const maxImportJobErrors = 1000
+
+func (s *LedgerService) SubmitImportJob(ctx context.Context, job *domain.ImportJob) error {
+	if err := job.Validate(); err != nil {
+		return err
+	}
+	if job.Profile != "" {
+		if _, err := s.GetImportProfile(ctx, job.UserID, job.Profile); err != nil {
+			return err
+		}
+	}
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		return err
+	}
+
+	select {
+	case s.importQueued <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (s *LedgerService) ImportJobQueued() <-chan struct{} {
+	return s.importQueued
+}
+
+func (s *LedgerService) GetImportJob(ctx context.Context, id, userID int64) (*domain.ImportJob, error) {
+	job, err := s.jobRepo.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrImportJobNotFound
+	}
+	return job, nil
+}
+
+func (s *LedgerService) GetImportJobErrors(ctx context.Context, jobID int64) ([]domain.ImportJobError, error) {
+	return s.jobRepo.GetErrors(ctx, jobID, maxImportJobErrors)
+}
+
+func (s *LedgerService) CancelImportJob(ctx context.Context, id, userID int64) (*domain.ImportJob, error) {
+	canceled, err := s.jobRepo.Cancel(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.GetImportJob(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !canceled {
+		return nil, ErrImportJobFinished
+	}
+	return job, nil
+}
+
+func (s *LedgerService) ClaimImportJob(ctx context.Context) (*domain.ImportJob, error) {
+	return s.jobRepo.Claim(ctx)
+}
+
+func (s *LedgerService) TouchImportJob(ctx context.Context, id int64) (bool, error) {
+	return s.jobRepo.Touch(ctx, id)
+}
+
+func (s *LedgerService) RequeueStaleImportJobs(ctx context.Context, before time.Time) (int64, error) {
+	return s.jobRepo.RequeueStale(ctx, before)
+}
+
+func (s *LedgerService) RunImportJob(ctx context.Context, job *domain.ImportJob, rows []domain.ImportRow) error {
+	job.TotalRows = len(rows)
+	if err := s.assignFingerprints(ctx, job.UserID, rows); err != nil {
+		return err
+	}
+
+	size := domain.ImportJobBatchSize
+	if job.Atomic {
+		size = len(rows)
+	}
+	for start := job.ProcessedRows; start < len(rows); start += size {
+		end := start + size
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		running := true
+		result, err := s.importBatch(ctx, job.UserID, rows[start:end], domain.ImportOptions{
+			DuplicateMode: job.DuplicateMode,
+			Atomic:        job.Atomic,
+		}, func(ctx context.Context, result *domain.ImportResult, batch []domain.Transaction) error {
+			progress := *job
+			rowErrors := progress.Record(result)
+			saved, err := s.jobRepo.SaveBatch(ctx, &progress, batch, rowErrors)
+			if err != nil {
+				return err
+			}
+			running = saved
+			if saved {
+				*job = progress
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if !running {
+			return nil
+		}
+		if result.RolledBack {
+			job.Record(result)
+			job.Status = domain.JobFailed
+			job.Error = fmt.Sprintf("atomic import rolled back: %d rows failed", result.Failed())
+			_, err := s.jobRepo.Finish(ctx, job)
+			return err
+		}
+	}
+
+	job.Status = domain.JobCompleted
+	_, err := s.jobRepo.Finish(ctx, job)
+	return err
+}
+
+func (s *LedgerService) FailImportJob(ctx context.Context, job *domain.ImportJob, cause error) error {
+	job.Status = domain.JobFailed
+	job.Error = cause.Error()
+	_, err := s.jobRepo.Finish(ctx, job)
+	return err
+}
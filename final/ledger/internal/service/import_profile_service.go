@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func (s *LedgerService) CreateImportProfile(ctx context.Context, profile *domain.ImportProfile) error {
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+	return s.profileRepo.Create(ctx, profile)
+}
+
+func (s *LedgerService) GetImportProfiles(ctx context.Context, userID int64) ([]domain.ImportProfile, error) {
+	return s.profileRepo.GetByUserID(ctx, userID)
+}
+
+func (s *LedgerService) GetImportProfile(ctx context.Context, userID int64, name string) (*domain.ImportProfile, error) {
+	profile, err := s.profileRepo.GetByName(ctx, userID, domain.NormalizeCategoryName(name))
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, ErrImportProfileNotFound
+	}
+	return profile, nil
+}
+
+func (s *LedgerService) UpdateImportProfile(ctx context.Context, profile *domain.ImportProfile) error {
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+	updated, err := s.profileRepo.Update(ctx, profile)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return ErrImportProfileNotFound
+	}
+	return nil
+}
+
+func (s *LedgerService) DeleteImportProfile(ctx context.Context, id, userID int64) error {
+	deleted, err := s.profileRepo.Delete(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrImportProfileNotFound
+	}
+	return nil
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type importBudgets struct {
+	base     string
+	versions map[string][]domain.Budget
+	impacts  map[string]*domain.BudgetImpact
+	order    []string
+}
+
+func (s *LedgerService) ImportTransactions(ctx context.Context, userID int64, rows []domain.ImportRow, options domain.ImportOptions) (*domain.ImportResult, error) {
+	if err := s.assignFingerprints(ctx, userID, rows); err != nil {
+		return nil, err
+	}
+	return s.importBatch(ctx, userID, rows, options, func(ctx context.Context, _ *domain.ImportResult, batch []domain.Transaction) error {
+		return s.txRepo.CreateBatch(ctx, batch)
+	})
+}
+
+func (s *LedgerService) assignFingerprints(ctx context.Context, userID int64, rows []domain.ImportRow) error {
+	domain.RequireDates(rows)
+	base, err := s.baseCurrency(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for i := range rows {
+		if rows[i].Status == domain.RowReady && rows[i].Transaction.Currency == "" {
+			rows[i].Transaction.Currency = base
+		}
+	}
+	domain.AssignFingerprints(rows)
+	return nil
+}
+
+type storeBatchFunc func(ctx context.Context, result *domain.ImportResult, batch []domain.Transaction) error
+
+func (s *LedgerService) importBatch(ctx context.Context, userID int64, rows []domain.ImportRow, options domain.ImportOptions, store storeBatchFunc) (*domain.ImportResult, error) {
+	mode, err := domain.NormalizeDuplicateMode(options.DuplicateMode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ImportResult{Rows: rows}
+	if err := s.markDuplicates(ctx, userID, rows, mode); err != nil {
+		return nil, err
+	}
+
+	newCategories, err := s.prepareImport(ctx, userID, result)
+	if err != nil {
+		return nil, err
+	}
+	if options.Preview {
+		return result, nil
+	}
+	if options.Atomic && result.Failed() > 0 {
+		result.RolledBack = true
+		return result, nil
+	}
+
+	var ready []int
+	var batch []domain.Transaction
+	for i := range rows {
+		if rows[i].Status == domain.RowReady {
+			ready = append(ready, i)
+			batch = append(batch, rows[i].Transaction)
+		}
+	}
+	if len(batch) > 0 {
+		for _, name := range newCategories {
+			if _, err := s.categoryRepo.Ensure(ctx, userID, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, i := range ready {
+		rows[i].Status = domain.RowImported
+	}
+	result.Imported = len(batch)
+	if err := store(ctx, result, batch); err != nil {
+		return nil, err
+	}
+	for j, i := range ready {
+		rows[i].Transaction = batch[j]
+	}
+
+	if len(batch) > 0 {
+		s.invalidateSpending(ctx, userID)
+	}
+
+	return result, nil
+}
+
+func (s *LedgerService) markDuplicates(ctx context.Context, userID int64, rows []domain.ImportRow, mode string) error {
+	var fingerprints []string
+	for i := range rows {
+		if rows[i].Status == domain.RowReady {
+			fingerprints = append(fingerprints, rows[i].Transaction.Fingerprint)
+		}
+	}
+	if len(fingerprints) == 0 {
+		return nil
+	}
+
+	existing, err := s.txRepo.GetByFingerprints(ctx, userID, fingerprints)
+	if err != nil {
+		return err
+	}
+
+	matched := make(map[int64]bool)
+	for i := range rows {
+		if id, ok := existing[rows[i].Transaction.Fingerprint]; ok && rows[i].Status == domain.RowReady {
+			markDuplicate(&rows[i], id, false, mode)
+			matched[id] = true
+		}
+	}
+	if mode != domain.DuplicateFlag {
+		return nil
+	}
+
+	var from, to time.Time
+	for i := range rows {
+		if rows[i].Status != domain.RowReady {
+			continue
+		}
+		date := rows[i].Transaction.Date
+		if from.IsZero() || date.Before(from) {
+			from = date
+		}
+		if date.After(to) {
+			to = date
+		}
+	}
+	if from.IsZero() {
+		return nil
+	}
+	from = from.Truncate(24 * time.Hour)
+
+	history, err := s.txRepo.GetByUserID(ctx, userID, domain.TransactionFilter{
+		From: &from,
+		To:   &to,
+		Sort: domain.SortDateDesc,
+	})
+	if err != nil {
+		return err
+	}
+
+	candidates := make(map[string][]int64)
+	for i := range history {
+		if !matched[history[i].ID] {
+			key := domain.DuplicateKey(&history[i])
+			candidates[key] = append(candidates[key], history[i].ID)
+		}
+	}
+	for i := range rows {
+		if rows[i].Status != domain.RowReady {
+			continue
+		}
+		key := domain.DuplicateKey(&rows[i].Transaction)
+		if ids := candidates[key]; len(ids) > 0 {
+			markDuplicate(&rows[i], ids[0], true, mode)
+			candidates[key] = ids[1:]
+		}
+	}
+	return nil
+}
+
+func markDuplicate(row *domain.ImportRow, existingID int64, probable bool, mode string) {
+	row.ExistingID = existingID
+	row.Probable = probable
+	if mode == domain.DuplicateForce {
+		row.Transaction.Fingerprint = ""
+		return
+	}
+	row.Status = domain.RowDuplicate
+}
+
+func (s *LedgerService) prepareImport(ctx context.Context, userID int64, result *domain.ImportResult) ([]string, error) {
+	base, err := s.baseCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.budgetRepo.GetHistoryByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	budgets := &importBudgets{
+		base:     base,
+		versions: make(map[string][]domain.Budget),
+		impacts:  make(map[string]*domain.BudgetImpact),
+	}
+	for _, version := range versions {
+		budgets.versions[version.Category] = append(budgets.versions[version.Category], version)
+	}
+
+	resolved := make(map[string]string)
+	var newCategories []string
+	resolve := func(name string) (string, error) {
+		key := domain.CategoryKey(name)
+		if canonical, ok := resolved[key]; ok {
+			return canonical, nil
+		}
+		category, canonical, err := s.canonicalCategory(ctx, userID, name)
+		if err != nil {
+			return "", err
+		}
+		if category == nil {
+			newCategories = append(newCategories, canonical)
+		} else if category.Archived {
+			return "", fmt.Errorf("%w: %s", ErrCategoryArchived, category.Name)
+		}
+		resolved[key] = canonical
+		return canonical, nil
+	}
+
+	rows := result.Rows
+	order := make([]int, 0, len(rows))
+	for i := range rows {
+		if rows[i].Status == domain.RowReady {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return rows[order[a]].Transaction.Date.Before(rows[order[b]].Transaction.Date)
+	})
+
+	for _, i := range order {
+		tx := &rows[i].Transaction
+		if err := s.prepareImportRow(ctx, tx, base, resolve); err != nil {
+			rows[i].Fail(err)
+			continue
+		}
+		if err := s.applyImportBudgets(ctx, tx, budgets); err != nil {
+			rows[i].Fail(err)
+		}
+	}
+
+	for _, key := range budgets.order {
+		result.BudgetImpact = append(result.BudgetImpact, *budgets.impacts[key])
+	}
+	return newCategories, nil
+}
+
+func (s *LedgerService) prepareImportRow(ctx context.Context, tx *domain.Transaction, base string, resolve func(string) (string, error)) error {
+	for i := range tx.Splits {
+		category, err := resolve(tx.Splits[i].Category)
+		if err != nil {
+			return err
+		}
+		tx.Splits[i].Category = category
+	}
+	category, err := resolve(tx.Category)
+	if err != nil {
+		return err
+	}
+	tx.Category = category
+
+	if err := s.resolveTransactionAccount(ctx, tx, nil); err != nil {
+		return err
+	}
+	if tx.Currency == "" {
+		tx.Currency = base
+	}
+	return nil
+}
+
+func (s *LedgerService) applyImportBudgets(ctx context.Context, tx *domain.Transaction, budgets *importBudgets) error {
+	tx.OverBudget = false
+	if !tx.IsExpense() {
+		return nil
+	}
+
+	categories, amounts := tx.CategoryAmounts()
+	impacts := make([]*domain.BudgetImpact, 0, len(categories))
+	converted := make([]domain.Money, 0, len(categories))
+	for _, category := range categories {
+		budget := domain.BudgetVersionAt(budgets.versions[category], tx.Date)
+		if budget == nil {
+			continue
+		}
+		impact, err := s.importBudgetImpact(ctx, budget, budgets, tx.Date)
+		if err != nil {
+			return err
+		}
+		amount, err := s.convert(ctx, tx.UserID, amounts[category], tx.Currency, budgets.base, tx.Date)
+		if err != nil {
+			return err
+		}
+
+		newTotal := impact.Spent + impact.Incoming + amount
+		if newTotal > impact.Limit {
+			switch budget.Enforcement {
+			case domain.EnforcementSoft:
+				tx.OverBudget = true
+			case domain.EnforcementWarn:
+			default:
+				return fmt.Errorf("%w: %s: limit %s %s, would be %s %s (%.1f%%)",
+					ErrBudgetExceeded, category, impact.Limit, budgets.base, newTotal, budgets.base, newTotal.Percent(impact.Limit))
+			}
+		}
+		impacts = append(impacts, impact)
+		converted = append(converted, amount)
+	}
+
+	for i, impact := range impacts {
+		impact.Incoming += converted[i]
+	}
+	return nil
+}
+
+func (s *LedgerService) importBudgetImpact(ctx context.Context, budget *domain.Budget, budgets *importBudgets, date time.Time) (*domain.BudgetImpact, error) {
+	from, to := s.getBudgetPeriod(budget, date)
+	key := budget.Category + "|" + from.Format("2006-01-02")
+	if impact, ok := budgets.impacts[key]; ok {
+		return impact, nil
+	}
+
+	spent, err := s.txRepo.SumByCategory(ctx, budget.UserID, budget.Category, from, to, budgets.base)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := s.effectiveLimit(ctx, budget, budgets.base, date)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := &domain.BudgetImpact{
+		Category:    budget.Category,
+		Enforcement: budget.Enforcement,
+		Currency:    budgets.base,
+		PeriodFrom:  from,
+		PeriodTo:    to,
+		Limit:       limit,
+		Spent:       spent,
+	}
+	budgets.impacts[key] = impact
+	budgets.order = append(budgets.order, key)
+	return impact, nil
+}
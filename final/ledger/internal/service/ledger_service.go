@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/mikhailmogilnikov/go/final/ledger/internal/cache"
@@ -10,71 +11,326 @@ import (
 )
 
 type LedgerService struct {
-	txRepo     domain.TransactionRepository
-	budgetRepo domain.BudgetRepository
-	cache      *cache.Cache
+	txRepo        domain.TransactionRepository
+	budgetRepo    domain.BudgetRepository
+	rateRepo      domain.ExchangeRateRepository
+	settingsRepo  domain.UserSettingsRepository
+	recurringRepo domain.RecurringRuleRepository
+	categoryRepo  domain.CategoryRepository
+	accountRepo   domain.AccountRepository
+	ruleRepo      domain.CategoryRuleRepository
+	profileRepo   domain.ImportProfileRepository
+	jobRepo       domain.ImportJobRepository
+	cache         *cache.Cache
+	importQueued  chan struct{}
 }
 
-func NewLedgerService(txRepo domain.TransactionRepository, budgetRepo domain.BudgetRepository, cache *cache.Cache) *LedgerService {
+type Repositories struct {
+	Transactions   domain.TransactionRepository
+	Budgets        domain.BudgetRepository
+	ExchangeRates  domain.ExchangeRateRepository
+	Settings       domain.UserSettingsRepository
+	RecurringRules domain.RecurringRuleRepository
+	Categories     domain.CategoryRepository
+	Accounts       domain.AccountRepository
+	CategoryRules  domain.CategoryRuleRepository
+	ImportProfiles domain.ImportProfileRepository
+	ImportJobs     domain.ImportJobRepository
+}
+
+func NewLedgerService(repos Repositories, cache *cache.Cache) *LedgerService {
 	return &LedgerService{
-		txRepo:     txRepo,
-		budgetRepo: budgetRepo,
-		cache:      cache,
+		txRepo:        repos.Transactions,
+		budgetRepo:    repos.Budgets,
+		rateRepo:      repos.ExchangeRates,
+		settingsRepo:  repos.Settings,
+		recurringRepo: repos.RecurringRules,
+		categoryRepo:  repos.Categories,
+		accountRepo:   repos.Accounts,
+		ruleRepo:      repos.CategoryRules,
+		profileRepo:   repos.ImportProfiles,
+		jobRepo:       repos.ImportJobs,
+		cache:         cache,
+		importQueued:  make(chan struct{}, 1),
 	}
 }
 
-var ErrBudgetExceeded = fmt.Errorf("budget exceeded")
+type BudgetCheck struct {
+	Warning  string
+	Exceeded bool
+}
+
+var (
+	ErrBudgetExceeded        = fmt.Errorf("budget exceeded")
+	ErrTransactionNotFound   = fmt.Errorf("transaction not found")
+	ErrInvalidTransaction    = fmt.Errorf("invalid transaction")
+	ErrNoCategoryRule        = fmt.Errorf("category is required: no categorization rule matched")
+	ErrRecurringRuleNotFound = fmt.Errorf("recurring rule not found")
+	ErrBudgetNotFound        = fmt.Errorf("budget not found")
+	ErrCategoryNotFound      = fmt.Errorf("category not found")
+	ErrCategoryInUse         = fmt.Errorf("category is used by transactions, budgets, recurring or categorization rules; archive or merge it instead")
+	ErrCategoryArchived      = fmt.Errorf("category is archived")
+	ErrInvalidCategoryParent = fmt.Errorf("invalid parent category")
+	ErrAccountNotFound       = fmt.Errorf("account not found")
+	ErrAccountArchived       = fmt.Errorf("account is archived")
+	ErrAccountInUse          = fmt.Errorf("account has transactions or transfers; archive it instead")
+	ErrCurrencyMismatch      = fmt.Errorf("currency does not match account currency")
+	ErrTransferEntry         = fmt.Errorf("transfer entries cannot be edited; delete the transfer and create a new one")
+	ErrCategoryRuleNotFound  = fmt.Errorf("category rule not found")
+	ErrImportProfileNotFound = fmt.Errorf("import profile not found")
+	ErrImportJobNotFound     = fmt.Errorf("import job not found")
+	ErrImportJobFinished     = fmt.Errorf("import job already finished")
+)
+
+func (s *LedgerService) AddTransaction(ctx context.Context, tx *domain.Transaction) (BudgetCheck, error) {
+	if err := s.ApplyCategoryRules(ctx, tx); err != nil {
+		return BudgetCheck{}, err
+	}
+	if tx.Category == "" && len(tx.Splits) == 0 {
+		return BudgetCheck{}, ErrNoCategoryRule
+	}
 
-func (s *LedgerService) AddTransaction(ctx context.Context, tx *domain.Transaction) (string, error) {
 	if err := tx.Validate(); err != nil {
-		return "", err
+		return BudgetCheck{}, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
 	}
 
 	if tx.Date.IsZero() {
 		tx.Date = time.Now()
 	}
 
-	budgetWarning := ""
+	if err := s.resolveTransactionCategories(ctx, tx, nil); err != nil {
+		return BudgetCheck{}, err
+	}
+
+	if err := s.resolveTransactionAccount(ctx, tx, nil); err != nil {
+		return BudgetCheck{}, err
+	}
+
+	if err := s.fillCurrency(ctx, tx); err != nil {
+		return BudgetCheck{}, err
+	}
+
+	check, err := s.checkBudget(ctx, tx, nil)
+	if err != nil {
+		return BudgetCheck{}, err
+	}
+
+	if err := s.txRepo.Create(ctx, tx); err != nil {
+		return BudgetCheck{}, err
+	}
+
+	s.invalidateSpending(ctx, tx.UserID)
+
+	return check, nil
+}
+
+func (s *LedgerService) UpdateTransaction(ctx context.Context, tx *domain.Transaction) (BudgetCheck, error) {
+	if tx.Category == "" && len(tx.Splits) == 0 {
+		return BudgetCheck{}, fmt.Errorf("%w: category is required", ErrInvalidTransaction)
+	}
+	if err := tx.Validate(); err != nil {
+		return BudgetCheck{}, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	}
+
+	existing, err := s.txRepo.GetByID(ctx, tx.ID, tx.UserID)
+	if err != nil {
+		return BudgetCheck{}, err
+	}
+	if existing == nil {
+		return BudgetCheck{}, ErrTransactionNotFound
+	}
+	if existing.TransferID != nil {
+		return BudgetCheck{}, ErrTransferEntry
+	}
+
+	if err := s.resolveTransactionAccount(ctx, tx, existing); err != nil {
+		return BudgetCheck{}, err
+	}
+
+	if tx.Date.IsZero() {
+		tx.Date = existing.Date
+	}
+	if tx.Currency == "" {
+		tx.Currency = existing.Currency
+	}
+
+	if err := s.resolveTransactionCategories(ctx, tx, existing); err != nil {
+		return BudgetCheck{}, err
+	}
+
+	check, err := s.checkBudget(ctx, tx, existing)
+	if err != nil {
+		return BudgetCheck{}, err
+	}
+
+	updated, err := s.txRepo.Update(ctx, tx)
+	if err != nil {
+		return BudgetCheck{}, err
+	}
+	if !updated {
+		return BudgetCheck{}, ErrTransactionNotFound
+	}
+
+	s.invalidateSpending(ctx, tx.UserID)
 
-	budget, err := s.budgetRepo.GetByCategory(ctx, tx.UserID, tx.Category)
+	return check, nil
+}
+
+func (s *LedgerService) DeleteTransaction(ctx context.Context, id, userID int64) error {
+	deleted, err := s.txRepo.Delete(ctx, id, userID)
 	if err != nil {
-		return "", err
+		return err
+	}
+	if !deleted {
+		return ErrTransactionNotFound
+	}
+
+	s.invalidateSpending(ctx, userID)
+
+	return nil
+}
+
+func (s *LedgerService) checkBudget(ctx context.Context, tx *domain.Transaction, prev *domain.Transaction) (BudgetCheck, error) {
+	var check BudgetCheck
+	tx.OverBudget = false
+	if !tx.IsExpense() {
+		return check, nil
+	}
+
+	var prevAmounts map[string]domain.Money
+	if prev != nil && prev.IsExpense() {
+		_, prevAmounts = prev.CategoryAmounts()
 	}
 
-	if budget != nil {
-		from, to := s.getBudgetPeriod(budget.Period, tx.Date)
-		spent, err := s.txRepo.SumByCategory(ctx, tx.UserID, tx.Category, from, to)
+	categories, amounts := tx.CategoryAmounts()
+	var warnings []string
+	for _, category := range categories {
+		categoryCheck, err := s.checkCategoryBudget(ctx, tx, category, amounts[category], prev, prevAmounts[category])
 		if err != nil {
-			return "", err
+			if len(categories) > 1 {
+				return check, fmt.Errorf("%s: %w", category, err)
+			}
+			return check, err
+		}
+		if categoryCheck.Warning != "" {
+			if len(categories) > 1 {
+				categoryCheck.Warning = category + ": " + categoryCheck.Warning
+			}
+			warnings = append(warnings, categoryCheck.Warning)
 		}
+		check.Exceeded = check.Exceeded || categoryCheck.Exceeded
+	}
+	check.Warning = strings.Join(warnings, "; ")
 
-		newTotal := spent + tx.Amount
-		percentage := (newTotal / budget.LimitAmount) * 100
+	return check, nil
+}
 
-		if newTotal > budget.LimitAmount {
-			return "", fmt.Errorf("%w: limit %.2f, would be %.2f (%.1f%%)",
-				ErrBudgetExceeded, budget.LimitAmount, newTotal, percentage)
-		}
+func (s *LedgerService) checkCategoryBudget(ctx context.Context, tx *domain.Transaction, category string, lineAmount domain.Money, prev *domain.Transaction, prevAmount domain.Money) (BudgetCheck, error) {
+	var check BudgetCheck
+
+	budget, err := s.budgetRepo.GetInForce(ctx, tx.UserID, category, tx.Date)
+	if err != nil {
+		return check, err
+	}
+	if budget == nil {
+		return check, nil
+	}
+
+	base, err := s.baseCurrency(ctx, tx.UserID)
+	if err != nil {
+		return check, err
+	}
 
-		if percentage >= 80 {
-			budgetWarning = fmt.Sprintf("Warning: %.1f%% of budget used (%.2f/%.2f)",
-				percentage, newTotal, budget.LimitAmount)
+	from, to := s.getBudgetPeriod(budget, tx.Date)
+	spent, err := s.txRepo.SumByCategory(ctx, tx.UserID, category, from, to, base)
+	if err != nil {
+		return check, err
+	}
+
+	if prevAmount > 0 && !prev.Date.Before(from) && !prev.Date.After(to) {
+		converted, err := s.convert(ctx, prev.UserID, prevAmount, prev.Currency, base, prev.Date)
+		if err != nil {
+			return check, err
 		}
+		spent -= converted
 	}
 
-	if err := s.txRepo.Create(ctx, tx); err != nil {
-		return "", err
+	amount, err := s.convert(ctx, tx.UserID, lineAmount, tx.Currency, base, tx.Date)
+	if err != nil {
+		return check, err
+	}
+	limit, err := s.effectiveLimit(ctx, budget, base, tx.Date)
+	if err != nil {
+		return check, err
 	}
 
-	if s.cache != nil {
-		s.cache.InvalidateReports(ctx, tx.UserID)
+	newTotal := spent + amount
+	percentage := newTotal.Percent(limit)
+
+	if newTotal > limit {
+		if budget.Enforcement == domain.EnforcementSoft || budget.Enforcement == domain.EnforcementWarn {
+			check.Exceeded = true
+			check.Warning = fmt.Sprintf("Budget exceeded: limit %s %s, now %s %s (%.1f%%)",
+				limit, base, newTotal, base, percentage)
+			if budget.Enforcement == domain.EnforcementSoft {
+				tx.OverBudget = true
+			}
+			return check, nil
+		}
+		return check, fmt.Errorf("%w: limit %s %s, would be %s %s (%.1f%%)",
+			ErrBudgetExceeded, limit, base, newTotal, base, percentage)
 	}
 
-	return budgetWarning, nil
+	if budget.ReachedThreshold(percentage) > 0 {
+		check.Warning = fmt.Sprintf("Warning: %.1f%% of budget used (%s/%s %s)",
+			percentage, newTotal, limit, base)
+	}
+
+	return check, nil
 }
 
-func (s *LedgerService) GetTransactions(ctx context.Context, userID int64, from, to *time.Time, category string) ([]domain.Transaction, error) {
-	return s.txRepo.GetByUserID(ctx, userID, from, to, category)
+func (s *LedgerService) invalidateSpending(ctx context.Context, userID int64) {
+	if s.cache == nil {
+		return
+	}
+	s.cache.InvalidateReports(ctx, userID)
+	s.cache.InvalidateBudgets(ctx, userID)
+}
+
+func (s *LedgerService) GetTransactions(ctx context.Context, userID int64, filter domain.TransactionFilter) ([]domain.Transaction, string, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, "", err
+	}
+	if filter.Currency == "" && (filter.MinAmount != nil || filter.MaxAmount != nil) {
+		base, err := s.baseCurrency(ctx, userID)
+		if err != nil {
+			return nil, "", err
+		}
+		filter.Currency = base
+	}
+
+	pageSize := filter.Limit
+	if pageSize > 0 {
+		filter.Limit = pageSize + 1
+	}
+
+	transactions, err := s.txRepo.GetByUserID(ctx, userID, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextPageToken := ""
+	if pageSize > 0 && len(transactions) > pageSize {
+		transactions = transactions[:pageSize]
+		last := transactions[pageSize-1]
+		cursor := domain.TransactionCursor{Sort: filter.Sort, Date: last.Date, ID: last.ID}
+		if filter.Sort == domain.SortRelevance {
+			cursor.Rank = &last.Rank
+		}
+		nextPageToken = cursor.Encode()
+	}
+
+	return transactions, nextPageToken, nil
 }
 
 func (s *LedgerService) SetBudget(ctx context.Context, budget *domain.Budget) error {
@@ -82,14 +338,42 @@ func (s *LedgerService) SetBudget(ctx context.Context, budget *domain.Budget) er
 		return err
 	}
 
-	if err := s.budgetRepo.Upsert(ctx, budget); err != nil {
+	category, err := s.resolveCategory(ctx, budget.UserID, budget.Category, false)
+	if err != nil {
 		return err
 	}
+	budget.Category = category
 
-	if s.cache != nil {
-		s.cache.InvalidateBudgets(ctx, budget.UserID)
+	if budget.Currency == "" {
+		base, err := s.baseCurrency(ctx, budget.UserID)
+		if err != nil {
+			return err
+		}
+		budget.Currency = base
+	}
+
+	budget.ValidFrom, _ = s.getBudgetPeriod(budget, time.Now())
+	budget.RolloverFrom = nil
+	if budget.Rollover {
+		existing, err := s.budgetRepo.GetByCategory(ctx, budget.UserID, budget.Category)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.Rollover && existing.RolloverFrom != nil &&
+			existing.Period == budget.Period && existing.StartDay == budget.StartDay {
+			budget.RolloverFrom = existing.RolloverFrom
+		} else {
+			from := budget.ValidFrom
+			budget.RolloverFrom = &from
+		}
+	}
+
+	if err := s.budgetRepo.Upsert(ctx, budget); err != nil {
+		return err
 	}
 
+	s.invalidateSpending(ctx, budget.UserID)
+
 	return nil
 }
 
@@ -112,63 +396,184 @@ func (s *LedgerService) GetBudgets(ctx context.Context, userID int64) ([]domain.
 	return budgets, nil
 }
 
-func (s *LedgerService) GetReport(ctx context.Context, userID int64, from, to time.Time) ([]domain.CategorySummary, float64, error) {
+func (s *LedgerService) GetBudgetHistory(ctx context.Context, userID int64, category string) ([]domain.Budget, error) {
+	_, category, err := s.canonicalCategory(ctx, userID, category)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.budgetRepo.GetHistory(ctx, userID, category)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrBudgetNotFound
+	}
+	return versions, nil
+}
+
+func (s *LedgerService) GetReport(ctx context.Context, userID int64, from, to time.Time) (*domain.Report, error) {
 	if s.cache != nil {
 		if cached, err := s.cache.GetReport(ctx, userID, from, to); err == nil && cached != nil {
-			return cached.Categories, cached.TotalExpenses, nil
+			return cached, nil
 		}
 	}
 
-	summaries, err := s.txRepo.GetReportSummary(ctx, userID, from, to)
+	base, err := s.baseCurrency(ctx, userID)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	budgets, err := s.budgetRepo.GetByUserID(ctx, userID)
+	summaries, err := s.txRepo.GetReportSummary(ctx, userID, from, to, base)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	budgetMap := make(map[string]*domain.Budget)
-	for i := range budgets {
-		budgetMap[budgets[i].Category] = &budgets[i]
+	income, expenses, err := s.txRepo.GetCashFlow(ctx, userID, from, to, base)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepo.GetByUserID(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	summaries = domain.RollUpCategories(summaries, categories)
+
+	budgets, err := s.budgetRepo.GetHistoryByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgetMap := make(map[string][]domain.Budget)
+	for _, b := range budgets {
+		budgetMap[b.Category] = append(budgetMap[b.Category], b)
 	}
 
-	var totalExpenses float64
 	for i := range summaries {
-		totalExpenses += summaries[i].Total
-		if b, ok := budgetMap[summaries[i].Category]; ok {
-			summaries[i].BudgetLimit = b.LimitAmount
-			summaries[i].BudgetPercentage = (summaries[i].Total / b.LimitAmount) * 100
+		if versions, ok := budgetMap[summaries[i].Category]; ok {
+			limit, found, err := s.reportLimit(ctx, userID, versions, base, from, to)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				continue
+			}
+			summaries[i].BudgetLimit = limit
+			summaries[i].BudgetPercentage = summaries[i].Total.Percent(limit)
 		}
 	}
 
+	report := &domain.Report{
+		BaseCurrency:  base,
+		Categories:    summaries,
+		TotalExpenses: expenses,
+		TotalIncome:   income,
+		NetBalance:    income - expenses,
+	}
+	if income > 0 {
+		report.SavingsRate = (income - expenses).Percent(income)
+	}
+
 	if s.cache != nil {
-		s.cache.SetReport(ctx, userID, from, to, &cache.ReportCache{
-			Categories:    summaries,
-			TotalExpenses: totalExpenses,
-		})
+		s.cache.SetReport(ctx, userID, from, to, report)
 	}
 
-	return summaries, totalExpenses, nil
+	return report, nil
+}
+
+func (s *LedgerService) getBudgetPeriod(budget *domain.Budget, date time.Time) (time.Time, time.Time) {
+	return budget.PeriodFor(date)
 }
 
-func (s *LedgerService) getBudgetPeriod(period string, date time.Time) (time.Time, time.Time) {
-	switch period {
-	case "weekly":
-		weekday := int(date.Weekday())
-		if weekday == 0 {
-			weekday = 7
+func (s *LedgerService) effectiveLimit(ctx context.Context, budget *domain.Budget, base string, date time.Time) (domain.Money, error) {
+	if !budget.Rollover || budget.RolloverFrom == nil {
+		return s.convert(ctx, budget.UserID, budget.LimitAmount, budget.Currency, base, date)
+	}
+
+	from, _ := s.getBudgetPeriod(budget, date)
+	if !budget.RolloverFrom.Before(from) {
+		return s.convert(ctx, budget.UserID, budget.LimitAmount, budget.Currency, base, date)
+	}
+
+	versions, err := s.budgetRepo.GetHistory(ctx, budget.UserID, budget.Category)
+	if err != nil {
+		return 0, err
+	}
+	limits := map[string]domain.Money{budget.Currency: budget.LimitAmount}
+	for start, _ := budget.PeriodFor(*budget.RolloverFrom); start.Before(from); start, _ = budget.NextPeriod(start) {
+		version := domain.BudgetVersionAt(versions, start)
+		if version == nil {
+			version = budget
+		}
+		limits[version.Currency] += version.LimitAmount
+	}
+	limit, err := s.convertLimits(ctx, budget.UserID, limits, base, date)
+	if err != nil {
+		return 0, err
+	}
+
+	spent, err := s.txRepo.SumByCategory(ctx, budget.UserID, budget.Category, *budget.RolloverFrom, from.Add(-time.Second), base)
+	if err != nil {
+		return 0, err
+	}
+
+	return limit - spent, nil
+}
+
+func (s *LedgerService) reportLimit(ctx context.Context, userID int64, versions []domain.Budget, base string, from, to time.Time) (domain.Money, bool, error) {
+	var limit domain.Money
+	found := false
+	extra := make(map[string]domain.Money)
+
+	for date := from; !date.After(to); {
+		version := domain.BudgetVersionAt(versions, date)
+		if version == nil {
+			next := domain.NextBudgetVersion(versions, date)
+			if next == nil {
+				break
+			}
+			date = next.ValidFrom
+			continue
+		}
+
+		if !found {
+			first, err := s.effectiveLimit(ctx, version, base, date)
+			if err != nil {
+				return 0, false, err
+			}
+			limit, found = first, true
+		} else {
+			extra[version.Currency] += version.LimitAmount
+		}
+
+		_, periodEnd := version.PeriodFor(date)
+		date = periodEnd.Add(time.Second)
+		if version.ValidTo != nil && date.After(*version.ValidTo) {
+			date = *version.ValidTo
+		}
+	}
+
+	if !found {
+		return 0, false, nil
+	}
+
+	rest, err := s.convertLimits(ctx, userID, extra, base, to)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return limit + rest, true, nil
+}
+
+func (s *LedgerService) convertLimits(ctx context.Context, userID int64, limits map[string]domain.Money, base string, date time.Time) (domain.Money, error) {
+	var total domain.Money
+	for currency, amount := range limits {
+		converted, err := s.convert(ctx, userID, amount, currency, base, date)
+		if err != nil {
+			return 0, err
 		}
-		from := date.AddDate(0, 0, -(weekday - 1))
-		from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
-		to := from.AddDate(0, 0, 6)
-		to = time.Date(to.Year(), to.Month(), to.Day(), 23, 59, 59, 0, to.Location())
-		return from, to
-	default:
-		from := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
-		to := from.AddDate(0, 1, -1)
-		to = time.Date(to.Year(), to.Month(), to.Day(), 23, 59, 59, 0, to.Location())
-		return from, to
+		total += converted
 	}
+	return total, nil
 }
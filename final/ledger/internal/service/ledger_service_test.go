@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+type fakeTransactionRepository struct {
+	domain.TransactionRepository
+	transactions       map[int64]domain.Transaction
+	deleteBeforeUpdate bool
+	updated            []domain.Transaction
+	fingerprints       map[string]int64
+	created            []domain.Transaction
+	filters            []domain.TransactionFilter
+}
+
+func (r *fakeTransactionRepository) GetByID(_ context.Context, id, userID int64) (*domain.Transaction, error) {
+	tx, ok := r.transactions[id]
+	if !ok || tx.UserID != userID {
+		return nil, nil
+	}
+	return &tx, nil
+}
+
+func (r *fakeTransactionRepository) Update(_ context.Context, tx *domain.Transaction) (bool, error) {
+	if r.deleteBeforeUpdate {
+		delete(r.transactions, tx.ID)
+	}
+	if _, ok := r.transactions[tx.ID]; !ok {
+		return false, nil
+	}
+	r.transactions[tx.ID] = *tx
+	r.updated = append(r.updated, *tx)
+	return true, nil
+}
+
+func (r *fakeTransactionRepository) GetByUserID(_ context.Context, _ int64, filter domain.TransactionFilter) ([]domain.Transaction, error) {
+	r.filters = append(r.filters, filter)
+	return nil, nil
+}
+
+func (r *fakeTransactionRepository) GetByFingerprints(_ context.Context, _ int64, fingerprints []string) (map[string]int64, error) {
+	existing := make(map[string]int64)
+	for _, fingerprint := range fingerprints {
+		if id, ok := r.fingerprints[fingerprint]; ok {
+			existing[fingerprint] = id
+		}
+	}
+	return existing, nil
+}
+
+func (r *fakeTransactionRepository) CreateBatch(_ context.Context, transactions []domain.Transaction) error {
+	if r.fingerprints == nil {
+		r.fingerprints = make(map[string]int64)
+	}
+	for i := range transactions {
+		if _, ok := r.fingerprints[transactions[i].Fingerprint]; ok {
+			return domain.ErrDuplicateTransaction
+		}
+		transactions[i].ID = int64(len(r.created) + 1)
+		r.fingerprints[transactions[i].Fingerprint] = transactions[i].ID
+		r.created = append(r.created, transactions[i])
+	}
+	return nil
+}
+
+type fakeCategoryRepository struct {
+	domain.CategoryRepository
+}
+
+func (fakeCategoryRepository) GetByName(_ context.Context, userID int64, name string) (*domain.Category, error) {
+	return &domain.Category{ID: 1, UserID: userID, Name: name}, nil
+}
+
+type fakeBudgetRepository struct {
+	domain.BudgetRepository
+}
+
+func (fakeBudgetRepository) GetInForce(context.Context, int64, string, time.Time) (*domain.Budget, error) {
+	return nil, nil
+}
+
+func (fakeBudgetRepository) GetHistoryByUserID(context.Context, int64) ([]domain.Budget, error) {
+	return nil, nil
+}
+
+type fakeSettingsRepository struct {
+	domain.UserSettingsRepository
+}
+
+func (fakeSettingsRepository) Get(context.Context, int64) (*domain.UserSettings, error) {
+	return nil, nil
+}
+
+func newTestService(txRepo *fakeTransactionRepository) *LedgerService {
+	return NewLedgerService(Repositories{
+		Transactions: txRepo,
+		Budgets:      fakeBudgetRepository{},
+		Settings:     fakeSettingsRepository{},
+		Categories:   fakeCategoryRepository{},
+	}, nil)
+}
+
+func TestLedgerService_UpdateTransaction(t *testing.T) {
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	existing := domain.Transaction{ID: 7, UserID: 1, Kind: domain.KindExpense, Amount: 35000, Currency: "RUB", Category: "cafe", Date: date}
+
+	tests := []struct {
+		name               string
+		tx                 domain.Transaction
+		deleteBeforeUpdate bool
+		wantErr            error
+	}{
+		{
+			name: "updated",
+			tx:   domain.Transaction{ID: 7, UserID: 1, Kind: domain.KindExpense, Amount: 42000, Category: "cafe", Tags: []string{"Work"}},
+		},
+		{
+			name:    "non-positive amount",
+			tx:      domain.Transaction{ID: 7, UserID: 1, Kind: domain.KindExpense, Amount: -100, Category: "cafe"},
+			wantErr: ErrInvalidTransaction,
+		},
+		{
+			name:    "unknown kind",
+			tx:      domain.Transaction{ID: 7, UserID: 1, Kind: "gift", Amount: 100, Category: "cafe"},
+			wantErr: ErrInvalidTransaction,
+		},
+		{
+			name:    "invalid currency",
+			tx:      domain.Transaction{ID: 7, UserID: 1, Kind: domain.KindExpense, Amount: 100, Currency: "рубли", Category: "cafe"},
+			wantErr: ErrInvalidTransaction,
+		},
+		{
+			name:    "no category",
+			tx:      domain.Transaction{ID: 7, UserID: 1, Kind: domain.KindExpense, Amount: 100},
+			wantErr: ErrInvalidTransaction,
+		},
+		{
+			name:    "not found",
+			tx:      domain.Transaction{ID: 8, UserID: 1, Kind: domain.KindExpense, Amount: 100, Category: "cafe"},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name:    "other user",
+			tx:      domain.Transaction{ID: 7, UserID: 2, Kind: domain.KindExpense, Amount: 100, Category: "cafe"},
+			wantErr: ErrTransactionNotFound,
+		},
+		{
+			name:               "deleted concurrently",
+			tx:                 domain.Transaction{ID: 7, UserID: 1, Kind: domain.KindExpense, Amount: 100, Category: "cafe"},
+			deleteBeforeUpdate: true,
+			wantErr:            ErrTransactionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txRepo := &fakeTransactionRepository{
+				transactions:       map[int64]domain.Transaction{existing.ID: existing},
+				deleteBeforeUpdate: tt.deleteBeforeUpdate,
+			}
+			tx := tt.tx
+
+			_, err := newTestService(txRepo).UpdateTransaction(context.Background(), &tx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateTransaction() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(txRepo.updated) != 0 {
+					t.Errorf("repository updated %d transactions, want none", len(txRepo.updated))
+				}
+				return
+			}
+
+			if len(txRepo.updated) != 1 {
+				t.Fatalf("repository updated %d transactions, want 1", len(txRepo.updated))
+			}
+			got := txRepo.updated[0]
+			if got.Amount != 42000 || got.Currency != "RUB" || !got.Date.Equal(date) || len(got.Tags) != 1 || got.Tags[0] != "work" {
+				t.Errorf("updated transaction = %+v, want amount 420.00 RUB on %s tagged work", got, date.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestLedgerService_UpdateTransaction_TransferEntry(t *testing.T) {
+	transferID := int64(3)
+	txRepo := &fakeTransactionRepository{transactions: map[int64]domain.Transaction{
+		7: {ID: 7, UserID: 1, Kind: domain.KindTransfer, Amount: 100, Currency: "RUB", Category: domain.TransferCategory, TransferID: &transferID},
+	}}
+	tx := domain.Transaction{ID: 7, UserID: 1, Kind: domain.KindTransfer, Amount: 200, Category: domain.TransferCategory}
+
+	if _, err := newTestService(txRepo).UpdateTransaction(context.Background(), &tx); !errors.Is(err, ErrTransferEntry) {
+		t.Errorf("UpdateTransaction() error = %v, want %v", err, ErrTransferEntry)
+	}
+}
+
+func TestLedgerService_ImportTransactions_DatelessRow(t *testing.T) {
+	txRepo := &fakeTransactionRepository{}
+	service := newTestService(txRepo)
+	dated := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	for day := 1; day <= 2; day++ {
+		now := time.Date(2025, 4, day, 12, 0, 0, 0, time.UTC)
+		rows := []domain.ImportRow{
+			{Line: 2, Status: domain.RowReady, Transaction: domain.Transaction{UserID: 1, Kind: domain.KindExpense, Amount: 35000, Category: "cafe", Date: dated}},
+			{Line: 3, Status: domain.RowReady, DateError: "missing date", Transaction: domain.Transaction{UserID: 1, Kind: domain.KindExpense, Amount: 12000, Category: "taxi", Date: now}},
+		}
+
+		result, err := service.ImportTransactions(context.Background(), 1, rows, domain.ImportOptions{})
+		if err != nil {
+			t.Fatalf("import on day %d: ImportTransactions() error = %v", day, err)
+		}
+		if got := result.Rows[1]; got.Status != domain.RowFailed || got.Error != "missing date" || got.Warning != "" {
+			t.Errorf("import on day %d: dateless row = %s %q %q, want failed \"missing date\" without warning", day, got.Status, got.Error, got.Warning)
+		}
+		wantDated := domain.RowImported
+		if day > 1 {
+			wantDated = domain.RowDuplicate
+		}
+		if got := result.Rows[0].Status; got != wantDated {
+			t.Errorf("import on day %d: dated row status = %s, want %s", day, got, wantDated)
+		}
+	}
+
+	if len(txRepo.created) != 1 {
+		t.Errorf("repository created %d transactions, want 1", len(txRepo.created))
+	}
+}
+
+func TestLedgerService_GetTransactions_AmountCurrency(t *testing.T) {
+	amount := domain.Money(10000)
+	tests := []struct {
+		name   string
+		filter domain.TransactionFilter
+		want   string
+	}{
+		{name: "no amount range", filter: domain.TransactionFilter{}, want: ""},
+		{name: "range in base currency", filter: domain.TransactionFilter{MinAmount: &amount}, want: domain.DefaultCurrency},
+		{name: "range in given currency", filter: domain.TransactionFilter{MaxAmount: &amount, Currency: "usd"}, want: "USD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txRepo := &fakeTransactionRepository{}
+			if _, _, err := newTestService(txRepo).GetTransactions(context.Background(), 1, tt.filter); err != nil {
+				t.Fatalf("GetTransactions() error = %v", err)
+			}
+			if len(txRepo.filters) != 1 || txRepo.filters[0].Currency != tt.want {
+				t.Errorf("repository filters = %+v, want currency %q", txRepo.filters, tt.want)
+			}
+		})
+	}
+}
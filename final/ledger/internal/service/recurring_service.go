@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+const recurringBatchSize = 100
+
+type RecurringFailure struct {
+	RuleID int64
+	UserID int64
+	Date   time.Time
+	Err    error
+}
+
+func (s *LedgerService) CreateRecurringRule(ctx context.Context, rule *domain.RecurringRule) error {
+	if err := s.prepareRecurringRule(ctx, rule); err != nil {
+		return err
+	}
+
+	next, _ := rule.NextOnOrAfter(rule.StartDate)
+	rule.NextDate = &next
+
+	return s.recurringRepo.Create(ctx, rule)
+}
+
+func (s *LedgerService) GetRecurringRules(ctx context.Context, userID int64) ([]domain.RecurringRule, error) {
+	return s.recurringRepo.GetByUserID(ctx, userID)
+}
+
+func (s *LedgerService) UpdateRecurringRule(ctx context.Context, rule *domain.RecurringRule) error {
+	if err := s.prepareRecurringRule(ctx, rule); err != nil {
+		return err
+	}
+
+	existing, err := s.recurringRepo.GetByID(ctx, rule.ID, rule.UserID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrRecurringRuleNotFound
+	}
+
+	resumeFrom := rule.StartDate
+	switch {
+	case existing.NextDate != nil && existing.NextDate.After(resumeFrom):
+		resumeFrom = *existing.NextDate
+	case existing.NextDate == nil && existing.EndDate != nil && existing.EndDate.After(resumeFrom):
+		resumeFrom = existing.EndDate.AddDate(0, 0, 1)
+	}
+
+	rule.NextDate = nil
+	if next, ok := rule.NextOnOrAfter(resumeFrom); ok {
+		rule.NextDate = &next
+	}
+	rule.LastError = ""
+
+	return s.recurringRepo.Update(ctx, rule)
+}
+
+func (s *LedgerService) DeleteRecurringRule(ctx context.Context, id, userID int64) error {
+	deleted, err := s.recurringRepo.Delete(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrRecurringRuleNotFound
+	}
+	return nil
+}
+
+func (s *LedgerService) MaterializeDueRecurring(ctx context.Context, today time.Time) (int, []RecurringFailure, error) {
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+
+	var created int
+	var failures []RecurringFailure
+	for {
+		rules, err := s.recurringRepo.ListDue(ctx, today, recurringBatchSize)
+		if err != nil {
+			return created, failures, err
+		}
+
+		for i := range rules {
+			n, ruleFailures, err := s.materializeRule(ctx, &rules[i], today)
+			created += n
+			failures = append(failures, ruleFailures...)
+			if err != nil {
+				return created, failures, err
+			}
+		}
+
+		if len(rules) < recurringBatchSize {
+			return created, failures, nil
+		}
+	}
+}
+
+func (s *LedgerService) materializeRule(ctx context.Context, rule *domain.RecurringRule, today time.Time) (int, []RecurringFailure, error) {
+	var created int
+	var failures []RecurringFailure
+	for rule.NextDate != nil && !rule.NextDate.After(today) {
+		date := *rule.NextDate
+		tx := rule.Transaction(date)
+
+		_, err := s.AddTransaction(ctx, &tx)
+		switch {
+		case err == nil:
+			created++
+		case errors.Is(err, domain.ErrDuplicateOccurrence):
+		case errors.Is(err, ErrBudgetExceeded) || errors.Is(err, domain.ErrExchangeRateNotFound) ||
+			errors.Is(err, ErrCategoryArchived):
+			rule.LastError = fmt.Sprintf("%s: %v", date.Format("2006-01-02"), err)
+			failures = append(failures, RecurringFailure{RuleID: rule.ID, UserID: rule.UserID, Date: date, Err: err})
+		default:
+			return created, failures, fmt.Errorf("recurring rule %d: %w", rule.ID, err)
+		}
+
+		rule.Advance(date)
+		if err := s.recurringRepo.UpdateSchedule(ctx, rule); err != nil {
+			return created, failures, err
+		}
+	}
+	return created, failures, nil
+}
+
+func (s *LedgerService) prepareRecurringRule(ctx context.Context, rule *domain.RecurringRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	category, err := s.resolveCategory(ctx, rule.UserID, rule.Category, false)
+	if err != nil {
+		return err
+	}
+	rule.Category = category
+	if rule.Currency == "" {
+		base, err := s.baseCurrency(ctx, rule.UserID)
+		if err != nil {
+			return err
+		}
+		rule.Currency = base
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func (s *LedgerService) GetTagReport(ctx context.Context, userID int64, from, to time.Time, tags []string) (*domain.TagReport, error) {
+	tags, err := domain.NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := s.baseCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := s.txRepo.GetTagSummary(ctx, userID, from, to, base, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TagReport{
+		BaseCurrency: base,
+		Tags:         summaries,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+)
+
+func (s *LedgerService) GetTrends(ctx context.Context, query domain.TrendQuery) (*domain.Trend, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	base, err := s.baseCurrency(ctx, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.txRepo.GetSpendingTrend(ctx, query, base)
+	if err != nil {
+		return nil, err
+	}
+
+	trend := domain.NewTrend(query, points)
+	trend.BaseCurrency = base
+	return trend, nil
+}
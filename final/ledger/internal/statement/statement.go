@@ -0,0 +1,18 @@
+package statement
+
+import (
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/csv"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/ofx"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/qif"
+)
+
+func Parse(format string, data []byte, userID int64, profile *domain.ImportProfile, rules []domain.CategoryRule) ([]domain.ImportRow, error) {
+	switch format {
+	case domain.FormatOFX:
+		return ofx.ParseOFX(data, userID, rules)
+	case domain.FormatQIF:
+		return qif.ParseQIF(data, userID, rules)
+	}
+	return csv.ParseCSV(data, userID, profile, rules)
+}
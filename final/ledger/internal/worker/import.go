@@ -0,0 +1,148 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/domain"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/statement"
+)
+
+const (
+	importHeartbeat  = 30 * time.Second
+	importStaleAfter = 5 * time.Minute
+)
+
+type ImportWorker struct {
+	ledgerService *service.LedgerService
+	workers       int
+	interval      time.Duration
+}
+
+func NewImportWorker(ledgerService *service.LedgerService, workers int, interval time.Duration) *ImportWorker {
+	if workers < 1 {
+		workers = 1
+	}
+	return &ImportWorker{ledgerService: ledgerService, workers: workers, interval: interval}
+}
+
+func (w *ImportWorker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < w.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.loop(ctx)
+		}()
+	}
+
+	ticker := time.NewTicker(importStaleAfter)
+	defer ticker.Stop()
+	for {
+		requeued, err := w.ledgerService.RequeueStaleImportJobs(ctx, time.Now().Add(-importStaleAfter))
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Import worker: failed to requeue stale jobs: %v", err)
+		}
+		if requeued > 0 {
+			log.Printf("Import worker: requeued %d stale jobs", requeued)
+		}
+
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *ImportWorker) loop(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for w.runOnce(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.ledgerService.ImportJobQueued():
+		}
+	}
+}
+
+func (w *ImportWorker) runOnce(ctx context.Context) bool {
+	job, err := w.ledgerService.ClaimImportJob(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Import worker: failed to claim job: %v", err)
+		}
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go w.heartbeat(jobCtx, cancel, job.ID)
+
+	if err := w.process(jobCtx, job); err != nil {
+		switch {
+		case ctx.Err() != nil:
+		case jobCtx.Err() != nil:
+			log.Printf("Import job %d (user %d) canceled", job.ID, job.UserID)
+		default:
+			log.Printf("Import job %d (user %d) failed: %v", job.ID, job.UserID, err)
+			if err := w.ledgerService.FailImportJob(ctx, job, err); err != nil {
+				log.Printf("Import job %d: failed to save status: %v", job.ID, err)
+			}
+		}
+	}
+	return true
+}
+
+func (w *ImportWorker) process(ctx context.Context, job *domain.ImportJob) error {
+	var profile *domain.ImportProfile
+	if job.Profile != "" {
+		found, err := w.ledgerService.GetImportProfile(ctx, job.UserID, job.Profile)
+		if err != nil {
+			return err
+		}
+		profile = found
+	}
+	rules, err := w.ledgerService.GetCategoryRules(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := statement.Parse(job.Format, job.Data, job.UserID, profile, rules)
+	if err != nil {
+		return err
+	}
+	return w.ledgerService.RunImportJob(ctx, job, rows)
+}
+
+func (w *ImportWorker) heartbeat(ctx context.Context, cancel context.CancelFunc, jobID int64) {
+	ticker := time.NewTicker(importHeartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		running, err := w.ledgerService.TouchImportJob(ctx, jobID)
+		if err == nil && !running {
+			cancel()
+			return
+		}
+	}
+}
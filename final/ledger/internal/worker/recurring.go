@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mikhailmogilnikov/go/final/ledger/internal/service"
+)
+
+type RecurringWorker struct {
+	ledgerService *service.LedgerService
+	interval      time.Duration
+}
+
+func NewRecurringWorker(ledgerService *service.LedgerService, interval time.Duration) *RecurringWorker {
+	return &RecurringWorker{ledgerService: ledgerService, interval: interval}
+}
+
+func (w *RecurringWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *RecurringWorker) runOnce(ctx context.Context) {
+	created, failures, err := w.ledgerService.MaterializeDueRecurring(ctx, time.Now())
+	for _, f := range failures {
+		log.Printf("Recurring rule %d (user %d): occurrence %s skipped: %v",
+			f.RuleID, f.UserID, f.Date.Format("2006-01-02"), f.Err)
+	}
+	if err != nil && ctx.Err() == nil {
+		log.Printf("Recurring worker error: %v", err)
+	}
+	if created > 0 {
+		log.Printf("Recurring worker: created %d transactions", created)
+	}
+}
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Индекс для постраничной выборки по курсору (date, id)
+CREATE INDEX IF NOT EXISTS idx_transactions_user_date_id ON transactions(user_id, date, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_user_date_id;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Тип транзакции: расход, доход или перевод
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'expense'
+    CHECK (kind IN ('expense', 'income', 'transfer'));
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_kind_date ON transactions(user_id, kind, date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_user_kind_date;
+ALTER TABLE transactions DROP COLUMN IF EXISTS kind;
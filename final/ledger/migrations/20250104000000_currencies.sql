@@ -0,0 +1,31 @@
+-- +goose Up
+-- Валюта транзакций и бюджетов (ISO 4217)
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB'
+    CHECK (currency ~ '^[A-Z]{3}$');
+ALTER TABLE budgets
+    ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB'
+    CHECK (currency ~ '^[A-Z]{3}$');
+
+-- Настройки пользователя (базовая валюта отчётов)
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id BIGINT PRIMARY KEY,
+    base_currency TEXT NOT NULL DEFAULT 'RUB' CHECK (base_currency ~ '^[A-Z]{3}$'),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Курсы валют: 1 base_currency = rate quote_currency на дату
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    user_id BIGINT NOT NULL,
+    base_currency TEXT NOT NULL CHECK (base_currency ~ '^[A-Z]{3}$'),
+    quote_currency TEXT NOT NULL CHECK (quote_currency ~ '^[A-Z]{3}$'),
+    date DATE NOT NULL,
+    rate NUMERIC(20,8) NOT NULL CHECK (rate > 0),
+    PRIMARY KEY (user_id, base_currency, quote_currency, date)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS exchange_rates;
+DROP TABLE IF EXISTS user_settings;
+ALTER TABLE budgets DROP COLUMN IF EXISTS currency;
+ALTER TABLE transactions DROP COLUMN IF EXISTS currency;
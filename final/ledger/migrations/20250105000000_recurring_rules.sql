@@ -0,0 +1,31 @@
+-- +goose Up
+-- Правила регулярных транзакций (аренда, подписки)
+CREATE TABLE IF NOT EXISTS recurring_rules (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    kind TEXT NOT NULL DEFAULT 'expense' CHECK (kind IN ('expense', 'income', 'transfer')),
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    currency TEXT NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    category TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+    interval_count INT NOT NULL DEFAULT 1 CHECK (interval_count > 0),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    next_date DATE,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_recurring_rules_user_id ON recurring_rules(user_id);
+CREATE INDEX IF NOT EXISTS idx_recurring_rules_next_date ON recurring_rules(next_date) WHERE next_date IS NOT NULL;
+
+-- Каждое срабатывание правила создаёт не больше одной транзакции
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS recurring_rule_id INT REFERENCES recurring_rules(id) ON DELETE SET NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_recurring_occurrence
+    ON transactions(recurring_rule_id, date) WHERE recurring_rule_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_recurring_occurrence;
+ALTER TABLE transactions DROP COLUMN IF EXISTS recurring_rule_id;
+DROP TABLE IF EXISTS recurring_rules;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Периоды бюджета: daily/weekly/monthly/quarterly/yearly с произвольным днём начала
+ALTER TABLE budgets
+    ADD COLUMN IF NOT EXISTS start_day INT NOT NULL DEFAULT 1 CHECK (start_day BETWEEN 1 AND 31),
+    ADD COLUMN IF NOT EXISTS rollover BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS rollover_from DATE;
+ALTER TABLE budgets
+    ADD CONSTRAINT budgets_period_check CHECK (period IN ('daily', 'weekly', 'monthly', 'quarterly', 'yearly'));
+
+-- +goose Down
+ALTER TABLE budgets DROP CONSTRAINT IF EXISTS budgets_period_check;
+ALTER TABLE budgets
+    DROP COLUMN IF EXISTS rollover_from,
+    DROP COLUMN IF EXISTS rollover,
+    DROP COLUMN IF EXISTS start_day;
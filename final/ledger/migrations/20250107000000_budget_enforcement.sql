@@ -0,0 +1,15 @@
+-- +goose Up
+-- Политика бюджета: hard - отклонять, soft - сохранять с пометкой, warn - только предупреждать
+ALTER TABLE budgets
+    ADD COLUMN IF NOT EXISTS enforcement TEXT NOT NULL DEFAULT 'hard' CHECK (enforcement IN ('hard', 'soft', 'warn')),
+    ADD COLUMN IF NOT EXISTS warning_thresholds INT[] NOT NULL DEFAULT '{80}';
+
+-- Расходы, сохранённые сверх soft-бюджета
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS over_budget BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN IF EXISTS over_budget;
+ALTER TABLE budgets
+    DROP COLUMN IF EXISTS warning_thresholds,
+    DROP COLUMN IF EXISTS enforcement;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Версии бюджета: каждое изменение лимита действует с начала своего периода, прошлые версии сохраняются.
+-- valid_to не включается в версию; NULL - текущая версия.
+-- История до версионирования неизвестна, поэтому существующие лимиты действуют с начала времён.
+ALTER TABLE budgets
+    ADD COLUMN IF NOT EXISTS valid_from DATE NOT NULL DEFAULT DATE '1970-01-01',
+    ADD COLUMN IF NOT EXISTS valid_to DATE;
+ALTER TABLE budgets ALTER COLUMN valid_from DROP DEFAULT;
+ALTER TABLE budgets
+    DROP CONSTRAINT IF EXISTS budgets_user_id_category_key,
+    ADD CONSTRAINT budgets_user_category_valid_from_key UNIQUE (user_id, category, valid_from),
+    ADD CONSTRAINT budgets_valid_range_check CHECK (valid_to IS NULL OR valid_to > valid_from);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_budgets_current ON budgets(user_id, category) WHERE valid_to IS NULL;
+
+-- +goose Down
+DELETE FROM budgets WHERE valid_to IS NOT NULL;
+DROP INDEX IF EXISTS idx_budgets_current;
+ALTER TABLE budgets
+    DROP CONSTRAINT IF EXISTS budgets_valid_range_check,
+    DROP CONSTRAINT IF EXISTS budgets_user_category_valid_from_key,
+    ADD CONSTRAINT budgets_user_id_category_key UNIQUE (user_id, category);
+ALTER TABLE budgets
+    DROP COLUMN IF EXISTS valid_to,
+    DROP COLUMN IF EXISTS valid_from;
@@ -0,0 +1,62 @@
+-- +goose Up
+-- Категории пользователя с иерархией; транзакции и бюджеты хранят имя категории
+CREATE TABLE IF NOT EXISTS categories (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (name <> ''),
+    parent_id INT REFERENCES categories(id) ON DELETE SET NULL,
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (parent_id IS NULL OR parent_id <> id)
+);
+-- "Food" и "food" - одна категория
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_user_name ON categories(user_id, lower(name));
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories(parent_id);
+
+-- Заполняем категории из уже использованных имён, выбирая самое частое написание
+INSERT INTO categories (user_id, name)
+SELECT DISTINCT ON (user_id, lower(name)) user_id, name
+FROM (
+    SELECT user_id, name, COUNT(*) AS uses
+    FROM (
+        SELECT user_id, btrim(regexp_replace(category, '\s+', ' ', 'g')) AS name FROM transactions
+        UNION ALL
+        SELECT user_id, btrim(regexp_replace(category, '\s+', ' ', 'g')) FROM budgets
+        UNION ALL
+        SELECT user_id, btrim(regexp_replace(category, '\s+', ' ', 'g')) FROM recurring_rules
+    ) used
+    WHERE name <> ''
+    GROUP BY user_id, name
+) counted
+ORDER BY user_id, lower(name), uses DESC, name
+ON CONFLICT DO NOTHING;
+
+-- Приводим существующие записи к выбранному написанию
+UPDATE transactions t SET category = c.name
+FROM categories c
+WHERE c.user_id = t.user_id AND lower(c.name) = lower(btrim(regexp_replace(t.category, '\s+', ' ', 'g')))
+    AND t.category <> c.name;
+UPDATE recurring_rules r SET category = c.name
+FROM categories c
+WHERE c.user_id = r.user_id AND lower(c.name) = lower(btrim(regexp_replace(r.category, '\s+', ' ', 'g')))
+    AND r.category <> c.name;
+-- У каждого написания категории своя цепочка версий бюджета, и после переименования цепочки
+-- пересеклись бы. Лимиты разных написаний не складываются, поэтому остаётся одна цепочка:
+-- с выбранным написанием, иначе с действующей версией, иначе с самой поздней; остальные удаляются
+DELETE FROM budgets b
+USING categories c
+WHERE c.user_id = b.user_id AND lower(c.name) = lower(btrim(regexp_replace(b.category, '\s+', ' ', 'g')))
+    AND b.category <> (
+        SELECT kept.category FROM budgets kept
+        WHERE kept.user_id = b.user_id AND lower(btrim(regexp_replace(kept.category, '\s+', ' ', 'g'))) = lower(c.name)
+        ORDER BY kept.category = c.name DESC, kept.valid_to IS NULL DESC, kept.valid_from DESC, kept.id DESC
+        LIMIT 1
+    );
+UPDATE budgets b SET category = c.name
+FROM categories c
+WHERE c.user_id = b.user_id AND lower(c.name) = lower(btrim(regexp_replace(b.category, '\s+', ' ', 'g')))
+    AND b.category <> c.name;
+
+-- +goose Down
+-- Имена категорий в транзакциях и бюджетах остаются приведёнными
+DROP TABLE IF EXISTS categories;
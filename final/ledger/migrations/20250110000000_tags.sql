@@ -0,0 +1,21 @@
+-- +goose Up
+-- Метки транзакций (например, "vacation-2026", "reimbursable"), хранятся в нижнем регистре
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (name <> '' AND name = lower(name)),
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (user_id, name)
+);
+
+-- Связь многие-ко-многим между транзакциями и метками
+CREATE TABLE IF NOT EXISTS transaction_tags (
+    transaction_id INT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    tag_id INT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (transaction_id, tag_id)
+);
+CREATE INDEX IF NOT EXISTS idx_transaction_tags_tag_id ON transaction_tags(tag_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_tags;
+DROP TABLE IF EXISTS tags;
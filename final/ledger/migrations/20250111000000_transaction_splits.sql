@@ -0,0 +1,15 @@
+-- +goose Up
+-- Разбивка транзакции по категориям (например, чек: продукты + бытовая химия).
+-- Сумма строк равна сумме транзакции; transactions.category - категория первой строки
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id SERIAL PRIMARY KEY,
+    transaction_id INT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    category TEXT NOT NULL,
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    note TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_transaction_id ON transaction_splits(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_category ON transaction_splits(category);
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_splits;
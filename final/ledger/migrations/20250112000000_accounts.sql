@@ -0,0 +1,43 @@
+-- +goose Up
+-- Счета пользователя (наличные, карты, накопительные) с начальным остатком
+CREATE TABLE IF NOT EXISTS accounts (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (name <> ''),
+    type TEXT NOT NULL DEFAULT 'cash' CHECK (type IN ('cash', 'debit', 'credit', 'savings')),
+    currency TEXT NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    opening_balance NUMERIC(14,2) NOT NULL DEFAULT 0,
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_user_name ON accounts(user_id, lower(name));
+
+-- Перевод между счетами: две транзакции kind = 'transfer' (списание и зачисление),
+-- to_amount - сумма зачисления в валюте счёта получателя
+CREATE TABLE IF NOT EXISTS transfers (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    from_account_id INT NOT NULL REFERENCES accounts(id),
+    to_account_id INT NOT NULL REFERENCES accounts(id),
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    to_amount NUMERIC(14,2) NOT NULL CHECK (to_amount > 0),
+    description TEXT NOT NULL DEFAULT '',
+    date DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (from_account_id <> to_account_id)
+);
+CREATE INDEX IF NOT EXISTS idx_transfers_user_id ON transfers(user_id);
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS account_id INT REFERENCES accounts(id),
+    ADD COLUMN IF NOT EXISTS transfer_id INT REFERENCES transfers(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_transactions_account_date ON transactions(account_id, date) WHERE account_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_transfer_id ON transactions(transfer_id) WHERE transfer_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_transfer_id;
+DROP INDEX IF EXISTS idx_transactions_account_date;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS account_id;
+DROP TABLE IF EXISTS transfers;
+DROP TABLE IF EXISTS accounts;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Полнотекстовый поиск по описанию и категории. Данные смешанные, поэтому
+-- вектор строится сразу по русскому и английскому словарям
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS search tsvector GENERATED ALWAYS AS (
+        to_tsvector('russian', coalesce(description, '') || ' ' || category) ||
+        to_tsvector('english', coalesce(description, '') || ' ' || category)
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_transactions_search ON transactions USING GIN (search);
+CREATE INDEX IF NOT EXISTS idx_transactions_user_amount ON transactions(user_id, amount);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_user_amount;
+DROP INDEX IF EXISTS idx_transactions_search;
+ALTER TABLE transactions DROP COLUMN IF EXISTS search;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Правила автокатегоризации: если у транзакции не указана категория, первое подходящее
+-- правило (по priority, затем id) задаёт категорию и добавляет метки
+CREATE TABLE IF NOT EXISTS category_rules (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    match_type TEXT NOT NULL DEFAULT 'contains' CHECK (match_type IN ('contains', 'regex')),
+    pattern TEXT NOT NULL DEFAULT '',
+    min_amount NUMERIC(14,2),
+    max_amount NUMERIC(14,2),
+    category TEXT NOT NULL CHECK (category <> ''),
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    priority INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_category_rules_user_priority ON category_rules(user_id, priority, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS category_rules;
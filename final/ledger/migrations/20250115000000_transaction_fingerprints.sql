@@ -0,0 +1,10 @@
+-- +goose Up
+-- Отпечаток импортированной строки (дата, сумма, описание): повторный импорт
+-- той же выписки не создаёт дублей. Для транзакций, введённых вручную, NULL
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fingerprint TEXT;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_fingerprint
+    ON transactions(user_id, fingerprint) WHERE fingerprint IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_fingerprint;
+ALTER TABLE transactions DROP COLUMN IF EXISTS fingerprint;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Профили импорта CSV: соответствие колонок (по имени заголовка или номеру), разделитель,
+-- десятичная запятая, форматы дат, кодировка и правило знака суммы
+CREATE TABLE IF NOT EXISTS import_profiles (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (name <> ''),
+    delimiter TEXT NOT NULL DEFAULT ',',
+    decimal_comma BOOLEAN NOT NULL DEFAULT FALSE,
+    date_layouts TEXT[] NOT NULL DEFAULT '{2006-01-02}',
+    encoding TEXT NOT NULL DEFAULT 'utf-8' CHECK (encoding IN ('utf-8', 'windows-1251', 'koi8-r')),
+    sign_convention TEXT NOT NULL DEFAULT 'positive' CHECK (sign_convention IN ('positive', 'negative_expense', 'negative_income')),
+    columns JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_import_profiles_user_name ON import_profiles(user_id, lower(name));
+
+-- +goose Down
+DROP TABLE IF EXISTS import_profiles;
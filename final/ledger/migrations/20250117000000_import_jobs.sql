@@ -0,0 +1,37 @@
+-- +goose Up
+-- Фоновые задания импорта: файл хранится до завершения обработки, счётчики обновляются после каждой пачки строк
+CREATE TABLE IF NOT EXISTS import_jobs (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'queued' CHECK (status IN ('queued', 'running', 'completed', 'failed', 'canceled')),
+    format TEXT NOT NULL DEFAULT 'csv',
+    profile TEXT NOT NULL DEFAULT '',
+    duplicate_mode TEXT NOT NULL DEFAULT 'skip',
+    atomic BOOLEAN NOT NULL DEFAULT FALSE,
+    data BYTEA,
+    total_rows INTEGER NOT NULL DEFAULT 0,
+    processed_rows INTEGER NOT NULL DEFAULT 0,
+    imported_rows INTEGER NOT NULL DEFAULT 0,
+    duplicate_rows INTEGER NOT NULL DEFAULT 0,
+    failed_rows INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP
+);
+-- Очередь: воркеры забирают самые старые задания в статусе queued
+CREATE INDEX IF NOT EXISTS idx_import_jobs_queued ON import_jobs(id) WHERE status = 'queued';
+CREATE INDEX IF NOT EXISTS idx_import_jobs_running ON import_jobs(updated_at) WHERE status = 'running';
+
+-- Ошибки и предупреждения по строкам файла
+CREATE TABLE IF NOT EXISTS import_job_errors (
+    job_id INTEGER NOT NULL REFERENCES import_jobs(id) ON DELETE CASCADE,
+    line INTEGER NOT NULL,
+    message TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_import_job_errors_job ON import_job_errors(job_id, line);
+
+-- +goose Down
+DROP TABLE IF EXISTS import_job_errors;
+DROP TABLE IF EXISTS import_jobs;
@@ -0,0 +1,48 @@
+-- +goose Up
+-- В ключ отпечатка импортированной транзакции добавлены тип и валюта: доход и расход
+-- или суммы в разных валютах с одной датой и описанием больше не считаются дублями.
+-- Номер повтора строки в выписке из хеша не восстановить, поэтому он подбирается перебором;
+-- отпечатки по FITID ни с одним номером не совпадают и остаются как есть
+WITH keyed AS (
+    SELECT id, fingerprint, kind, currency,
+        to_char(date, 'YYYY-MM-DD') AS day,
+        amount::text AS amount,
+        btrim(regexp_replace(lower(coalesce(description, '')), '\s+', ' ', 'g')) AS description,
+        COUNT(*) OVER (PARTITION BY user_id, date, amount, btrim(regexp_replace(lower(coalesce(description, '')), '\s+', ' ', 'g'))) AS same
+    FROM transactions
+    WHERE fingerprint IS NOT NULL
+),
+matched AS (
+    SELECT k.id, k.day || '|' || k.kind || '|' || k.amount || '|' || k.currency || '|' || k.description || '|' || n AS key
+    FROM keyed k
+    CROSS JOIN LATERAL generate_series(1, k.same + 10) AS n
+    WHERE k.fingerprint = encode(sha256(convert_to(k.day || '|' || k.amount || '|' || k.description || '|' || n, 'UTF8')), 'hex')
+)
+UPDATE transactions t SET fingerprint = encode(sha256(convert_to(m.key, 'UTF8')), 'hex')
+FROM matched m
+WHERE t.id = m.id;
+
+-- +goose Down
+-- Старый ключ грубее нового: из совпавших отпечатков остаётся один, у остальных он сбрасывается
+WITH keyed AS (
+    SELECT id, user_id, fingerprint, kind, currency,
+        to_char(date, 'YYYY-MM-DD') AS day,
+        amount::text AS amount,
+        btrim(regexp_replace(lower(coalesce(description, '')), '\s+', ' ', 'g')) AS description,
+        COUNT(*) OVER (PARTITION BY user_id, date, amount, btrim(regexp_replace(lower(coalesce(description, '')), '\s+', ' ', 'g'))) AS same
+    FROM transactions
+    WHERE fingerprint IS NOT NULL
+),
+matched AS (
+    SELECT k.id, k.user_id, encode(sha256(convert_to(k.day || '|' || k.amount || '|' || k.description || '|' || n, 'UTF8')), 'hex') AS old
+    FROM keyed k
+    CROSS JOIN LATERAL generate_series(1, k.same + 10) AS n
+    WHERE k.fingerprint = encode(sha256(convert_to(k.day || '|' || k.kind || '|' || k.amount || '|' || k.currency || '|' || k.description || '|' || n, 'UTF8')), 'hex')
+),
+ranked AS (
+    SELECT id, old, ROW_NUMBER() OVER (PARTITION BY user_id, old ORDER BY id) AS rank
+    FROM matched
+)
+UPDATE transactions t SET fingerprint = CASE WHEN r.rank = 1 THEN r.old END
+FROM ranked r
+WHERE t.id = r.id;